@@ -0,0 +1,1686 @@
+// Code generated by gen_catalogue.go from catalogue.yaml; DO NOT EDIT.
+
+package gtpv2
+
+// These represent possible GTPv2 IE types.  In some cases, includes the
+// full name and its abbreviation (e.g., for IMSI)
+const (
+	InternationalMobileSubscriberIdentity                = 1
+	IMSI                                                 = 1
+	Cause                                                = 2
+	RecoveryRestartCounter                               = 3
+	STNSR                                                = 51
+	AccessPointName                                      = 71
+	APN                                                  = 71
+	AggregateMaximumBitRate                              = 72
+	AMBR                                                 = 72
+	EPSBearerID                                          = 73
+	EBI                                                  = 73
+	IPAddress                                            = 74
+	MobileEquipmentIdentity                              = 75
+	MEI                                                  = 75
+	MSISDN                                               = 76
+	Indication                                           = 77
+	ProtocolConfigurationOptions                         = 78
+	PCI                                                  = 78
+	PDNAddressAllocation                                 = 79
+	PAA                                                  = 79
+	BearerLevelQualityofService                          = 80
+	BearerQoS                                            = 80
+	FlowQualityofService                                 = 81
+	FlowQoS                                              = 81
+	RATType                                              = 82
+	ServingNetwork                                       = 83
+	EPSBearerLevelTrafficFlowTemplate                    = 84
+	BearerTFT                                            = 84
+	TrafficAggregationDescription                        = 85
+	TAD                                                  = 85
+	UserLocationInformation                              = 86
+	ULI                                                  = 86
+	FullyQualifiedTunnelEndpointIdentifier               = 87
+	FTEID                                                = 87
+	TMSI                                                 = 88
+	GlobalCNId                                           = 89
+	S103PDNDataForwardingInfo                            = 90
+	S103PDF                                              = 90
+	S1UDataForwardingInfo                                = 91
+	S1UDF                                                = 91
+	DelayValue                                           = 92
+	BearerContext                                        = 93
+	ChargingID                                           = 94
+	ChargingCharacteristics                              = 95
+	TraceInformation                                     = 96
+	BearerFlags                                          = 97
+	PDNType                                              = 99
+	ProcedureTransactionID                               = 100
+	MMContextGSMKeyandTriplets                           = 103
+	MMContextUMTSKeyUsedCipherandQuintuplets             = 104
+	MMContextGSMKeyUsedCipherandQuintuplets              = 105
+	MMContextUMTSKeyandQuintuplets                       = 106
+	MMContextEPSSecurityContextQuadrupletsandQuintuplets = 107
+	MMContextUMTSKeyQuadrupletsandQuintuplets            = 108
+	PDNConnection                                        = 109
+	PDUNumbers                                           = 110
+	PTMSI                                                = 111
+	PTMSISignature                                       = 112
+	HopCounter                                           = 113
+	UETimeZone                                           = 114
+	TraceReference                                       = 115
+	CompleteRequestMessage                               = 116
+	GUTI                                                 = 117
+	FContainer                                           = 118
+	FCause                                               = 119
+	PLMNID                                               = 120
+	TargetIdentification                                 = 121
+	PacketFlowID                                         = 123
+	RABContext                                           = 124
+	SourceRNCPDCPContextInfo                             = 125
+	PortNumber                                           = 126
+	APNRestriction                                       = 127
+	SelectionMode                                        = 128
+	SourceIdentification                                 = 129
+	ChangeReportingAction                                = 131
+	FullyQualifiedPDNConnectionSetIdentifier             = 132
+	FQCSID                                               = 132
+	Channelneeded                                        = 133
+	eMLPPPriority                                        = 134
+	NodeType                                             = 135
+	FullyQualifiedDomainName                             = 136
+	FQDN                                                 = 136
+	TransactionIdentifier                                = 137
+	TI                                                   = 137
+	MBMSSessionDuration                                  = 138
+	MBMSServiceArea                                      = 139
+	MBMSSessionIdentifier                                = 140
+	MBMSFlowIdentifier                                   = 141
+	MBMSIPMulticastDistribution                          = 142
+	MBMSDistributionAcknowledge                          = 143
+	RFSPIndex                                            = 144
+	UserCSGInformation                                   = 145
+	UCI                                                  = 145
+	CSGInformationReportingAction                        = 146
+	CSGID                                                = 147
+	CSGMembershipIndication                              = 148
+	CMI                                                  = 148
+	Serviceindicator                                     = 149
+	DetachType                                           = 150
+	LocalDistinguishedName                               = 151
+	LocalDistiguishedName                                = 151
+	LDN                                                  = 151
+	NodeFeatures                                         = 152
+	MBMSTimetoDataTransfer                               = 153
+	Throttling                                           = 154
+	AllocationRetentionPriority                          = 155
+	ARP                                                  = 155
+	EPCTimer                                             = 156
+	SignallingPriorityIndication                         = 157
+	TemporaryMobileGroupIdentity                         = 158
+	TMGI                                                 = 158
+	AdditionalMMcontextforSRVCC                          = 159
+	AdditionalflagsforSRVCC                              = 160
+	MDTConfiguration                                     = 162
+	AdditionalProtocolConfigurationOptions               = 163
+	APCO                                                 = 163
+	AbsoluteTimeofMBMSDataTransfer                       = 164
+	HeNBInformationReporting                             = 165
+	IPv4ConfigurationParameters                          = 166
+	IP4CP                                                = 166
+	ChangetoReportFlags                                  = 167
+	ActionIndication                                     = 168
+	TWANIdentifier                                       = 169
+	ULITimestamp                                         = 170
+	MBMSFlags                                            = 171
+	RANNASCause                                          = 172
+	CNOperatorSelectionEntity                            = 173
+	TrustedWLANModeIndication                            = 174
+	NodeNumber                                           = 175
+	NodeIdentifier                                       = 176
+	PresenceReportingAreaAction                          = 177
+	PresenceReportingAreaInformation                     = 178
+	TWANIdentifierTimestamp                              = 179
+	OverloadControlInformation                           = 180
+	LoadControlInformation                               = 181
+	Metric                                               = 182
+	SequenceNumber                                       = 183
+	APNandRelativeCapacity                               = 184
+	WLANOffloadabilityIndication                         = 185
+	PagingandServiceInformation                          = 186
+	IntegerNumber                                        = 187
+	MillisecondTimeStamp                                 = 188
+	MonitoringEventInformation                           = 189
+	ECGIList                                             = 190
+	RemoteUEContext                                      = 191
+	RemoteUserID                                         = 192
+	RemoteUEIPinformation                                = 193
+	CIoTOptimizationsSupportIndication                   = 194
+	SCEFPDNConnection                                    = 195
+	HeaderCompressionConfiguration                       = 196
+	ExtendedProtocolConfigurationOptions                 = 197
+	ePCO                                                 = 197
+	ServingPLMNRateControl                               = 198
+	Counter                                              = 199
+	MappedUEUsageType                                    = 200
+	SecondaryRATUsageDataReport                          = 201
+	UPFunctionSelectionIndicationFlags                   = 202
+	MaximumPacketLossRate                                = 203
+	APNRateControlStatus                                 = 204
+	ExtendedTraceInformation                             = 205
+	MonitoringEventExtensionInformation                  = 206
+	AdditionalRRMPolicyIndex                             = 207
+	V2XContext                                           = 208
+	PC5QoSParameters                                     = 209
+	ServicesAuthorized                                   = 210
+	BitRate                                              = 211
+	PC5QoSFlow                                           = 212
+	SGiPtPTunnelAddress                                  = 213
+	PGWChangeInfo                                        = 214
+	PGWSetFQDN                                           = 215
+	GroupId                                              = 216
+	PSCellID                                             = 217
+	UPSecurityPolicy                                     = 218
+	AlternativeIMSI                                      = 219
+	ExtensionType                                        = 254
+	PrivateExtension                                     = 255
+)
+
+// GTPv2 MessageTypes
+const (
+	EchoRequest                                MessageType = 1
+	EchoResponse                               MessageType = 2
+	VersionNotSupportedIndication              MessageType = 3
+	CreateSessionRequest                       MessageType = 32
+	CreateSessionResponse                      MessageType = 33
+	ModifyBearerRequest                        MessageType = 34
+	ModifyBearerResponse                       MessageType = 35
+	DeleteSessionRequest                       MessageType = 36
+	DeleteSessionResponse                      MessageType = 37
+	ChangeNotificationRequest                  MessageType = 38
+	ChangeNotificationResponse                 MessageType = 39
+	RemoteUEReportNotification                 MessageType = 40
+	RemoteUEReportAcknowledge                  MessageType = 41
+	RemoteUEReportAcknowlegement               MessageType = 41
+	ModifyBearerCommand                        MessageType = 64
+	ModifyBearerFailureIndication              MessageType = 65
+	DeleteBearerCommand                        MessageType = 66
+	DeleteBearerFailureIndication              MessageType = 67
+	BearerResourceCommand                      MessageType = 68
+	BearerResourceFailureIndication            MessageType = 69
+	DownlinkDataNotificationFailureIndication  MessageType = 70
+	TraceSessionActivation                     MessageType = 71
+	TraceSessionDeactivation                   MessageType = 72
+	StopPagingIndication                       MessageType = 73
+	CreateBearerRequest                        MessageType = 95
+	CreateBearerResponse                       MessageType = 96
+	UpdateBearerRequest                        MessageType = 97
+	UpdateBearerResponse                       MessageType = 98
+	DeleteBearerRequest                        MessageType = 99
+	DeleteBearerResponse                       MessageType = 100
+	DeletePDNConnectionSetRequest              MessageType = 101
+	DeletePDNConnectionSetResponse             MessageType = 102
+	PGWDownlinkTriggeringNotification          MessageType = 103
+	PGWDownlinkTriggeringAcknowledge           MessageType = 104
+	IdentificationRequest                      MessageType = 128
+	IdentificationResponse                     MessageType = 129
+	ContextRequest                             MessageType = 130
+	ContextResponse                            MessageType = 131
+	ContextAcknowledge                         MessageType = 132
+	ForwardRelocationRequest                   MessageType = 133
+	ForwardRelocationResponse                  MessageType = 134
+	ForwardRelocationCompleteNotification      MessageType = 135
+	ForwardRelocationCompleteAcknowledge       MessageType = 136
+	ForwardAccessContextNotification           MessageType = 137
+	ForwardAccessContextAcknowledge            MessageType = 138
+	RelocationCancelRequest                    MessageType = 139
+	RelocationCancelResponse                   MessageType = 140
+	ConfigurationTransferTunnel                MessageType = 141
+	DetachNotification                         MessageType = 149
+	DetachAcknowledge                          MessageType = 150
+	CSPagingIndication                         MessageType = 151
+	RANInformationRelay                        MessageType = 152
+	AlertMMENotification                       MessageType = 153
+	AlertMMEAcknowledge                        MessageType = 154
+	UEActivityNotification                     MessageType = 155
+	UEActivityAcknowledge                      MessageType = 156
+	ISRStatusIndication                        MessageType = 157
+	UERegistrationQueryRequest                 MessageType = 158
+	UERegistrationQueryResponse                MessageType = 159
+	CreateForwardingTunnelRequest              MessageType = 160
+	CreateForwardingTunnelResponse             MessageType = 161
+	SuspendNotification                        MessageType = 162
+	SuspendAcknowledge                         MessageType = 163
+	ResumeNotification                         MessageType = 164
+	ResumeAcknowledge                          MessageType = 165
+	CreateIndirectDataForwardingTunnelRequest  MessageType = 166
+	CreateIndirectDataForwardingTunnelResponse MessageType = 167
+	DeleteIndirectDataForwardingTunnelRequest  MessageType = 168
+	DeleteIndirectDataForwardingTunnelResponse MessageType = 169
+	ReleaseAccessBearersRequest                MessageType = 170
+	ReleaseAccessBearersResponse               MessageType = 171
+	DownlinkDataNotification                   MessageType = 176
+	DownlinkDataNotificationAcknowledge        MessageType = 177
+	PGWRestartNotification                     MessageType = 179
+	PGWRestartNotificationAcknowledge          MessageType = 180
+	UpdatePDNConnectionSetRequest              MessageType = 200
+	UpdatePDNConnectionSetResponse             MessageType = 201
+	ModifyAccessBearersRequest                 MessageType = 211
+	ModifyAccessBearersResponse                MessageType = 212
+	MBMSSessionStartRequest                    MessageType = 231
+	MBMSSessionStartResponse                   MessageType = 232
+	MBMSSessionUpdateRequest                   MessageType = 233
+	MBMSSessionUpdateResponse                  MessageType = 234
+	MBMSSessionStopRequest                     MessageType = 235
+	MBMSSessionStopResponse                    MessageType = 236
+)
+
+var ieNames = []string{
+	"Reserved", // 0
+	"International Mobile Subscriber Identity (IMSI)", // 1
+	"Cause",                                // 2
+	"Recovery (Restart Counter)",           // 3
+	"Reserved",                             // 4
+	"Reserved",                             // 5
+	"Reserved",                             // 6
+	"Reserved",                             // 7
+	"Reserved",                             // 8
+	"Reserved",                             // 9
+	"Reserved",                             // 10
+	"Reserved",                             // 11
+	"Reserved",                             // 12
+	"Reserved",                             // 13
+	"Reserved",                             // 14
+	"Reserved",                             // 15
+	"Reserved",                             // 16
+	"Reserved",                             // 17
+	"Reserved",                             // 18
+	"Reserved",                             // 19
+	"Reserved",                             // 20
+	"Reserved",                             // 21
+	"Reserved",                             // 22
+	"Reserved",                             // 23
+	"Reserved",                             // 24
+	"Reserved",                             // 25
+	"Reserved",                             // 26
+	"Reserved",                             // 27
+	"Reserved",                             // 28
+	"Reserved",                             // 29
+	"Reserved",                             // 30
+	"Reserved",                             // 31
+	"Reserved",                             // 32
+	"Reserved",                             // 33
+	"Reserved",                             // 34
+	"Reserved",                             // 35
+	"Reserved",                             // 36
+	"Reserved",                             // 37
+	"Reserved",                             // 38
+	"Reserved",                             // 39
+	"Reserved",                             // 40
+	"Reserved",                             // 41
+	"Reserved",                             // 42
+	"Reserved",                             // 43
+	"Reserved",                             // 44
+	"Reserved",                             // 45
+	"Reserved",                             // 46
+	"Reserved",                             // 47
+	"Reserved",                             // 48
+	"Reserved",                             // 49
+	"Reserved",                             // 50
+	"STN-SR",                               // 51
+	"Reserved",                             // 52
+	"Reserved",                             // 53
+	"Reserved",                             // 54
+	"Reserved",                             // 55
+	"Reserved",                             // 56
+	"Reserved",                             // 57
+	"Reserved",                             // 58
+	"Reserved",                             // 59
+	"Reserved",                             // 60
+	"Reserved",                             // 61
+	"Reserved",                             // 62
+	"Reserved",                             // 63
+	"Reserved",                             // 64
+	"Reserved",                             // 65
+	"Reserved",                             // 66
+	"Reserved",                             // 67
+	"Reserved",                             // 68
+	"Reserved",                             // 69
+	"Reserved",                             // 70
+	"Access Point Name (APN)",              // 71
+	"Aggregate Maximum Bit Rate (AMBR)",    // 72
+	"EPS Bearer ID (EBI)",                  // 73
+	"IP Address",                           // 74
+	"Mobile Equipment Identity (MEI)",      // 75
+	"MSISDN",                               // 76
+	"Indication",                           // 77
+	"Protocol Configuration Options (PCO)", // 78
+	"PDN Address Allocation (PAA)",         // 79
+	"Bearer Level Quality of Service (Bearer QoS)",        // 80
+	"Flow Quality of Service (Flow QoS)",                  // 81
+	"RAT Type",                                            // 82
+	"Serving Network",                                     // 83
+	"EPS Bearer Level Traffic Flow Template (Bearer TFT)", // 84
+	"Traffic Aggregation Description (TAD)",               // 85
+	"User Location Information (ULI)",                     // 86
+	"Fully Qualified Tunnel Endpoint Identifier (F-TEID)", // 87
+	"TMSI",         // 88
+	"Global CN-Id", // 89
+	"S103 PDN Data Forwarding Info (S103PDF)", // 90
+	"S1-U Data Forwarding Info (S1UDF)",       // 91
+	"Delay Value",                             // 92
+	"Bearer Context",                          // 93
+	"Charging ID",                             // 94
+	"Charging Characteristics",                // 95
+	"Trace Information",                       // 96
+	"Bearer Flags",                            // 97
+	"Reserved",                                // 98
+	"PDN Type",                                // 99
+	"Procedure Transaction ID",                // 100
+	"Reserved",                                // 101
+	"Reserved",                                // 102
+	"MM Context (GSM Key and Triplets)",       // 103
+	"MM Context (UMTS Key, Used Cipher and Quintuplets)",             // 104
+	"MM Context (GSM Key, Used Cipher and Quintuplets)",              // 105
+	"MM Context (UMTS Key and Quintuplets)",                          // 106
+	"MM Context (EPS Security Context, Quadruplets and Quintuplets)", // 107
+	"MM Context (UMTS Key, Quadruplets and Quintuplets)",             // 108
+	"PDN Connection",               // 109
+	"PDU Numbers",                  // 110
+	"P-TMSI",                       // 111
+	"P-TMSI Signature",             // 112
+	"Hop Counter",                  // 113
+	"UE Time Zone",                 // 114
+	"Trace Reference",              // 115
+	"Complete Request Message",     // 116
+	"GUTI",                         // 117
+	"F-Container",                  // 118
+	"F-Cause",                      // 119
+	"PLMN ID",                      // 120
+	"Target Identification",        // 121
+	"Reserved",                     // 122
+	"Packet Flow ID",               // 123
+	"RAB Context",                  // 124
+	"Source RNC PDCP Context Info", // 125
+	"Port Number",                  // 126
+	"APN Restriction",              // 127
+	"Selection Mode",               // 128
+	"Source Identification",        // 129
+	"Reserved",                     // 130
+	"Change Reporting Action",      // 131
+	"Fully Qualified PDN Connection Set Identifier (FQ-CSID)", // 132
+	"Channel needed",                                   // 133
+	"eMLPP Priority",                                   // 134
+	"Node Type",                                        // 135
+	"Fully Qualified Domain Name (FQDN)",               // 136
+	"Transaction Identifier (TI)",                      // 137
+	"MBMS Session Duration",                            // 138
+	"MBMS Service Area",                                // 139
+	"MBMS Session Identifier",                          // 140
+	"MBMS Flow Identifier",                             // 141
+	"MBMS IP Multicast Distribution",                   // 142
+	"MBMS Distribution Acknowledge",                    // 143
+	"RFSP Index",                                       // 144
+	"User CSG Information (UCI)",                       // 145
+	"CSG Information Reporting Action",                 // 146
+	"CSG ID",                                           // 147
+	"CSG Membership Indication (CMI)",                  // 148
+	"Service indicator",                                // 149
+	"Detach Type",                                      // 150
+	"Local Distinguished Name (LDN)",                   // 151
+	"Node Features",                                    // 152
+	"MBMS Time to Data Transfer",                       // 153
+	"Throttling",                                       // 154
+	"Allocation/Retention Priority (ARP)",              // 155
+	"EPC Timer",                                        // 156
+	"Signalling Priority Indication",                   // 157
+	"Temporary Mobile Group Identity (TMGI)",           // 158
+	"Additional MM context for SRVCC",                  // 159
+	"Additional flags for SRVCC",                       // 160
+	"Reserved",                                         // 161
+	"MDT Configuration",                                // 162
+	"Additional Protocol Configuration Options (APCO)", // 163
+	"Absolute Time of MBMS Data Transfer",              // 164
+	"H(e)NB Information Reporting",                     // 165
+	"IPv4 Configuration Parameters (IP4CP)",            // 166
+	"Change to Report Flags",                           // 167
+	"Action Indication",                                // 168
+	"TWAN Identifier",                                  // 169
+	"ULI Timestamp",                                    // 170
+	"MBMS Flags",                                       // 171
+	"RAN/NAS Cause",                                    // 172
+	"CN Operator Selection Entity",                     // 173
+	"Trusted WLAN Mode Indication",                     // 174
+	"Node Number",                                      // 175
+	"Node Identifier",                                  // 176
+	"Presence Reporting Area Action",                   // 177
+	"Presence Reporting Area Information",              // 178
+	"TWAN Identifier Timestamp",                        // 179
+	"Overload Control Information",                     // 180
+	"Load Control Information",                         // 181
+	"Metric",                                           // 182
+	"Sequence Number",                                  // 183
+	"APN and Relative Capacity",                        // 184
+	"WLAN Offloadability Indication",                   // 185
+	"Paging and Service Information",                   // 186
+	"Integer Number",                                   // 187
+	"Millisecond Time Stamp",                           // 188
+	"Monitoring Event Information",                     // 189
+	"ECGI List",                                        // 190
+	"Remote UE Context",                                // 191
+	"Remote User ID",                                   // 192
+	"Remote UE IP information",                         // 193
+	"CIoT Optimizations Support Indication",            // 194
+	"SCEF PDN Connection",                              // 195
+	"Header Compression Configuration",                 // 196
+	"Extended Protocol Configuration Options (ePCO)",   // 197
+	"Serving PLMN Rate Control",                        // 198
+	"Counter",                                          // 199
+	"Mapped UE Usage Type",                             // 200
+	"Secondary RAT Usage Data Report",                  // 201
+	"UP Function Selection Indication Flags",           // 202
+	"Maximum Packet Loss Rate",                         // 203
+	"APN Rate Control Status",                          // 204
+	"Extended Trace Information",                       // 205
+	"Monitoring Event Extension Information",           // 206
+	"Additional RRM Policy Index",                      // 207
+	"V2X Context",                                      // 208
+	"PC5 QoS Parameters",                               // 209
+	"Services Authorized",                              // 210
+	"Bit Rate",                                         // 211
+	"PC5 QoS Flow",                                     // 212
+	"SGi PtP Tunnel Address",                           // 213
+	"PGW Change Info",                                  // 214
+	"PGW Set FQDN",                                     // 215
+	"Group Id",                                         // 216
+	"PSCell ID",                                        // 217
+	"UP Security Policy",                               // 218
+	"Alternative IMSI",                                 // 219
+	"Reserved",                                         // 220
+	"Reserved",                                         // 221
+	"Reserved",                                         // 222
+	"Reserved",                                         // 223
+	"Reserved",                                         // 224
+	"Reserved",                                         // 225
+	"Reserved",                                         // 226
+	"Reserved",                                         // 227
+	"Reserved",                                         // 228
+	"Reserved",                                         // 229
+	"Reserved",                                         // 230
+	"Reserved",                                         // 231
+	"Reserved",                                         // 232
+	"Reserved",                                         // 233
+	"Reserved",                                         // 234
+	"Reserved",                                         // 235
+	"Reserved",                                         // 236
+	"Reserved",                                         // 237
+	"Reserved",                                         // 238
+	"Reserved",                                         // 239
+	"Reserved",                                         // 240
+	"Reserved",                                         // 241
+	"Reserved",                                         // 242
+	"Reserved",                                         // 243
+	"Reserved",                                         // 244
+	"Reserved",                                         // 245
+	"Reserved",                                         // 246
+	"Reserved",                                         // 247
+	"Reserved",                                         // 248
+	"Reserved",                                         // 249
+	"Reserved",                                         // 250
+	"Reserved",                                         // 251
+	"Reserved",                                         // 252
+	"Reserved",                                         // 253
+	"IE Extension",                                     // 254
+	"Private Extension",                                // 255
+}
+
+var extendedIENames = map[IEType]string{}
+
+var ieTypeReleases = map[IEType]Release{
+	InternationalMobileSubscriberIdentity:    Release8,
+	Cause:                                    Release8,
+	RecoveryRestartCounter:                   Release8,
+	STNSR:                                    Release8,
+	AccessPointName:                          Release8,
+	AggregateMaximumBitRate:                  Release8,
+	EPSBearerID:                              Release8,
+	IPAddress:                                Release8,
+	MobileEquipmentIdentity:                  Release8,
+	MSISDN:                                   Release8,
+	Indication:                               Release8,
+	ProtocolConfigurationOptions:             Release8,
+	PDNAddressAllocation:                     Release8,
+	BearerLevelQualityofService:              Release8,
+	FlowQualityofService:                     Release8,
+	RATType:                                  Release8,
+	ServingNetwork:                           Release8,
+	EPSBearerLevelTrafficFlowTemplate:        Release8,
+	TrafficAggregationDescription:            Release8,
+	UserLocationInformation:                  Release8,
+	FullyQualifiedTunnelEndpointIdentifier:   Release8,
+	TMSI:                                     Release8,
+	GlobalCNId:                               Release8,
+	S103PDNDataForwardingInfo:                Release8,
+	S1UDataForwardingInfo:                    Release8,
+	DelayValue:                               Release8,
+	BearerContext:                            Release8,
+	ChargingID:                               Release8,
+	ChargingCharacteristics:                  Release8,
+	TraceInformation:                         Release8,
+	BearerFlags:                              Release8,
+	PDNType:                                  Release8,
+	ProcedureTransactionID:                   Release8,
+	MMContextGSMKeyandTriplets:               Release8,
+	MMContextUMTSKeyUsedCipherandQuintuplets: Release8,
+	MMContextGSMKeyUsedCipherandQuintuplets:  Release8,
+	MMContextUMTSKeyandQuintuplets:           Release8,
+	MMContextEPSSecurityContextQuadrupletsandQuintuplets: Release8,
+	MMContextUMTSKeyQuadrupletsandQuintuplets:            Release8,
+	PDNConnection:                            Release8,
+	PDUNumbers:                               Release8,
+	PTMSI:                                    Release8,
+	PTMSISignature:                           Release8,
+	HopCounter:                               Release8,
+	UETimeZone:                               Release8,
+	TraceReference:                           Release8,
+	CompleteRequestMessage:                   Release8,
+	GUTI:                                     Release8,
+	FContainer:                               Release8,
+	FCause:                                   Release8,
+	PLMNID:                                   Release8,
+	TargetIdentification:                     Release8,
+	PacketFlowID:                             Release8,
+	RABContext:                               Release8,
+	SourceRNCPDCPContextInfo:                 Release8,
+	PortNumber:                               Release8,
+	APNRestriction:                           Release8,
+	SelectionMode:                            Release8,
+	SourceIdentification:                     Release8,
+	ChangeReportingAction:                    Release8,
+	FullyQualifiedPDNConnectionSetIdentifier: Release8,
+	Channelneeded:                            Release8,
+	eMLPPPriority:                            Release8,
+	NodeType:                                 Release8,
+	FullyQualifiedDomainName:                 Release8,
+	TransactionIdentifier:                    Release8,
+	MBMSSessionDuration:                      Release9,
+	MBMSServiceArea:                          Release9,
+	MBMSSessionIdentifier:                    Release9,
+	MBMSFlowIdentifier:                       Release9,
+	MBMSIPMulticastDistribution:              Release9,
+	MBMSDistributionAcknowledge:              Release9,
+	RFSPIndex:                                Release8,
+	UserCSGInformation:                       Release9,
+	CSGInformationReportingAction:            Release9,
+	CSGID:                                    Release9,
+	CSGMembershipIndication:                  Release9,
+	Serviceindicator:                         Release8,
+	DetachType:                               Release8,
+	LocalDistinguishedName:                   Release8,
+	NodeFeatures:                             Release9,
+	MBMSTimetoDataTransfer:                   Release9,
+	Throttling:                               Release10,
+	AllocationRetentionPriority:              Release9,
+	EPCTimer:                                 Release10,
+	SignallingPriorityIndication:             Release10,
+	TemporaryMobileGroupIdentity:             Release9,
+	AdditionalMMcontextforSRVCC:              Release10,
+	AdditionalflagsforSRVCC:                  Release10,
+	MDTConfiguration:                         Release10,
+	AdditionalProtocolConfigurationOptions:   Release10,
+	AbsoluteTimeofMBMSDataTransfer:           Release11,
+	HeNBInformationReporting:                 Release11,
+	IPv4ConfigurationParameters:              Release11,
+	ChangetoReportFlags:                      Release11,
+	ActionIndication:                         Release11,
+	TWANIdentifier:                           Release11,
+	ULITimestamp:                             Release11,
+	MBMSFlags:                                Release11,
+	RANNASCause:                              Release11,
+	CNOperatorSelectionEntity:                Release11,
+	TrustedWLANModeIndication:                Release12,
+	NodeNumber:                               Release12,
+	NodeIdentifier:                           Release12,
+	PresenceReportingAreaAction:              Release12,
+	PresenceReportingAreaInformation:         Release12,
+	TWANIdentifierTimestamp:                  Release12,
+	OverloadControlInformation:               Release12,
+	LoadControlInformation:                   Release12,
+	Metric:                                   Release12,
+	SequenceNumber:                           Release12,
+	APNandRelativeCapacity:                   Release12,
+	WLANOffloadabilityIndication:             Release12,
+	PagingandServiceInformation:              Release13,
+	IntegerNumber:                            Release13,
+	MillisecondTimeStamp:                     Release13,
+	MonitoringEventInformation:               Release13,
+	ECGIList:                                 Release13,
+	RemoteUEContext:                          Release13,
+	RemoteUserID:                             Release13,
+	RemoteUEIPinformation:                    Release13,
+	CIoTOptimizationsSupportIndication:       Release13,
+	SCEFPDNConnection:                        Release13,
+	HeaderCompressionConfiguration:           Release13,
+	ExtendedProtocolConfigurationOptions:     Release13,
+	ServingPLMNRateControl:                   Release13,
+	Counter:                                  Release13,
+	MappedUEUsageType:                        Release13,
+	SecondaryRATUsageDataReport:              Release15,
+	UPFunctionSelectionIndicationFlags:       Release15,
+	MaximumPacketLossRate:                    Release15,
+	APNRateControlStatus:                     Release15,
+	ExtendedTraceInformation:                 Release15,
+	MonitoringEventExtensionInformation:      Release15,
+	AdditionalRRMPolicyIndex:                 Release16,
+	V2XContext:                               Release16,
+	PC5QoSParameters:                         Release16,
+	ServicesAuthorized:                       Release16,
+	BitRate:                                  Release16,
+	PC5QoSFlow:                               Release16,
+	SGiPtPTunnelAddress:                      Release16,
+	PGWChangeInfo:                            Release16,
+	PGWSetFQDN:                               Release16,
+	GroupId:                                  Release16,
+	PSCellID:                                 Release16,
+	UPSecurityPolicy:                         Release17,
+	AlternativeIMSI:                          Release17,
+	ExtensionType:                            Release8,
+	PrivateExtension:                         Release8,
+}
+
+var ieShortNames = map[IEType]string{
+	InternationalMobileSubscriberIdentity:    "IMSI",
+	Cause:                                    "Cause",
+	RecoveryRestartCounter:                   "RecoveryRestartCounter",
+	STNSR:                                    "STNSR",
+	AccessPointName:                          "APN",
+	AggregateMaximumBitRate:                  "AMBR",
+	EPSBearerID:                              "EBI",
+	IPAddress:                                "IPAddress",
+	MobileEquipmentIdentity:                  "MEI",
+	MSISDN:                                   "MSISDN",
+	Indication:                               "Indication",
+	ProtocolConfigurationOptions:             "PCI",
+	PDNAddressAllocation:                     "PAA",
+	BearerLevelQualityofService:              "BearerQoS",
+	FlowQualityofService:                     "FlowQoS",
+	RATType:                                  "RATType",
+	ServingNetwork:                           "ServingNetwork",
+	EPSBearerLevelTrafficFlowTemplate:        "BearerTFT",
+	TrafficAggregationDescription:            "TAD",
+	UserLocationInformation:                  "ULI",
+	FullyQualifiedTunnelEndpointIdentifier:   "FTEID",
+	TMSI:                                     "TMSI",
+	GlobalCNId:                               "GlobalCNId",
+	S103PDNDataForwardingInfo:                "S103PDF",
+	S1UDataForwardingInfo:                    "S1UDF",
+	DelayValue:                               "DelayValue",
+	BearerContext:                            "BearerContext",
+	ChargingID:                               "ChargingID",
+	ChargingCharacteristics:                  "ChargingCharacteristics",
+	TraceInformation:                         "TraceInformation",
+	BearerFlags:                              "BearerFlags",
+	PDNType:                                  "PDNType",
+	ProcedureTransactionID:                   "ProcedureTransactionID",
+	MMContextGSMKeyandTriplets:               "MMContextGSMKeyandTriplets",
+	MMContextUMTSKeyUsedCipherandQuintuplets: "MMContextUMTSKeyUsedCipherandQuintuplets",
+	MMContextGSMKeyUsedCipherandQuintuplets:  "MMContextGSMKeyUsedCipherandQuintuplets",
+	MMContextUMTSKeyandQuintuplets:           "MMContextUMTSKeyandQuintuplets",
+	MMContextEPSSecurityContextQuadrupletsandQuintuplets: "MMContextEPSSecurityContextQuadrupletsandQuintuplets",
+	MMContextUMTSKeyQuadrupletsandQuintuplets:            "MMContextUMTSKeyQuadrupletsandQuintuplets",
+	PDNConnection:                            "PDNConnection",
+	PDUNumbers:                               "PDUNumbers",
+	PTMSI:                                    "PTMSI",
+	PTMSISignature:                           "PTMSISignature",
+	HopCounter:                               "HopCounter",
+	UETimeZone:                               "UETimeZone",
+	TraceReference:                           "TraceReference",
+	CompleteRequestMessage:                   "CompleteRequestMessage",
+	GUTI:                                     "GUTI",
+	FContainer:                               "FContainer",
+	FCause:                                   "FCause",
+	PLMNID:                                   "PLMNID",
+	TargetIdentification:                     "TargetIdentification",
+	PacketFlowID:                             "PacketFlowID",
+	RABContext:                               "RABContext",
+	SourceRNCPDCPContextInfo:                 "SourceRNCPDCPContextInfo",
+	PortNumber:                               "PortNumber",
+	APNRestriction:                           "APNRestriction",
+	SelectionMode:                            "SelectionMode",
+	SourceIdentification:                     "SourceIdentification",
+	ChangeReportingAction:                    "ChangeReportingAction",
+	FullyQualifiedPDNConnectionSetIdentifier: "FQCSID",
+	Channelneeded:                            "Channelneeded",
+	eMLPPPriority:                            "eMLPPPriority",
+	NodeType:                                 "NodeType",
+	FullyQualifiedDomainName:                 "FQDN",
+	TransactionIdentifier:                    "TI",
+	MBMSSessionDuration:                      "MBMSSessionDuration",
+	MBMSServiceArea:                          "MBMSServiceArea",
+	MBMSSessionIdentifier:                    "MBMSSessionIdentifier",
+	MBMSFlowIdentifier:                       "MBMSFlowIdentifier",
+	MBMSIPMulticastDistribution:              "MBMSIPMulticastDistribution",
+	MBMSDistributionAcknowledge:              "MBMSDistributionAcknowledge",
+	RFSPIndex:                                "RFSPIndex",
+	UserCSGInformation:                       "UCI",
+	CSGInformationReportingAction:            "CSGInformationReportingAction",
+	CSGID:                                    "CSGID",
+	CSGMembershipIndication:                  "CMI",
+	Serviceindicator:                         "Serviceindicator",
+	DetachType:                               "DetachType",
+	LocalDistinguishedName:                   "LDN",
+	NodeFeatures:                             "NodeFeatures",
+	MBMSTimetoDataTransfer:                   "MBMSTimetoDataTransfer",
+	Throttling:                               "Throttling",
+	AllocationRetentionPriority:              "ARP",
+	EPCTimer:                                 "EPCTimer",
+	SignallingPriorityIndication:             "SignallingPriorityIndication",
+	TemporaryMobileGroupIdentity:             "TMGI",
+	AdditionalMMcontextforSRVCC:              "AdditionalMMcontextforSRVCC",
+	AdditionalflagsforSRVCC:                  "AdditionalflagsforSRVCC",
+	MDTConfiguration:                         "MDTConfiguration",
+	AdditionalProtocolConfigurationOptions:   "APCO",
+	AbsoluteTimeofMBMSDataTransfer:           "AbsoluteTimeofMBMSDataTransfer",
+	HeNBInformationReporting:                 "HeNBInformationReporting",
+	IPv4ConfigurationParameters:              "IP4CP",
+	ChangetoReportFlags:                      "ChangetoReportFlags",
+	ActionIndication:                         "ActionIndication",
+	TWANIdentifier:                           "TWANIdentifier",
+	ULITimestamp:                             "ULITimestamp",
+	MBMSFlags:                                "MBMSFlags",
+	RANNASCause:                              "RANNASCause",
+	CNOperatorSelectionEntity:                "CNOperatorSelectionEntity",
+	TrustedWLANModeIndication:                "TrustedWLANModeIndication",
+	NodeNumber:                               "NodeNumber",
+	NodeIdentifier:                           "NodeIdentifier",
+	PresenceReportingAreaAction:              "PresenceReportingAreaAction",
+	PresenceReportingAreaInformation:         "PresenceReportingAreaInformation",
+	TWANIdentifierTimestamp:                  "TWANIdentifierTimestamp",
+	OverloadControlInformation:               "OverloadControlInformation",
+	LoadControlInformation:                   "LoadControlInformation",
+	Metric:                                   "Metric",
+	SequenceNumber:                           "SequenceNumber",
+	APNandRelativeCapacity:                   "APNandRelativeCapacity",
+	WLANOffloadabilityIndication:             "WLANOffloadabilityIndication",
+	PagingandServiceInformation:              "PagingandServiceInformation",
+	IntegerNumber:                            "IntegerNumber",
+	MillisecondTimeStamp:                     "MillisecondTimeStamp",
+	MonitoringEventInformation:               "MonitoringEventInformation",
+	ECGIList:                                 "ECGIList",
+	RemoteUEContext:                          "RemoteUEContext",
+	RemoteUserID:                             "RemoteUserID",
+	RemoteUEIPinformation:                    "RemoteUEIPinformation",
+	CIoTOptimizationsSupportIndication:       "CIoTOptimizationsSupportIndication",
+	SCEFPDNConnection:                        "SCEFPDNConnection",
+	HeaderCompressionConfiguration:           "HeaderCompressionConfiguration",
+	ExtendedProtocolConfigurationOptions:     "ePCO",
+	ServingPLMNRateControl:                   "ServingPLMNRateControl",
+	Counter:                                  "Counter",
+	MappedUEUsageType:                        "MappedUEUsageType",
+	SecondaryRATUsageDataReport:              "SecondaryRATUsageDataReport",
+	UPFunctionSelectionIndicationFlags:       "UPFunctionSelectionIndicationFlags",
+	MaximumPacketLossRate:                    "MaximumPacketLossRate",
+	APNRateControlStatus:                     "APNRateControlStatus",
+	ExtendedTraceInformation:                 "ExtendedTraceInformation",
+	MonitoringEventExtensionInformation:      "MonitoringEventExtensionInformation",
+	AdditionalRRMPolicyIndex:                 "AdditionalRRMPolicyIndex",
+	V2XContext:                               "V2XContext",
+	PC5QoSParameters:                         "PC5QoSParameters",
+	ServicesAuthorized:                       "ServicesAuthorized",
+	BitRate:                                  "BitRate",
+	PC5QoSFlow:                               "PC5QoSFlow",
+	SGiPtPTunnelAddress:                      "SGiPtPTunnelAddress",
+	PGWChangeInfo:                            "PGWChangeInfo",
+	PGWSetFQDN:                               "PGWSetFQDN",
+	GroupId:                                  "GroupId",
+	PSCellID:                                 "PSCellID",
+	UPSecurityPolicy:                         "UPSecurityPolicy",
+	AlternativeIMSI:                          "AlternativeIMSI",
+	ExtensionType:                            "ExtensionType",
+	PrivateExtension:                         "PrivateExtension",
+}
+
+var groupedIETypes = map[IEType]bool{
+	BearerContext:              true,
+	PDNConnection:              true,
+	OverloadControlInformation: true,
+	LoadControlInformation:     true,
+	RemoteUEContext:            true,
+	SCEFPDNConnection:          true,
+	V2XContext:                 true,
+	PC5QoSParameters:           true,
+	PGWChangeInfo:              true,
+}
+
+var mapOfYamlIETypeToIEType = map[string]IEType{
+	"InternationalMobileSubscriberIdentity":  InternationalMobileSubscriberIdentity,
+	"IMSI":                                   InternationalMobileSubscriberIdentity,
+	"Cause":                                  Cause,
+	"RecoveryRestartCounter":                 RecoveryRestartCounter,
+	"STNSR":                                  STNSR,
+	"AccessPointName":                        AccessPointName,
+	"APN":                                    AccessPointName,
+	"AggregateMaximumBitRate":                AggregateMaximumBitRate,
+	"AMBR":                                   AggregateMaximumBitRate,
+	"EPSBearerID":                            EPSBearerID,
+	"EBI":                                    EPSBearerID,
+	"IPAddress":                              IPAddress,
+	"MobileEquipmentIdentity":                MobileEquipmentIdentity,
+	"MEI":                                    MobileEquipmentIdentity,
+	"MSISDN":                                 MSISDN,
+	"Indication":                             Indication,
+	"ProtocolConfigurationOptions":           ProtocolConfigurationOptions,
+	"PCI":                                    ProtocolConfigurationOptions,
+	"PDNAddressAllocation":                   PDNAddressAllocation,
+	"PAA":                                    PDNAddressAllocation,
+	"BearerLevelQualityofService":            BearerLevelQualityofService,
+	"BearerQoS":                              BearerLevelQualityofService,
+	"FlowQualityofService":                   FlowQualityofService,
+	"FlowQoS":                                FlowQualityofService,
+	"RATType":                                RATType,
+	"ServingNetwork":                         ServingNetwork,
+	"EPSBearerLevelTrafficFlowTemplate":      EPSBearerLevelTrafficFlowTemplate,
+	"BearerTFT":                              EPSBearerLevelTrafficFlowTemplate,
+	"TrafficAggregationDescription":          TrafficAggregationDescription,
+	"TAD":                                    TrafficAggregationDescription,
+	"UserLocationInformation":                UserLocationInformation,
+	"ULI":                                    UserLocationInformation,
+	"FullyQualifiedTunnelEndpointIdentifier": FullyQualifiedTunnelEndpointIdentifier,
+	"FTEID":                                  FullyQualifiedTunnelEndpointIdentifier,
+	"F-TEID":                                 FullyQualifiedTunnelEndpointIdentifier,
+	"TMSI":                                   TMSI,
+	"GlobalCNId":                             GlobalCNId,
+	"S103PDNDataForwardingInfo":              S103PDNDataForwardingInfo,
+	"S103PDF":                                S103PDNDataForwardingInfo,
+	"S1UDataForwardingInfo":                  S1UDataForwardingInfo,
+	"S1UDF":                                  S1UDataForwardingInfo,
+	"DelayValue":                             DelayValue,
+	"BearerContext":                          BearerContext,
+	"ChargingID":                             ChargingID,
+	"ChargingCharacteristics":                ChargingCharacteristics,
+	"TraceInformation":                       TraceInformation,
+	"BearerFlags":                            BearerFlags,
+	"PDNType":                                PDNType,
+	"ProcedureTransactionID":                 ProcedureTransactionID,
+	"MMContextGSMKeyandTriplets":             MMContextGSMKeyandTriplets,
+	"MMContextUMTSKeyUsedCipherandQuintuplets":             MMContextUMTSKeyUsedCipherandQuintuplets,
+	"MMContextGSMKeyUsedCipherandQuintuplets":              MMContextGSMKeyUsedCipherandQuintuplets,
+	"MMContextUMTSKeyandQuintuplets":                       MMContextUMTSKeyandQuintuplets,
+	"MMContextEPSSecurityContextQuadrupletsandQuintuplets": MMContextEPSSecurityContextQuadrupletsandQuintuplets,
+	"MMContextUMTSKeyQuadrupletsandQuintuplets":            MMContextUMTSKeyQuadrupletsandQuintuplets,
+	"PDNConnection":            PDNConnection,
+	"PDUNumbers":               PDUNumbers,
+	"PTMSI":                    PTMSI,
+	"PTMSISignature":           PTMSISignature,
+	"HopCounter":               HopCounter,
+	"UETimeZone":               UETimeZone,
+	"TraceReference":           TraceReference,
+	"CompleteRequestMessage":   CompleteRequestMessage,
+	"GUTI":                     GUTI,
+	"FContainer":               FContainer,
+	"FCause":                   FCause,
+	"PLMNID":                   PLMNID,
+	"TargetIdentification":     TargetIdentification,
+	"PacketFlowID":             PacketFlowID,
+	"RABContext":               RABContext,
+	"SourceRNCPDCPContextInfo": SourceRNCPDCPContextInfo,
+	"PortNumber":               PortNumber,
+	"APNRestriction":           APNRestriction,
+	"SelectionMode":            SelectionMode,
+	"SourceIdentification":     SourceIdentification,
+	"ChangeReportingAction":    ChangeReportingAction,
+	"FullyQualifiedPDNConnectionSetIdentifier": FullyQualifiedPDNConnectionSetIdentifier,
+	"FQCSID":                                 FullyQualifiedPDNConnectionSetIdentifier,
+	"FQ-CSID":                                FullyQualifiedPDNConnectionSetIdentifier,
+	"Channelneeded":                          Channelneeded,
+	"eMLPPPriority":                          eMLPPPriority,
+	"NodeType":                               NodeType,
+	"FullyQualifiedDomainName":               FullyQualifiedDomainName,
+	"FQDN":                                   FullyQualifiedDomainName,
+	"TransactionIdentifier":                  TransactionIdentifier,
+	"TI":                                     TransactionIdentifier,
+	"MBMSSessionDuration":                    MBMSSessionDuration,
+	"MBMSServiceArea":                        MBMSServiceArea,
+	"MBMSSessionIdentifier":                  MBMSSessionIdentifier,
+	"MBMSFlowIdentifier":                     MBMSFlowIdentifier,
+	"MBMSIPMulticastDistribution":            MBMSIPMulticastDistribution,
+	"MBMSDistributionAcknowledge":            MBMSDistributionAcknowledge,
+	"RFSPIndex":                              RFSPIndex,
+	"UserCSGInformation":                     UserCSGInformation,
+	"UCI":                                    UserCSGInformation,
+	"CSGInformationReportingAction":          CSGInformationReportingAction,
+	"CSGID":                                  CSGID,
+	"CSGMembershipIndication":                CSGMembershipIndication,
+	"CMI":                                    CSGMembershipIndication,
+	"Serviceindicator":                       Serviceindicator,
+	"DetachType":                             DetachType,
+	"LocalDistinguishedName":                 LocalDistinguishedName,
+	"LocalDistiguishedName":                  LocalDistinguishedName,
+	"LDN":                                    LocalDistinguishedName,
+	"NodeFeatures":                           NodeFeatures,
+	"MBMSTimetoDataTransfer":                 MBMSTimetoDataTransfer,
+	"Throttling":                             Throttling,
+	"AllocationRetentionPriority":            AllocationRetentionPriority,
+	"ARP":                                    AllocationRetentionPriority,
+	"EPCTimer":                               EPCTimer,
+	"SignallingPriorityIndication":           SignallingPriorityIndication,
+	"TemporaryMobileGroupIdentity":           TemporaryMobileGroupIdentity,
+	"TMGI":                                   TemporaryMobileGroupIdentity,
+	"AdditionalMMcontextforSRVCC":            AdditionalMMcontextforSRVCC,
+	"AdditionalflagsforSRVCC":                AdditionalflagsforSRVCC,
+	"MDTConfiguration":                       MDTConfiguration,
+	"AdditionalProtocolConfigurationOptions": AdditionalProtocolConfigurationOptions,
+	"APCO":                                   AdditionalProtocolConfigurationOptions,
+	"AbsoluteTimeofMBMSDataTransfer":         AbsoluteTimeofMBMSDataTransfer,
+	"HeNBInformationReporting":               HeNBInformationReporting,
+	"IPv4ConfigurationParameters":            IPv4ConfigurationParameters,
+	"IP4CP":                                  IPv4ConfigurationParameters,
+	"ChangetoReportFlags":                    ChangetoReportFlags,
+	"ActionIndication":                       ActionIndication,
+	"TWANIdentifier":                         TWANIdentifier,
+	"ULITimestamp":                           ULITimestamp,
+	"MBMSFlags":                              MBMSFlags,
+	"RANNASCause":                            RANNASCause,
+	"CNOperatorSelectionEntity":              CNOperatorSelectionEntity,
+	"TrustedWLANModeIndication":              TrustedWLANModeIndication,
+	"NodeNumber":                             NodeNumber,
+	"NodeIdentifier":                         NodeIdentifier,
+	"PresenceReportingAreaAction":            PresenceReportingAreaAction,
+	"PresenceReportingAreaInformation":       PresenceReportingAreaInformation,
+	"TWANIdentifierTimestamp":                TWANIdentifierTimestamp,
+	"OverloadControlInformation":             OverloadControlInformation,
+	"LoadControlInformation":                 LoadControlInformation,
+	"Metric":                                 Metric,
+	"SequenceNumber":                         SequenceNumber,
+	"APNandRelativeCapacity":                 APNandRelativeCapacity,
+	"WLANOffloadabilityIndication":           WLANOffloadabilityIndication,
+	"PagingandServiceInformation":            PagingandServiceInformation,
+	"IntegerNumber":                          IntegerNumber,
+	"MillisecondTimeStamp":                   MillisecondTimeStamp,
+	"MonitoringEventInformation":             MonitoringEventInformation,
+	"ECGIList":                               ECGIList,
+	"RemoteUEContext":                        RemoteUEContext,
+	"RemoteUserID":                           RemoteUserID,
+	"RemoteUEIPinformation":                  RemoteUEIPinformation,
+	"CIoTOptimizationsSupportIndication":     CIoTOptimizationsSupportIndication,
+	"SCEFPDNConnection":                      SCEFPDNConnection,
+	"HeaderCompressionConfiguration":         HeaderCompressionConfiguration,
+	"ExtendedProtocolConfigurationOptions":   ExtendedProtocolConfigurationOptions,
+	"ePCO":                                   ExtendedProtocolConfigurationOptions,
+	"ServingPLMNRateControl":                 ServingPLMNRateControl,
+	"Counter":                                Counter,
+	"MappedUEUsageType":                      MappedUEUsageType,
+	"SecondaryRATUsageDataReport":            SecondaryRATUsageDataReport,
+	"UPFunctionSelectionIndicationFlags":     UPFunctionSelectionIndicationFlags,
+	"MaximumPacketLossRate":                  MaximumPacketLossRate,
+	"APNRateControlStatus":                   APNRateControlStatus,
+	"ExtendedTraceInformation":               ExtendedTraceInformation,
+	"MonitoringEventExtensionInformation":    MonitoringEventExtensionInformation,
+	"AdditionalRRMPolicyIndex":               AdditionalRRMPolicyIndex,
+	"V2XContext":                             V2XContext,
+	"PC5QoSParameters":                       PC5QoSParameters,
+	"ServicesAuthorized":                     ServicesAuthorized,
+	"BitRate":                                BitRate,
+	"PC5QoSFlow":                             PC5QoSFlow,
+	"SGiPtPTunnelAddress":                    SGiPtPTunnelAddress,
+	"PGWChangeInfo":                          PGWChangeInfo,
+	"PGWSetFQDN":                             PGWSetFQDN,
+	"GroupId":                                GroupId,
+	"PSCellID":                               PSCellID,
+	"UPSecurityPolicy":                       UPSecurityPolicy,
+	"AlternativeIMSI":                        AlternativeIMSI,
+	"ExtensionType":                          ExtensionType,
+	"PrivateExtension":                       PrivateExtension,
+}
+
+var messageNames = []string{
+	"Reserved",                           // 0
+	"Echo Request",                       // 1
+	"Echo Response",                      // 2
+	"Version Not Supported Indication",   // 3
+	"Reserved",                           // 4
+	"Reserved",                           // 5
+	"Reserved",                           // 6
+	"Reserved",                           // 7
+	"Reserved",                           // 8
+	"Reserved",                           // 9
+	"Reserved",                           // 10
+	"Reserved",                           // 11
+	"Reserved",                           // 12
+	"Reserved",                           // 13
+	"Reserved",                           // 14
+	"Reserved",                           // 15
+	"Reserved",                           // 16
+	"Reserved",                           // 17
+	"Reserved",                           // 18
+	"Reserved",                           // 19
+	"Reserved",                           // 20
+	"Reserved",                           // 21
+	"Reserved",                           // 22
+	"Reserved",                           // 23
+	"Reserved",                           // 24
+	"Reserved",                           // 25
+	"Reserved",                           // 26
+	"Reserved",                           // 27
+	"Reserved",                           // 28
+	"Reserved",                           // 29
+	"Reserved",                           // 30
+	"Reserved",                           // 31
+	"Create Session Request",             // 32
+	"Create Session Response",            // 33
+	"Modify Bearer Request",              // 34
+	"Modify Bearer Response",             // 35
+	"Delete Session Request",             // 36
+	"Delete Session Response",            // 37
+	"Change Notification Request",        // 38
+	"Change Notification Response",       // 39
+	"Remote UE Report Notification",      // 40
+	"Remote UE Report Acknowledge",       // 41
+	"Reserved",                           // 42
+	"Reserved",                           // 43
+	"Reserved",                           // 44
+	"Reserved",                           // 45
+	"Reserved",                           // 46
+	"Reserved",                           // 47
+	"Reserved",                           // 48
+	"Reserved",                           // 49
+	"Reserved",                           // 50
+	"Reserved",                           // 51
+	"Reserved",                           // 52
+	"Reserved",                           // 53
+	"Reserved",                           // 54
+	"Reserved",                           // 55
+	"Reserved",                           // 56
+	"Reserved",                           // 57
+	"Reserved",                           // 58
+	"Reserved",                           // 59
+	"Reserved",                           // 60
+	"Reserved",                           // 61
+	"Reserved",                           // 62
+	"Reserved",                           // 63
+	"Modify Bearer Command",              // 64
+	"Modify Bearer Failure Indication",   // 65
+	"Delete Bearer Command",              // 66
+	"Delete Bearer Failure Indication",   // 67
+	"Bearer Resource Command",            // 68
+	"Bearer Resource Failure Indication", // 69
+	"Downlink Data Notification Failure Indication", // 70
+	"Trace Session Activation",                      // 71
+	"Trace Session Deactivation",                    // 72
+	"Stop Paging Indication",                        // 73
+	"Reserved",                                      // 74
+	"Reserved",                                      // 75
+	"Reserved",                                      // 76
+	"Reserved",                                      // 77
+	"Reserved",                                      // 78
+	"Reserved",                                      // 79
+	"Reserved",                                      // 80
+	"Reserved",                                      // 81
+	"Reserved",                                      // 82
+	"Reserved",                                      // 83
+	"Reserved",                                      // 84
+	"Reserved",                                      // 85
+	"Reserved",                                      // 86
+	"Reserved",                                      // 87
+	"Reserved",                                      // 88
+	"Reserved",                                      // 89
+	"Reserved",                                      // 90
+	"Reserved",                                      // 91
+	"Reserved",                                      // 92
+	"Reserved",                                      // 93
+	"Reserved",                                      // 94
+	"Create Bearer Request",                         // 95
+	"Create Bearer Response",                        // 96
+	"Update Bearer Request",                         // 97
+	"Update Bearer Response",                        // 98
+	"Delete Bearer Request",                         // 99
+	"Delete Bearer Response",                        // 100
+	"Delete PDN Connection Set Request",             // 101
+	"Delete PDN Connection Set Response",            // 102
+	"PGW Downlink Triggering Notification",          // 103
+	"PGW Downlink Triggering Acknowledge",           // 104
+	"Reserved",                                      // 105
+	"Reserved",                                      // 106
+	"Reserved",                                      // 107
+	"Reserved",                                      // 108
+	"Reserved",                                      // 109
+	"Reserved",                                      // 110
+	"Reserved",                                      // 111
+	"Reserved",                                      // 112
+	"Reserved",                                      // 113
+	"Reserved",                                      // 114
+	"Reserved",                                      // 115
+	"Reserved",                                      // 116
+	"Reserved",                                      // 117
+	"Reserved",                                      // 118
+	"Reserved",                                      // 119
+	"Reserved",                                      // 120
+	"Reserved",                                      // 121
+	"Reserved",                                      // 122
+	"Reserved",                                      // 123
+	"Reserved",                                      // 124
+	"Reserved",                                      // 125
+	"Reserved",                                      // 126
+	"Reserved",                                      // 127
+	"Identification Request",                        // 128
+	"Identification Response",                       // 129
+	"Context Request",                               // 130
+	"Context Response",                              // 131
+	"Context Acknowledge",                           // 132
+	"Forward Relocation Request",                    // 133
+	"Forward Relocation Response",                   // 134
+	"Forward Relocation Complete Notification",        // 135
+	"Forward Relocation Complete Acknowledge",         // 136
+	"Forward Access Context Notification",             // 137
+	"Forward Access Context Acknowledge",              // 138
+	"Relocation Cancel Request",                       // 139
+	"Relocation Cancel Response",                      // 140
+	"Configuration Transfer Tunnel",                   // 141
+	"Reserved",                                        // 142
+	"Reserved",                                        // 143
+	"Reserved",                                        // 144
+	"Reserved",                                        // 145
+	"Reserved",                                        // 146
+	"Reserved",                                        // 147
+	"Reserved",                                        // 148
+	"Detach Notification",                             // 149
+	"Detach Acknowledge",                              // 150
+	"CS Paging Indication",                            // 151
+	"RAN Information Relay",                           // 152
+	"Alert MME Notification",                          // 153
+	"Alert MME Acknowledge",                           // 154
+	"UE Activity Notification",                        // 155
+	"UE Activity Acknowledge",                         // 156
+	"ISR Status Indication",                           // 157
+	"UE Registration Query Request",                   // 158
+	"UE Registration Query Response",                  // 159
+	"Create Forwarding Tunnel Request",                // 160
+	"Create Forwarding Tunnel Response",               // 161
+	"Suspend Notification",                            // 162
+	"Suspend Acknowledge",                             // 163
+	"Resume Notification",                             // 164
+	"Resume Acknowledge",                              // 165
+	"Create Indirect Data Forwarding Tunnel Request",  // 166
+	"Create Indirect Data Forwarding Tunnel Response", // 167
+	"Delete Indirect Data Forwarding Tunnel Request",  // 168
+	"Delete Indirect Data Forwarding Tunnel Response", // 169
+	"Release Access Bearers Request",                  // 170
+	"Release Access Bearers Response",                 // 171
+	"Reserved",                                        // 172
+	"Reserved",                                        // 173
+	"Reserved",                                        // 174
+	"Reserved",                                        // 175
+	"Downlink Data Notification",                      // 176
+	"Downlink Data Notification Acknowledge",          // 177
+	"Reserved",                                        // 178
+	"PGW Restart Notification",                        // 179
+	"PGW Restart Notification Acknowledge",            // 180
+	"Reserved",                                        // 181
+	"Reserved",                                        // 182
+	"Reserved",                                        // 183
+	"Reserved",                                        // 184
+	"Reserved",                                        // 185
+	"Reserved",                                        // 186
+	"Reserved",                                        // 187
+	"Reserved",                                        // 188
+	"Reserved",                                        // 189
+	"Reserved",                                        // 190
+	"Reserved",                                        // 191
+	"Reserved",                                        // 192
+	"Reserved",                                        // 193
+	"Reserved",                                        // 194
+	"Reserved",                                        // 195
+	"Reserved",                                        // 196
+	"Reserved",                                        // 197
+	"Reserved",                                        // 198
+	"Reserved",                                        // 199
+	"Update PDN Connection Set Request",               // 200
+	"Update PDN Connection Set Response",              // 201
+	"Reserved",                                        // 202
+	"Reserved",                                        // 203
+	"Reserved",                                        // 204
+	"Reserved",                                        // 205
+	"Reserved",                                        // 206
+	"Reserved",                                        // 207
+	"Reserved",                                        // 208
+	"Reserved",                                        // 209
+	"Reserved",                                        // 210
+	"Modify Access Bearers Request",                   // 211
+	"Modify Access Bearers Response",                  // 212
+	"Reserved",                                        // 213
+	"Reserved",                                        // 214
+	"Reserved",                                        // 215
+	"Reserved",                                        // 216
+	"Reserved",                                        // 217
+	"Reserved",                                        // 218
+	"Reserved",                                        // 219
+	"Reserved",                                        // 220
+	"Reserved",                                        // 221
+	"Reserved",                                        // 222
+	"Reserved",                                        // 223
+	"Reserved",                                        // 224
+	"Reserved",                                        // 225
+	"Reserved",                                        // 226
+	"Reserved",                                        // 227
+	"Reserved",                                        // 228
+	"Reserved",                                        // 229
+	"Reserved",                                        // 230
+	"MBMS Session Start Request",                      // 231
+	"MBMS Session Start Response",                     // 232
+	"MBMS Session Update Request",                     // 233
+	"MBMS Session Update Response",                    // 234
+	"MBMS Session Stop Request",                       // 235
+	"MBMS Session Stop Response",                      // 236
+	"Reserved",                                        // 237
+	"Reserved",                                        // 238
+	"Reserved",                                        // 239
+	"Reserved",                                        // 240
+	"Reserved",                                        // 241
+	"Reserved",                                        // 242
+	"Reserved",                                        // 243
+	"Reserved",                                        // 244
+	"Reserved",                                        // 245
+	"Reserved",                                        // 246
+	"Reserved",                                        // 247
+	"Reserved",                                        // 248
+	"Reserved",                                        // 249
+	"Reserved",                                        // 250
+	"Reserved",                                        // 251
+	"Reserved",                                        // 252
+	"Reserved",                                        // 253
+	"Reserved",                                        // 254
+	"Reserved",                                        // 255
+}
+
+var messageTypeReleases = map[MessageType]Release{
+	EchoRequest:                                Release8,
+	EchoResponse:                               Release8,
+	VersionNotSupportedIndication:              Release8,
+	CreateSessionRequest:                       Release8,
+	CreateSessionResponse:                      Release8,
+	ModifyBearerRequest:                        Release8,
+	ModifyBearerResponse:                       Release8,
+	DeleteSessionRequest:                       Release8,
+	DeleteSessionResponse:                      Release8,
+	ChangeNotificationRequest:                  Release8,
+	ChangeNotificationResponse:                 Release8,
+	RemoteUEReportNotification:                 Release13,
+	RemoteUEReportAcknowledge:                  Release13,
+	ModifyBearerCommand:                        Release8,
+	ModifyBearerFailureIndication:              Release8,
+	DeleteBearerCommand:                        Release8,
+	DeleteBearerFailureIndication:              Release8,
+	BearerResourceCommand:                      Release8,
+	BearerResourceFailureIndication:            Release8,
+	DownlinkDataNotificationFailureIndication:  Release9,
+	TraceSessionActivation:                     Release8,
+	TraceSessionDeactivation:                   Release8,
+	StopPagingIndication:                       Release8,
+	CreateBearerRequest:                        Release8,
+	CreateBearerResponse:                       Release8,
+	UpdateBearerRequest:                        Release8,
+	UpdateBearerResponse:                       Release8,
+	DeleteBearerRequest:                        Release8,
+	DeleteBearerResponse:                       Release8,
+	DeletePDNConnectionSetRequest:              Release8,
+	DeletePDNConnectionSetResponse:             Release8,
+	PGWDownlinkTriggeringNotification:          Release12,
+	PGWDownlinkTriggeringAcknowledge:           Release12,
+	IdentificationRequest:                      Release8,
+	IdentificationResponse:                     Release8,
+	ContextRequest:                             Release8,
+	ContextResponse:                            Release8,
+	ContextAcknowledge:                         Release8,
+	ForwardRelocationRequest:                   Release8,
+	ForwardRelocationResponse:                  Release8,
+	ForwardRelocationCompleteNotification:      Release8,
+	ForwardRelocationCompleteAcknowledge:       Release8,
+	ForwardAccessContextNotification:           Release8,
+	ForwardAccessContextAcknowledge:            Release8,
+	RelocationCancelRequest:                    Release8,
+	RelocationCancelResponse:                   Release8,
+	ConfigurationTransferTunnel:                Release9,
+	DetachNotification:                         Release8,
+	DetachAcknowledge:                          Release8,
+	CSPagingIndication:                         Release8,
+	RANInformationRelay:                        Release8,
+	AlertMMENotification:                       Release8,
+	AlertMMEAcknowledge:                        Release8,
+	UEActivityNotification:                     Release8,
+	UEActivityAcknowledge:                      Release8,
+	ISRStatusIndication:                        Release11,
+	UERegistrationQueryRequest:                 Release11,
+	UERegistrationQueryResponse:                Release11,
+	CreateForwardingTunnelRequest:              Release8,
+	CreateForwardingTunnelResponse:             Release8,
+	SuspendNotification:                        Release8,
+	SuspendAcknowledge:                         Release8,
+	ResumeNotification:                         Release8,
+	ResumeAcknowledge:                          Release8,
+	CreateIndirectDataForwardingTunnelRequest:  Release8,
+	CreateIndirectDataForwardingTunnelResponse: Release8,
+	DeleteIndirectDataForwardingTunnelRequest:  Release8,
+	DeleteIndirectDataForwardingTunnelResponse: Release8,
+	ReleaseAccessBearersRequest:                Release8,
+	ReleaseAccessBearersResponse:               Release8,
+	DownlinkDataNotification:                   Release8,
+	DownlinkDataNotificationAcknowledge:        Release8,
+	PGWRestartNotification:                     Release9,
+	PGWRestartNotificationAcknowledge:          Release9,
+	UpdatePDNConnectionSetRequest:              Release10,
+	UpdatePDNConnectionSetResponse:             Release10,
+	ModifyAccessBearersRequest:                 Release11,
+	ModifyAccessBearersResponse:                Release11,
+	MBMSSessionStartRequest:                    Release9,
+	MBMSSessionStartResponse:                   Release9,
+	MBMSSessionUpdateRequest:                   Release9,
+	MBMSSessionUpdateResponse:                  Release9,
+	MBMSSessionStopRequest:                     Release9,
+	MBMSSessionStopResponse:                    Release9,
+}
+
+var messageTypeClasses = map[MessageType]MessageClass{
+	EchoRequest:                                MessageClassRequest,
+	EchoResponse:                               MessageClassResponse,
+	VersionNotSupportedIndication:              MessageClassIndication,
+	CreateSessionRequest:                       MessageClassRequest,
+	CreateSessionResponse:                      MessageClassResponse,
+	ModifyBearerRequest:                        MessageClassRequest,
+	ModifyBearerResponse:                       MessageClassResponse,
+	DeleteSessionRequest:                       MessageClassRequest,
+	DeleteSessionResponse:                      MessageClassResponse,
+	ChangeNotificationRequest:                  MessageClassRequest,
+	ChangeNotificationResponse:                 MessageClassResponse,
+	RemoteUEReportNotification:                 MessageClassNotification,
+	RemoteUEReportAcknowledge:                  MessageClassAcknowledge,
+	ModifyBearerCommand:                        MessageClassCommand,
+	ModifyBearerFailureIndication:              MessageClassFailureIndication,
+	DeleteBearerCommand:                        MessageClassCommand,
+	DeleteBearerFailureIndication:              MessageClassFailureIndication,
+	BearerResourceCommand:                      MessageClassCommand,
+	BearerResourceFailureIndication:            MessageClassFailureIndication,
+	DownlinkDataNotificationFailureIndication:  MessageClassIndication,
+	TraceSessionActivation:                     MessageClassIndication,
+	TraceSessionDeactivation:                   MessageClassIndication,
+	StopPagingIndication:                       MessageClassIndication,
+	CreateBearerRequest:                        MessageClassRequest,
+	CreateBearerResponse:                       MessageClassResponse,
+	UpdateBearerRequest:                        MessageClassRequest,
+	UpdateBearerResponse:                       MessageClassResponse,
+	DeleteBearerRequest:                        MessageClassRequest,
+	DeleteBearerResponse:                       MessageClassResponse,
+	DeletePDNConnectionSetRequest:              MessageClassRequest,
+	DeletePDNConnectionSetResponse:             MessageClassResponse,
+	PGWDownlinkTriggeringNotification:          MessageClassNotification,
+	PGWDownlinkTriggeringAcknowledge:           MessageClassAcknowledge,
+	IdentificationRequest:                      MessageClassRequest,
+	IdentificationResponse:                     MessageClassResponse,
+	ContextRequest:                             MessageClassRequest,
+	ContextResponse:                            MessageClassResponse,
+	ContextAcknowledge:                         MessageClassAcknowledge,
+	ForwardRelocationRequest:                   MessageClassRequest,
+	ForwardRelocationResponse:                  MessageClassResponse,
+	ForwardRelocationCompleteNotification:      MessageClassNotification,
+	ForwardRelocationCompleteAcknowledge:       MessageClassAcknowledge,
+	ForwardAccessContextNotification:           MessageClassNotification,
+	ForwardAccessContextAcknowledge:            MessageClassAcknowledge,
+	RelocationCancelRequest:                    MessageClassRequest,
+	RelocationCancelResponse:                   MessageClassResponse,
+	ConfigurationTransferTunnel:                MessageClassIndication,
+	DetachNotification:                         MessageClassNotification,
+	DetachAcknowledge:                          MessageClassAcknowledge,
+	CSPagingIndication:                         MessageClassIndication,
+	RANInformationRelay:                        MessageClassIndication,
+	AlertMMENotification:                       MessageClassNotification,
+	AlertMMEAcknowledge:                        MessageClassAcknowledge,
+	UEActivityNotification:                     MessageClassNotification,
+	UEActivityAcknowledge:                      MessageClassAcknowledge,
+	ISRStatusIndication:                        MessageClassIndication,
+	UERegistrationQueryRequest:                 MessageClassRequest,
+	UERegistrationQueryResponse:                MessageClassResponse,
+	CreateForwardingTunnelRequest:              MessageClassRequest,
+	CreateForwardingTunnelResponse:             MessageClassResponse,
+	SuspendNotification:                        MessageClassNotification,
+	SuspendAcknowledge:                         MessageClassAcknowledge,
+	ResumeNotification:                         MessageClassNotification,
+	ResumeAcknowledge:                          MessageClassAcknowledge,
+	CreateIndirectDataForwardingTunnelRequest:  MessageClassRequest,
+	CreateIndirectDataForwardingTunnelResponse: MessageClassResponse,
+	DeleteIndirectDataForwardingTunnelRequest:  MessageClassRequest,
+	DeleteIndirectDataForwardingTunnelResponse: MessageClassResponse,
+	ReleaseAccessBearersRequest:                MessageClassRequest,
+	ReleaseAccessBearersResponse:               MessageClassResponse,
+	DownlinkDataNotification:                   MessageClassNotification,
+	DownlinkDataNotificationAcknowledge:        MessageClassAcknowledge,
+	PGWRestartNotification:                     MessageClassNotification,
+	PGWRestartNotificationAcknowledge:          MessageClassAcknowledge,
+	UpdatePDNConnectionSetRequest:              MessageClassRequest,
+	UpdatePDNConnectionSetResponse:             MessageClassResponse,
+	ModifyAccessBearersRequest:                 MessageClassRequest,
+	ModifyAccessBearersResponse:                MessageClassResponse,
+	MBMSSessionStartRequest:                    MessageClassRequest,
+	MBMSSessionStartResponse:                   MessageClassResponse,
+	MBMSSessionUpdateRequest:                   MessageClassRequest,
+	MBMSSessionUpdateResponse:                  MessageClassResponse,
+	MBMSSessionStopRequest:                     MessageClassRequest,
+	MBMSSessionStopResponse:                    MessageClassResponse,
+}
+
+var messageTypeResponses = map[MessageType]MessageType{
+	EchoRequest:                               EchoResponse,
+	CreateSessionRequest:                      CreateSessionResponse,
+	ModifyBearerRequest:                       ModifyBearerResponse,
+	DeleteSessionRequest:                      DeleteSessionResponse,
+	ChangeNotificationRequest:                 ChangeNotificationResponse,
+	RemoteUEReportNotification:                RemoteUEReportAcknowledge,
+	ModifyBearerCommand:                       ModifyBearerFailureIndication,
+	DeleteBearerCommand:                       DeleteBearerFailureIndication,
+	BearerResourceCommand:                     BearerResourceFailureIndication,
+	CreateBearerRequest:                       CreateBearerResponse,
+	UpdateBearerRequest:                       UpdateBearerResponse,
+	DeleteBearerRequest:                       DeleteBearerResponse,
+	DeletePDNConnectionSetRequest:             DeletePDNConnectionSetResponse,
+	PGWDownlinkTriggeringNotification:         PGWDownlinkTriggeringAcknowledge,
+	IdentificationRequest:                     IdentificationResponse,
+	ContextRequest:                            ContextResponse,
+	ContextResponse:                           ContextAcknowledge,
+	ForwardRelocationRequest:                  ForwardRelocationResponse,
+	ForwardRelocationCompleteNotification:     ForwardRelocationCompleteAcknowledge,
+	ForwardAccessContextNotification:          ForwardAccessContextAcknowledge,
+	RelocationCancelRequest:                   RelocationCancelResponse,
+	DetachNotification:                        DetachAcknowledge,
+	AlertMMENotification:                      AlertMMEAcknowledge,
+	UEActivityNotification:                    UEActivityAcknowledge,
+	UERegistrationQueryRequest:                UERegistrationQueryResponse,
+	CreateForwardingTunnelRequest:             CreateForwardingTunnelResponse,
+	SuspendNotification:                       SuspendAcknowledge,
+	ResumeNotification:                        ResumeAcknowledge,
+	CreateIndirectDataForwardingTunnelRequest: CreateIndirectDataForwardingTunnelResponse,
+	DeleteIndirectDataForwardingTunnelRequest: DeleteIndirectDataForwardingTunnelResponse,
+	ReleaseAccessBearersRequest:               ReleaseAccessBearersResponse,
+	DownlinkDataNotification:                  DownlinkDataNotificationAcknowledge,
+	PGWRestartNotification:                    PGWRestartNotificationAcknowledge,
+	UpdatePDNConnectionSetRequest:             UpdatePDNConnectionSetResponse,
+	ModifyAccessBearersRequest:                ModifyAccessBearersResponse,
+	MBMSSessionStartRequest:                   MBMSSessionStartResponse,
+	MBMSSessionUpdateRequest:                  MBMSSessionUpdateResponse,
+	MBMSSessionStopRequest:                    MBMSSessionStopResponse,
+}
+
+var messageTypeTriggers = map[MessageType][]MessageType{
+	ModifyBearerCommand:   {UpdateBearerRequest},
+	DeleteBearerCommand:   {DeleteBearerRequest},
+	BearerResourceCommand: {CreateBearerRequest, UpdateBearerRequest, DeleteBearerRequest},
+}
+
+var messageTypeInterfaces = map[MessageType][]Interface{
+	EchoRequest:                                {InterfaceS2a, InterfaceS2b, InterfaceS3, InterfaceS4, InterfaceS5S8, InterfaceS10, InterfaceS11, InterfaceS16, InterfaceSm, InterfaceSn},
+	EchoResponse:                               {InterfaceS2a, InterfaceS2b, InterfaceS3, InterfaceS4, InterfaceS5S8, InterfaceS10, InterfaceS11, InterfaceS16, InterfaceSm, InterfaceSn},
+	VersionNotSupportedIndication:              {InterfaceS2a, InterfaceS2b, InterfaceS3, InterfaceS4, InterfaceS5S8, InterfaceS10, InterfaceS11, InterfaceS16, InterfaceSm, InterfaceSn},
+	CreateSessionRequest:                       {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	CreateSessionResponse:                      {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	ModifyBearerRequest:                        {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	ModifyBearerResponse:                       {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	DeleteSessionRequest:                       {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	DeleteSessionResponse:                      {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	ChangeNotificationRequest:                  {InterfaceS4, InterfaceS5S8, InterfaceS11},
+	ChangeNotificationResponse:                 {InterfaceS4, InterfaceS5S8, InterfaceS11},
+	RemoteUEReportNotification:                 {InterfaceS5S8, InterfaceS11},
+	RemoteUEReportAcknowledge:                  {InterfaceS5S8, InterfaceS11},
+	ModifyBearerCommand:                        {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	ModifyBearerFailureIndication:              {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	DeleteBearerCommand:                        {InterfaceS2a, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	DeleteBearerFailureIndication:              {InterfaceS2a, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	BearerResourceCommand:                      {InterfaceS4, InterfaceS5S8, InterfaceS11},
+	BearerResourceFailureIndication:            {InterfaceS4, InterfaceS5S8, InterfaceS11},
+	DownlinkDataNotificationFailureIndication:  {InterfaceS4, InterfaceS11},
+	TraceSessionActivation:                     {InterfaceS4, InterfaceS5S8, InterfaceS11},
+	TraceSessionDeactivation:                   {InterfaceS4, InterfaceS5S8, InterfaceS11},
+	StopPagingIndication:                       {InterfaceS4, InterfaceS11},
+	CreateBearerRequest:                        {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	CreateBearerResponse:                       {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	UpdateBearerRequest:                        {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	UpdateBearerResponse:                       {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	DeleteBearerRequest:                        {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	DeleteBearerResponse:                       {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	DeletePDNConnectionSetRequest:              {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	DeletePDNConnectionSetResponse:             {InterfaceS2a, InterfaceS2b, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	PGWDownlinkTriggeringNotification:          {InterfaceS4, InterfaceS5S8, InterfaceS11},
+	PGWDownlinkTriggeringAcknowledge:           {InterfaceS4, InterfaceS5S8, InterfaceS11},
+	IdentificationRequest:                      {InterfaceS3, InterfaceS10, InterfaceS16},
+	IdentificationResponse:                     {InterfaceS3, InterfaceS10, InterfaceS16},
+	ContextRequest:                             {InterfaceS3, InterfaceS10, InterfaceS16},
+	ContextResponse:                            {InterfaceS3, InterfaceS10, InterfaceS16},
+	ContextAcknowledge:                         {InterfaceS3, InterfaceS10, InterfaceS16},
+	ForwardRelocationRequest:                   {InterfaceS3, InterfaceS10, InterfaceS16},
+	ForwardRelocationResponse:                  {InterfaceS3, InterfaceS10, InterfaceS16},
+	ForwardRelocationCompleteNotification:      {InterfaceS3, InterfaceS10, InterfaceS16},
+	ForwardRelocationCompleteAcknowledge:       {InterfaceS3, InterfaceS10, InterfaceS16},
+	ForwardAccessContextNotification:           {InterfaceS10, InterfaceS16},
+	ForwardAccessContextAcknowledge:            {InterfaceS10, InterfaceS16},
+	RelocationCancelRequest:                    {InterfaceS3, InterfaceS10, InterfaceS16},
+	RelocationCancelResponse:                   {InterfaceS3, InterfaceS10, InterfaceS16},
+	ConfigurationTransferTunnel:                {InterfaceS10},
+	DetachNotification:                         {InterfaceS3},
+	DetachAcknowledge:                          {InterfaceS3},
+	CSPagingIndication:                         {InterfaceS3},
+	RANInformationRelay:                        {InterfaceS3, InterfaceS10, InterfaceS16},
+	AlertMMENotification:                       {InterfaceS3},
+	AlertMMEAcknowledge:                        {InterfaceS3},
+	UEActivityNotification:                     {InterfaceS3},
+	UEActivityAcknowledge:                      {InterfaceS3},
+	ISRStatusIndication:                        {InterfaceS3},
+	UERegistrationQueryRequest:                 {InterfaceS3},
+	UERegistrationQueryResponse:                {InterfaceS3},
+	CreateForwardingTunnelRequest:              {InterfaceS4},
+	CreateForwardingTunnelResponse:             {InterfaceS4},
+	SuspendNotification:                        {InterfaceS3, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	SuspendAcknowledge:                         {InterfaceS3, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	ResumeNotification:                         {InterfaceS3, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	ResumeAcknowledge:                          {InterfaceS3, InterfaceS4, InterfaceS5S8, InterfaceS11},
+	CreateIndirectDataForwardingTunnelRequest:  {InterfaceS4, InterfaceS11},
+	CreateIndirectDataForwardingTunnelResponse: {InterfaceS4, InterfaceS11},
+	DeleteIndirectDataForwardingTunnelRequest:  {InterfaceS4, InterfaceS11},
+	DeleteIndirectDataForwardingTunnelResponse: {InterfaceS4, InterfaceS11},
+	ReleaseAccessBearersRequest:                {InterfaceS4, InterfaceS11},
+	ReleaseAccessBearersResponse:               {InterfaceS4, InterfaceS11},
+	DownlinkDataNotification:                   {InterfaceS4, InterfaceS11},
+	DownlinkDataNotificationAcknowledge:        {InterfaceS4, InterfaceS11},
+	PGWRestartNotification:                     {InterfaceS4, InterfaceS11},
+	PGWRestartNotificationAcknowledge:          {InterfaceS4, InterfaceS11},
+	UpdatePDNConnectionSetRequest:              {InterfaceS5S8},
+	UpdatePDNConnectionSetResponse:             {InterfaceS5S8},
+	ModifyAccessBearersRequest:                 {InterfaceS11},
+	ModifyAccessBearersResponse:                {InterfaceS11},
+	MBMSSessionStartRequest:                    {InterfaceSm, InterfaceSn},
+	MBMSSessionStartResponse:                   {InterfaceSm, InterfaceSn},
+	MBMSSessionUpdateRequest:                   {InterfaceSm, InterfaceSn},
+	MBMSSessionUpdateResponse:                  {InterfaceSm, InterfaceSn},
+	MBMSSessionStopRequest:                     {InterfaceSm, InterfaceSn},
+	MBMSSessionStopResponse:                    {InterfaceSm, InterfaceSn},
+}
+
+var mapOfYamlPduTypeToMessageType = map[string]MessageType{
+	"EchoRequest":                                EchoRequest,
+	"EchoResponse":                               EchoResponse,
+	"VersionNotSupportedIndication":              VersionNotSupportedIndication,
+	"CreateSessionRequest":                       CreateSessionRequest,
+	"CreateSessionResponse":                      CreateSessionResponse,
+	"ModifyBearerRequest":                        ModifyBearerRequest,
+	"ModifyBearerResponse":                       ModifyBearerResponse,
+	"DeleteSessionRequest":                       DeleteSessionRequest,
+	"DeleteSessionResponse":                      DeleteSessionResponse,
+	"ChangeNotificationRequest":                  ChangeNotificationRequest,
+	"ChangeNotificationResponse":                 ChangeNotificationResponse,
+	"RemoteUEReportNotification":                 RemoteUEReportNotification,
+	"RemoteUEReportAcknowledge":                  RemoteUEReportAcknowledge,
+	"RemoteUEReportAcknowlegement":               RemoteUEReportAcknowledge,
+	"ModifyBearerCommand":                        ModifyBearerCommand,
+	"ModifyBearerFailureIndication":              ModifyBearerFailureIndication,
+	"DeleteBearerCommand":                        DeleteBearerCommand,
+	"DeleteBearerFailureIndication":              DeleteBearerFailureIndication,
+	"BearerResourceCommand":                      BearerResourceCommand,
+	"BearerResourceFailureIndication":            BearerResourceFailureIndication,
+	"DownlinkDataNotificationFailureIndication":  DownlinkDataNotificationFailureIndication,
+	"TraceSessionActivation":                     TraceSessionActivation,
+	"TraceSessionDeactivation":                   TraceSessionDeactivation,
+	"StopPagingIndication":                       StopPagingIndication,
+	"CreateBearerRequest":                        CreateBearerRequest,
+	"CreateBearerResponse":                       CreateBearerResponse,
+	"UpdateBearerRequest":                        UpdateBearerRequest,
+	"UpdateBearerResponse":                       UpdateBearerResponse,
+	"DeleteBearerRequest":                        DeleteBearerRequest,
+	"DeleteBearerResponse":                       DeleteBearerResponse,
+	"DeletePDNConnectionSetRequest":              DeletePDNConnectionSetRequest,
+	"DeletePDNConnectionSetResponse":             DeletePDNConnectionSetResponse,
+	"PGWDownlinkTriggeringNotification":          PGWDownlinkTriggeringNotification,
+	"PGWDownlinkTriggeringAcknowledge":           PGWDownlinkTriggeringAcknowledge,
+	"IdentificationRequest":                      IdentificationRequest,
+	"IdentificationResponse":                     IdentificationResponse,
+	"ContextRequest":                             ContextRequest,
+	"ContextResponse":                            ContextResponse,
+	"ContextAcknowledge":                         ContextAcknowledge,
+	"ForwardRelocationRequest":                   ForwardRelocationRequest,
+	"ForwardRelocationResponse":                  ForwardRelocationResponse,
+	"ForwardRelocationCompleteNotification":      ForwardRelocationCompleteNotification,
+	"ForwardRelocationCompleteAcknowledge":       ForwardRelocationCompleteAcknowledge,
+	"ForwardAccessContextNotification":           ForwardAccessContextNotification,
+	"ForwardAccessContextAcknowledge":            ForwardAccessContextAcknowledge,
+	"RelocationCancelRequest":                    RelocationCancelRequest,
+	"RelocationCancelResponse":                   RelocationCancelResponse,
+	"ConfigurationTransferTunnel":                ConfigurationTransferTunnel,
+	"DetachNotification":                         DetachNotification,
+	"DetachAcknowledge":                          DetachAcknowledge,
+	"CSPagingIndication":                         CSPagingIndication,
+	"RANInformationRelay":                        RANInformationRelay,
+	"AlertMMENotification":                       AlertMMENotification,
+	"AlertMMEAcknowledge":                        AlertMMEAcknowledge,
+	"UEActivityNotification":                     UEActivityNotification,
+	"UEActivityAcknowledge":                      UEActivityAcknowledge,
+	"ISRStatusIndication":                        ISRStatusIndication,
+	"UERegistrationQueryRequest":                 UERegistrationQueryRequest,
+	"UERegistrationQueryResponse":                UERegistrationQueryResponse,
+	"CreateForwardingTunnelRequest":              CreateForwardingTunnelRequest,
+	"CreateForwardingTunnelResponse":             CreateForwardingTunnelResponse,
+	"SuspendNotification":                        SuspendNotification,
+	"SuspendAcknowledge":                         SuspendAcknowledge,
+	"ResumeNotification":                         ResumeNotification,
+	"ResumeAcknowledge":                          ResumeAcknowledge,
+	"CreateIndirectDataForwardingTunnelRequest":  CreateIndirectDataForwardingTunnelRequest,
+	"CreateIndirectDataForwardingTunnelResponse": CreateIndirectDataForwardingTunnelResponse,
+	"DeleteIndirectDataForwardingTunnelRequest":  DeleteIndirectDataForwardingTunnelRequest,
+	"DeleteIndirectDataForwardingTunnelResponse": DeleteIndirectDataForwardingTunnelResponse,
+	"ReleaseAccessBearersRequest":                ReleaseAccessBearersRequest,
+	"ReleaseAccessBearersResponse":               ReleaseAccessBearersResponse,
+	"DownlinkDataNotification":                   DownlinkDataNotification,
+	"DownlinkDataNotificationAcknowledge":        DownlinkDataNotificationAcknowledge,
+	"PGWRestartNotification":                     PGWRestartNotification,
+	"PGWRestartNotificationAcknowledge":          PGWRestartNotificationAcknowledge,
+	"UpdatePDNConnectionSetRequest":              UpdatePDNConnectionSetRequest,
+	"UpdatePDNConnectionSetResponse":             UpdatePDNConnectionSetResponse,
+	"ModifyAccessBearersRequest":                 ModifyAccessBearersRequest,
+	"ModifyAccessBearersResponse":                ModifyAccessBearersResponse,
+	"MBMSSessionStartRequest":                    MBMSSessionStartRequest,
+	"MBMSSessionStartResponse":                   MBMSSessionStartResponse,
+	"MBMSSessionUpdateRequest":                   MBMSSessionUpdateRequest,
+	"MBMSSessionUpdateResponse":                  MBMSSessionUpdateResponse,
+	"MBMSSessionStopRequest":                     MBMSSessionStopRequest,
+	"MBMSSessionStopResponse":                    MBMSSessionStopResponse,
+}
@@ -0,0 +1,558 @@
+package gtpv2
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The struct codec derives IE data encoding and decoding from a Go struct whose
+// fields are annotated with `gtp` struct tags.  Fields are laid out in declaration
+// order, starting at the first octet of the IE data, and bit fields are packed
+// from the most significant bit of each octet.  The tag options are:
+//
+//	bits=N      field occupies N bits (1 to 64); valid for unsigned integers and bool
+//	bytes=N     field occupies exactly N octets; valid for []byte, string and net.IP
+//	bcd         string of decimal digits encoded as TBCD (e.g., IMSI, MSISDN)
+//	ipv4        net.IP encoded as 4 octets
+//	ipv6        net.IP encoded as 16 octets
+//	rest        field consumes all remaining octets; must be the last field
+//	if=Field    field is present only if the named earlier bool or unsigned integer field is non-zero
+//	-           field is ignored by the codec
+//
+// Unsigned integer fields without bits= use their natural width.  Fields named "_"
+// are spare: they are encoded as zero and skipped on decode.  A []byte or string
+// field without bytes= is treated as rest.  Octets beyond the last field are ignored
+// on decode, since later releases may extend an IE with additional octets.
+
+type codecFieldKind int
+
+const (
+	codecUnsigned codecFieldKind = iota
+	codecBool
+	codecSpare
+	codecBytes
+	codecString
+	codecBCD
+	codecIP
+)
+
+type codecField struct {
+	index          int
+	name           string
+	kind           codecFieldKind
+	bits           int
+	bytes          int
+	isRest         bool
+	conditionIndex int
+}
+
+type codecLayout struct {
+	fields []codecField
+}
+
+var codecLayoutCache sync.Map
+
+func codecLayoutFor(structType reflect.Type) (*codecLayout, error) {
+	if cachedLayout, isCached := codecLayoutCache.Load(structType); isCached {
+		return cachedLayout.(*codecLayout), nil
+	}
+
+	layout, err := parseCodecLayout(structType)
+	if err != nil {
+		return nil, err
+	}
+
+	codecLayoutCache.Store(structType, layout)
+
+	return layout, nil
+}
+
+func parseCodecTag(tag string) map[string]string {
+	options := make(map[string]string)
+
+	for _, option := range strings.Split(tag, ",") {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+
+		if equalsAt := strings.Index(option, "="); equalsAt >= 0 {
+			options[option[:equalsAt]] = option[equalsAt+1:]
+		} else {
+			options[option] = ""
+		}
+	}
+
+	return options
+}
+
+func parseCodecLayout(structType reflect.Type) (*codecLayout, error) {
+	if structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("codec type must be a struct, but (%s) is not", structType)
+	}
+
+	layout := &codecLayout{fields: make([]codecField, 0, structType.NumField())}
+	fieldsByName := make(map[string]codecField)
+	bitOffset := 0
+	sawRestField := false
+
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		tag := structField.Tag.Get("gtp")
+
+		if tag == "-" {
+			continue
+		}
+
+		if sawRestField {
+			return nil, fmt.Errorf("field (%s) of (%s) follows a rest field", structField.Name, structType)
+		}
+
+		options := parseCodecTag(tag)
+		field := codecField{index: i, name: structField.Name, conditionIndex: -1}
+
+		if bitsOption, isSet := options["bits"]; isSet {
+			bits, err := strconv.Atoi(bitsOption)
+			if err != nil || bits < 1 || bits > 64 {
+				return nil, fmt.Errorf("field (%s) of (%s) has invalid bits value (%s)", structField.Name, structType, bitsOption)
+			}
+			field.bits = bits
+		}
+
+		if bytesOption, isSet := options["bytes"]; isSet {
+			byteCount, err := strconv.Atoi(bytesOption)
+			if err != nil || byteCount < 1 {
+				return nil, fmt.Errorf("field (%s) of (%s) has invalid bytes value (%s)", structField.Name, structType, bytesOption)
+			}
+			field.bytes = byteCount
+		}
+
+		if conditionName, isSet := options["if"]; isSet {
+			condition, isKnown := fieldsByName[conditionName]
+			if !isKnown {
+				return nil, fmt.Errorf("field (%s) of (%s) is conditional on (%s), which is not an earlier field", structField.Name, structType, conditionName)
+			}
+			if condition.kind != codecBool && condition.kind != codecUnsigned {
+				return nil, fmt.Errorf("field (%s) of (%s) is conditional on (%s), which is not a bool or unsigned integer field", structField.Name, structType, conditionName)
+			}
+			field.conditionIndex = condition.index
+		}
+
+		_, field.isRest = options["rest"]
+
+		switch {
+		case structField.Name == "_":
+			field.kind = codecSpare
+			if field.bits == 0 {
+				field.bits = int(structField.Type.Size()) * 8
+			}
+
+		case structField.Type == reflect.TypeOf(net.IP{}):
+			field.kind = codecIP
+			if field.isRest {
+				return nil, fmt.Errorf("field (%s) of (%s) is net.IP, so it cannot use rest", structField.Name, structType)
+			}
+			if _, isSet := options["ipv6"]; isSet {
+				field.bytes = 16
+			} else if _, isSet := options["ipv4"]; isSet {
+				field.bytes = 4
+			} else {
+				return nil, fmt.Errorf("field (%s) of (%s) is net.IP, but has neither ipv4 nor ipv6 option", structField.Name, structType)
+			}
+
+		case structField.Type.Kind() == reflect.Bool:
+			field.kind = codecBool
+			if field.bits == 0 {
+				return nil, fmt.Errorf("field (%s) of (%s) is bool, but has no bits option", structField.Name, structType)
+			}
+
+		case structField.Type.Kind() == reflect.Uint8, structField.Type.Kind() == reflect.Uint16,
+			structField.Type.Kind() == reflect.Uint32, structField.Type.Kind() == reflect.Uint64:
+			field.kind = codecUnsigned
+			if field.bits == 0 {
+				field.bits = int(structField.Type.Size()) * 8
+			} else if field.bits > int(structField.Type.Size())*8 {
+				return nil, fmt.Errorf("field (%s) of (%s) has more bits than its type holds", structField.Name, structType)
+			}
+
+		case structField.Type.Kind() == reflect.Slice && structField.Type.Elem().Kind() == reflect.Uint8:
+			field.kind = codecBytes
+
+		case structField.Type.Kind() == reflect.String:
+			field.kind = codecString
+			if _, isSet := options["bcd"]; isSet {
+				field.kind = codecBCD
+			}
+
+		default:
+			return nil, fmt.Errorf("field (%s) of (%s) has unsupported type (%s)", structField.Name, structType, structField.Type)
+		}
+
+		switch field.kind {
+		case codecBytes, codecString, codecBCD:
+			if field.bytes == 0 {
+				field.isRest = true
+			}
+		}
+
+		if field.kind == codecUnsigned || field.kind == codecBool || field.kind == codecSpare {
+			if field.isRest || field.bytes != 0 {
+				return nil, fmt.Errorf("field (%s) of (%s) is a bit field, so it cannot use bytes or rest", structField.Name, structType)
+			}
+			if field.conditionIndex >= 0 && field.bits%8 != 0 {
+				return nil, fmt.Errorf("conditional field (%s) of (%s) must be a whole number of octets", structField.Name, structType)
+			}
+			bitOffset += field.bits
+		} else {
+			if bitOffset%8 != 0 {
+				return nil, fmt.Errorf("field (%s) of (%s) does not start on an octet boundary", structField.Name, structType)
+			}
+			bitOffset += field.bytes * 8
+		}
+
+		sawRestField = field.isRest
+		fieldsByName[structField.Name] = field
+		layout.fields = append(layout.fields, field)
+	}
+
+	if bitOffset%8 != 0 {
+		return nil, fmt.Errorf("fields of (%s) do not end on an octet boundary", structType)
+	}
+
+	return layout, nil
+}
+
+type codecBitWriter struct {
+	data      []byte
+	bitOffset int
+}
+
+func (writer *codecBitWriter) writeBits(value uint64, bits int) {
+	for i := bits - 1; i >= 0; i-- {
+		if writer.bitOffset%8 == 0 {
+			writer.data = append(writer.data, 0)
+		}
+
+		if (value>>uint(i))&0x01 != 0 {
+			writer.data[len(writer.data)-1] |= 0x80 >> uint(writer.bitOffset%8)
+		}
+
+		writer.bitOffset++
+	}
+}
+
+func (writer *codecBitWriter) writeBytes(value []byte) {
+	writer.data = append(writer.data, value...)
+	writer.bitOffset += len(value) * 8
+}
+
+type codecBitReader struct {
+	data      []byte
+	bitOffset int
+}
+
+func (reader *codecBitReader) remainingBits() int {
+	return len(reader.data)*8 - reader.bitOffset
+}
+
+func (reader *codecBitReader) readBits(bits int) uint64 {
+	value := uint64(0)
+
+	for i := 0; i < bits; i++ {
+		bit := (reader.data[reader.bitOffset/8] << uint(reader.bitOffset%8)) & 0x80
+		value = (value << 1) | uint64(bit>>7)
+		reader.bitOffset++
+	}
+
+	return value
+}
+
+func (reader *codecBitReader) readBytes(byteCount int) []byte {
+	startOffset := reader.bitOffset / 8
+	reader.bitOffset += byteCount * 8
+
+	return reader.data[startOffset : startOffset+byteCount]
+}
+
+func codecFieldIsPresent(structValue reflect.Value, field *codecField) bool {
+	if field.conditionIndex < 0 {
+		return true
+	}
+
+	condition := structValue.Field(field.conditionIndex)
+
+	if condition.Kind() == reflect.Bool {
+		return condition.Bool()
+	}
+
+	return condition.Uint() != 0
+}
+
+var matcherForDecimalDigits = regexp.MustCompile(`^\d*$`)
+
+func encodeTBCD(digits string) ([]byte, error) {
+	if !matcherForDecimalDigits.MatchString(digits) {
+		return nil, fmt.Errorf("invalid format for BCD digit string")
+	}
+
+	encoded := make([]byte, 0, len(digits)/2+len(digits)%2)
+
+	for i := 0; i < len(digits); i += 2 {
+		if i+1 < len(digits) {
+			encoded = append(encoded, (digits[i+1]-'0')<<4|(digits[i]-'0'))
+		} else {
+			encoded = append(encoded, 0xf0|(digits[i]-'0'))
+		}
+	}
+
+	return encoded, nil
+}
+
+func decodeTBCD(encoded []byte) (string, error) {
+	digits := make([]byte, 0, len(encoded)*2)
+
+	for i, encodedByte := range encoded {
+		lowNybble := encodedByte & 0x0f
+		highNybble := encodedByte >> 4
+
+		if lowNybble > 9 {
+			return "", fmt.Errorf("invalid BCD encode value")
+		}
+
+		digits = append(digits, '0'+lowNybble)
+
+		if highNybble == 0x0f {
+			if i < len(encoded)-1 {
+				return "", fmt.Errorf("invalid BCD encode value")
+			}
+		} else if highNybble > 9 {
+			return "", fmt.Errorf("invalid BCD encode value")
+		} else {
+			digits = append(digits, '0'+highNybble)
+		}
+	}
+
+	return string(digits), nil
+}
+
+func structValueFrom(v interface{}) (reflect.Value, error) {
+	value := reflect.ValueOf(v)
+
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("codec value must be a non-nil pointer to a struct, but got (%T)", v)
+	}
+
+	return value.Elem(), nil
+}
+
+// MarshalIEData encodes the struct pointed to by v into IE data octets, using
+// the layout described by the struct `gtp` field tags
+func MarshalIEData(v interface{}) ([]byte, error) {
+	structValue, err := structValueFrom(v)
+	if err != nil {
+		return nil, err
+	}
+
+	layout, err := codecLayoutFor(structValue.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	writer := &codecBitWriter{data: make([]byte, 0, 16)}
+
+	for i := range layout.fields {
+		field := &layout.fields[i]
+
+		if !codecFieldIsPresent(structValue, field) {
+			continue
+		}
+
+		fieldValue := structValue.Field(field.index)
+
+		switch field.kind {
+		case codecSpare:
+			writer.writeBits(0, field.bits)
+
+		case codecBool:
+			if fieldValue.Bool() {
+				writer.writeBits(1, field.bits)
+			} else {
+				writer.writeBits(0, field.bits)
+			}
+
+		case codecUnsigned:
+			if field.bits < 64 && fieldValue.Uint()>>uint(field.bits) != 0 {
+				return nil, fmt.Errorf("value (%d) of field (%s) does not fit in (%d) bits", fieldValue.Uint(), field.name, field.bits)
+			}
+			writer.writeBits(fieldValue.Uint(), field.bits)
+
+		case codecBytes, codecString:
+			var value []byte
+			if field.kind == codecBytes {
+				value = fieldValue.Bytes()
+			} else {
+				value = []byte(fieldValue.String())
+			}
+
+			if !field.isRest && len(value) != field.bytes {
+				return nil, fmt.Errorf("field (%s) must be (%d) octets, but is (%d)", field.name, field.bytes, len(value))
+			}
+			writer.writeBytes(value)
+
+		case codecBCD:
+			value, err := encodeTBCD(fieldValue.String())
+			if err != nil {
+				return nil, fmt.Errorf("on field (%s): %s", field.name, err)
+			}
+
+			if !field.isRest && len(value) != field.bytes {
+				return nil, fmt.Errorf("field (%s) must be (%d) octets when BCD encoded, but is (%d)", field.name, field.bytes, len(value))
+			}
+			writer.writeBytes(value)
+
+		case codecIP:
+			ip := fieldValue.Interface().(net.IP)
+			var value net.IP
+			if field.bytes == 4 {
+				value = ip.To4()
+			} else if !ipAddressIsIPv4(ip) {
+				value = ip.To16()
+			}
+
+			if value == nil {
+				if field.bytes == 4 {
+					return nil, fmt.Errorf("field (%s) is not a valid IPv4 address", field.name)
+				}
+				return nil, fmt.Errorf("field (%s) is not a valid IPv6 address", field.name)
+			}
+			writer.writeBytes(value)
+		}
+	}
+
+	return writer.data, nil
+}
+
+// UnmarshalIEData decodes IE data octets into the struct pointed to by v, using
+// the layout described by the struct `gtp` field tags.  Decoded []byte and net.IP
+// fields reference data rather than copying it.
+func UnmarshalIEData(data []byte, v interface{}) error {
+	structValue, err := structValueFrom(v)
+	if err != nil {
+		return err
+	}
+
+	layout, err := codecLayoutFor(structValue.Type())
+	if err != nil {
+		return err
+	}
+
+	reader := &codecBitReader{data: data}
+
+	for i := range layout.fields {
+		field := &layout.fields[i]
+
+		if !codecFieldIsPresent(structValue, field) {
+			continue
+		}
+
+		requiredBits := field.bits
+		if field.kind != codecUnsigned && field.kind != codecBool && field.kind != codecSpare {
+			requiredBits = field.bytes * 8
+			if field.isRest {
+				requiredBits = reader.remainingBits()
+			}
+		}
+
+		if reader.remainingBits() < requiredBits {
+			return fmt.Errorf("IE data is too short for field (%s)", field.name)
+		}
+
+		fieldValue := structValue.Field(field.index)
+
+		switch field.kind {
+		case codecSpare:
+			reader.readBits(field.bits)
+
+		case codecBool:
+			fieldValue.SetBool(reader.readBits(field.bits) != 0)
+
+		case codecUnsigned:
+			fieldValue.SetUint(reader.readBits(field.bits))
+
+		case codecBytes:
+			fieldValue.SetBytes(reader.readBytes(requiredBits / 8))
+
+		case codecString:
+			fieldValue.SetString(string(reader.readBytes(requiredBits / 8)))
+
+		case codecBCD:
+			digits, err := decodeTBCD(reader.readBytes(requiredBits / 8))
+			if err != nil {
+				return fmt.Errorf("on field (%s): %s", field.name, err)
+			}
+			fieldValue.SetString(digits)
+
+		case codecIP:
+			fieldValue.Set(reflect.ValueOf(net.IP(reader.readBytes(field.bytes))))
+		}
+	}
+
+	return nil
+}
+
+// NewIEFromStruct creates an IE of the provided type whose data are the struct
+// pointed to by v, encoded with MarshalIEData()
+func NewIEFromStruct(ieType IEType, v interface{}) (*IE, error) {
+	data, err := MarshalIEData(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewIEWithRawDataErrorable(ieType, data)
+}
+
+// RegisterStructIE registers a typed IE decoder (see RegisterTypedIE()) for the
+// provided IE type that uses UnmarshalIEData() to decode the IE into a new value of
+// the same struct type as prototype, which must be a pointer to a struct.  Returns an
+// error if the struct `gtp` field tags do not describe a valid layout.
+func RegisterStructIE(ieType IEType, prototype TypedIE) error {
+	prototypeValue, err := structValueFrom(prototype)
+	if err != nil {
+		return err
+	}
+
+	structType := prototypeValue.Type()
+
+	if _, err := codecLayoutFor(structType); err != nil {
+		return err
+	}
+
+	RegisterTypedIE(ieType, func(ie *IE) (TypedIE, error) {
+		if ie.Type != ieType {
+			return nil, fmt.Errorf("supplied IE is not of type %s", NameOfIEForType(ieType))
+		}
+
+		typedValue := reflect.New(structType)
+
+		if err := UnmarshalIEData(ie.Data, typedValue.Interface()); err != nil {
+			return nil, fmt.Errorf("on %s IE: %s", NameOfIEForType(ieType), err)
+		}
+
+		return typedValue.Interface().(TypedIE), nil
+	})
+
+	return nil
+}
+
+func ieOrPanic(ie *IE, err error) *IE {
+	if err != nil {
+		panic(err)
+	}
+
+	return ie
+}
@@ -0,0 +1,167 @@
+package gtpv2
+
+import (
+	"net"
+	"testing"
+)
+
+type testCodecStruct struct {
+	V4        bool  `gtp:"bits=1"`
+	V6        bool  `gtp:"bits=1"`
+	Kind      uint8 `gtp:"bits=6"`
+	Key       uint32
+	IPv4Addr  net.IP `gtp:"ipv4,if=V4"`
+	IPv6Addr  net.IP `gtp:"ipv6,if=V6"`
+	_         uint8  `gtp:"bits=4"`
+	Count     uint16 `gtp:"bits=12"`
+	Digits    string `gtp:"bcd,bytes=2"`
+	Ignored   string `gtp:"-"`
+	Remainder []byte `gtp:"rest"`
+}
+
+func TestMarshalAndUnmarshalIEData(t *testing.T) {
+	value := &testCodecStruct{
+		V4:        true,
+		Kind:      0x2a,
+		Key:       0xaabbccdd,
+		IPv4Addr:  net.ParseIP("10.11.12.13"),
+		Count:     0x123,
+		Digits:    "123",
+		Ignored:   "not encoded",
+		Remainder: []byte{0xfe, 0xed},
+	}
+
+	expectedData := []byte{
+		0xaa, 0xaa, 0xbb, 0xcc, 0xdd,
+		0x0a, 0x0b, 0x0c, 0x0d,
+		0x01, 0x23,
+		0x21, 0xf3,
+		0xfe, 0xed,
+	}
+
+	data, err := MarshalIEData(value)
+	if err != nil {
+		t.Fatalf("[TestMarshalAndUnmarshalIEData] expected no error on MarshalIEData, got error = (%s)", err.Error())
+	}
+
+	if err := compareByteArrays(expectedData, data); err != nil {
+		t.Errorf("[TestMarshalAndUnmarshalIEData] on MarshalIEData: %s", err.Error())
+	}
+
+	decoded := &testCodecStruct{}
+	if err := UnmarshalIEData(expectedData, decoded); err != nil {
+		t.Fatalf("[TestMarshalAndUnmarshalIEData] expected no error on UnmarshalIEData, got error = (%s)", err.Error())
+	}
+
+	if !decoded.V4 || decoded.V6 || decoded.Kind != 0x2a || decoded.Key != 0xaabbccdd || decoded.Count != 0x123 {
+		t.Errorf("[TestMarshalAndUnmarshalIEData] decoded fields do not match, got = (%+v)", decoded)
+	}
+
+	if !decoded.IPv4Addr.Equal(value.IPv4Addr) || decoded.IPv6Addr != nil {
+		t.Errorf("[TestMarshalAndUnmarshalIEData] expected IPv4Addr = (10.11.12.13) and no IPv6Addr, got = (%s) and (%s)", decoded.IPv4Addr, decoded.IPv6Addr)
+	}
+
+	if decoded.Digits != "123" {
+		t.Errorf("[TestMarshalAndUnmarshalIEData] expected Digits = (123), got = (%s)", decoded.Digits)
+	}
+
+	if err := compareByteArrays(value.Remainder, decoded.Remainder); err != nil {
+		t.Errorf("[TestMarshalAndUnmarshalIEData] on decoded Remainder: %s", err.Error())
+	}
+
+	if err := UnmarshalIEData(expectedData[:7], &testCodecStruct{}); err == nil {
+		t.Errorf("[TestMarshalAndUnmarshalIEData] expected error on UnmarshalIEData for truncated data, but got none")
+	}
+
+	if _, err := MarshalIEData(&testCodecStruct{Kind: 0x40}); err == nil {
+		t.Errorf("[TestMarshalAndUnmarshalIEData] expected error on MarshalIEData for value exceeding bit width, but got none")
+	}
+
+	if _, err := MarshalIEData(testCodecStruct{}); err == nil {
+		t.Errorf("[TestMarshalAndUnmarshalIEData] expected error on MarshalIEData for non-pointer value, but got none")
+	}
+}
+
+type testCodecInvalidLayout struct {
+	Flag  bool `gtp:"bits=1"`
+	Value uint16
+}
+
+type testCodecInvalidCondition struct {
+	Value uint16 `gtp:"if=Missing"`
+}
+
+type testCodecBytesCondition struct {
+	Prefix []byte `gtp:"bytes=1"`
+	Value  uint16 `gtp:"if=Prefix"`
+}
+
+type testCodecStringCondition struct {
+	Prefix string `gtp:"bytes=2"`
+	Value  uint16 `gtp:"if=Prefix"`
+}
+
+func TestInvalidCodecLayouts(t *testing.T) {
+	if _, err := MarshalIEData(&testCodecInvalidLayout{}); err == nil {
+		t.Errorf("[TestInvalidCodecLayouts] expected error for layout not ending on an octet boundary, but got none")
+	}
+
+	if _, err := MarshalIEData(&testCodecInvalidCondition{}); err == nil {
+		t.Errorf("[TestInvalidCodecLayouts] expected error for condition on unknown field, but got none")
+	}
+
+	if _, err := MarshalIEData(&testCodecBytesCondition{Prefix: []byte{0x01}}); err == nil {
+		t.Errorf("[TestInvalidCodecLayouts] expected error for condition on []byte field, but got none")
+	}
+
+	if err := UnmarshalIEData([]byte{0x01, 0x02, 0x00, 0x03}, &testCodecStringCondition{}); err == nil {
+		t.Errorf("[TestInvalidCodecLayouts] expected error for condition on string field, but got none")
+	}
+}
+
+func TestBuiltInStructIEs(t *testing.T) {
+	qosIE := NewIEWithRawData(BearerQoS, []byte{
+		0x2c, 0x09,
+		0x00, 0x00, 0x00, 0x00, 0x64,
+		0x00, 0x00, 0x00, 0x00, 0xc8,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	})
+
+	var qos TypedBearerQoS
+	if err := DecodeTypedInto(qosIE, &qos); err != nil {
+		t.Fatalf("[TestBuiltInStructIEs] expected no error decoding Bearer QoS, got error = (%s)", err.Error())
+	}
+
+	if qos.PCI || qos.PL != 11 || qos.PVI || qos.QCI != 9 || qos.MaximumBitRateUplink != 100 || qos.MaximumBitRateDownlink != 200 {
+		t.Errorf("[TestBuiltInStructIEs] decoded Bearer QoS does not match, got = (%+v)", qos)
+	}
+
+	if err := compareByteArrays(qosIE.Data, qos.ToIE().Data); err != nil {
+		t.Errorf("[TestBuiltInStructIEs] on Bearer QoS ToIE: %s", err.Error())
+	}
+
+	network := &TypedServingNetwork{MCCDigit1: 3, MCCDigit2: 1, MCCDigit3: 0, MNCDigit1: 2, MNCDigit2: 6, MNCDigit3: 0x0f}
+	if err := compareByteArrays([]byte{0x13, 0xf0, 0x62}, network.ToIE().Data); err != nil {
+		t.Errorf("[TestBuiltInStructIEs] on Serving Network ToIE: %s", err.Error())
+	}
+
+	lossRateIE := (&TypedMaximumPacketLossRate{DL: true, DownlinkRate: 0x0102}).ToIE()
+	if err := compareByteArrays([]byte{0x02, 0x01, 0x02}, lossRateIE.Data); err != nil {
+		t.Errorf("[TestBuiltInStructIEs] on Maximum Packet Loss Rate ToIE: %s", err.Error())
+	}
+
+	typedIE, err := lossRateIE.TypedDataErrorable()
+	if err != nil {
+		t.Fatalf("[TestBuiltInStructIEs] expected no error on Maximum Packet Loss Rate TypedData, got error = (%s)", err.Error())
+	}
+
+	if lossRate := typedIE.(*TypedMaximumPacketLossRate); lossRate.UL || !lossRate.DL || lossRate.DownlinkRate != 0x0102 {
+		t.Errorf("[TestBuiltInStructIEs] decoded Maximum Packet Loss Rate does not match, got = (%+v)", lossRate)
+	}
+
+	meiIE := (&TypedMEI{Digits: "3520990017614823"}).ToIE()
+	if err := compareByteArrays([]byte{0x53, 0x02, 0x99, 0x00, 0x71, 0x16, 0x84, 0x32}, meiIE.Data); err != nil {
+		t.Errorf("[TestBuiltInStructIEs] on MEI ToIE: %s", err.Error())
+	}
+}
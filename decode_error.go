@@ -0,0 +1,182 @@
+package gtpv2
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// DecodeErrorKind identifies the reason that decoding failed
+type DecodeErrorKind int
+
+// Possible DecodeErrorKind values
+const (
+	// DecodeErrorTruncated means the stream ends before the element being decoded
+	// is complete
+	DecodeErrorTruncated DecodeErrorKind = iota + 1
+
+	// DecodeErrorBadVersion means the PDU version field is not 2
+	DecodeErrorBadVersion
+
+	// DecodeErrorLengthMismatch means a length field is inconsistent with the
+	// length of the stream or with the structure it describes
+	DecodeErrorLengthMismatch
+
+	// DecodeErrorInvalidHeader means the PDU header does not conform to TS 29.274
+	// section 5.5.  The wrapped error is a *HeaderError.
+	DecodeErrorInvalidHeader
+
+	// DecodeErrorInvalidPiggyback means the piggyback flags or the piggybacked
+	// PDU placement are not valid
+	DecodeErrorInvalidPiggyback
+
+	// DecodeErrorInvalidExtendedType means an IE with type 254 does not carry a
+	// valid IE Type Extension
+	DecodeErrorInvalidExtendedType
+
+	// DecodeErrorNoTypedDecoder means there is no typed decoder for the IE type
+	DecodeErrorNoTypedDecoder
+
+	// DecodeErrorInvalidIEData means the typed decoder for the IE rejected its data.
+	// The wrapped error is the error from the decoder.
+	DecodeErrorInvalidIEData
+)
+
+var decodeErrorKindDescriptions = map[DecodeErrorKind]string{
+	DecodeErrorTruncated:           "truncated",
+	DecodeErrorBadVersion:          "bad version",
+	DecodeErrorLengthMismatch:      "length mismatch",
+	DecodeErrorInvalidHeader:       "invalid header",
+	DecodeErrorInvalidPiggyback:    "invalid piggyback",
+	DecodeErrorInvalidExtendedType: "invalid extended type",
+	DecodeErrorNoTypedDecoder:      "no typed decoder",
+	DecodeErrorInvalidIEData:       "invalid IE data",
+}
+
+func (kind DecodeErrorKind) String() string {
+	if description, isKnown := decodeErrorKindDescriptions[kind]; isKnown {
+		return description
+	}
+
+	return fmt.Sprintf("unknown decode error kind (%d)", int(kind))
+}
+
+// DecodeError is returned by DecodePDU(), DecodeIE(), ExtractGroupedIEsFrom() and
+// the typed IE decoding methods when decoding fails.  Use errors.As() to retrieve it.
+// Offset is the byte offset, from the start of the stream passed to the decoding
+// function, at which the failing element starts.  For grouped IEs, the stream is the
+// grouped IE data.  Path locates the failing IE using the syntax of IE.LookupIE()
+// (e.g., "BearerContext[1]/FTEID"), and is empty if the error is not in an IE.
+// ExpectedLength and ActualLength are set, in octets, when the error concerns a
+// length, and are otherwise zero.  Message describes the error in detail, and Err is
+// the underlying cause, if any.
+type DecodeError struct {
+	Kind             DecodeErrorKind
+	Offset           int
+	Path             string
+	InPiggybackedPDU bool
+	ExpectedLength   int
+	ActualLength     int
+	Message          string
+	Err              error
+}
+
+func (decodeError *DecodeError) Error() string {
+	description := decodeError.Message
+	if description == "" {
+		description = decodeError.Kind.String()
+	}
+
+	location := fmt.Sprintf("at offset (%d)", decodeError.Offset)
+	if decodeError.Path != "" {
+		location += fmt.Sprintf(" in IE (%s)", decodeError.Path)
+	}
+	if decodeError.InPiggybackedPDU {
+		location += " of piggybacked PDU"
+	}
+
+	if decodeError.Err != nil {
+		return fmt.Sprintf("GTPv2 decode error %s: %s: %s", location, description, decodeError.Err)
+	}
+
+	return fmt.Sprintf("GTPv2 decode error %s: %s", location, description)
+}
+
+// Unwrap returns the underlying cause, so that errors.As() and errors.Is() can
+// examine it
+func (decodeError *DecodeError) Unwrap() error {
+	return decodeError.Err
+}
+
+// pathNameOfIEType returns the name of the IE type used in IE lookup paths
+func pathNameOfIEType(ieType IEType) string {
+	if name, isKnown := ieShortNames[ieType]; isKnown {
+		return name
+	}
+
+	return strconv.Itoa(int(ieType))
+}
+
+// pathSegmentForIE returns the IE lookup path segment for an IE of type ieType
+// preceded by precedingCount IEs of the same type at the same level
+func pathSegmentForIE(ieType IEType, precedingCount int) string {
+	if precedingCount == 0 {
+		return pathNameOfIEType(ieType)
+	}
+
+	return fmt.Sprintf("%s[%d]", pathNameOfIEType(ieType), precedingCount)
+}
+
+// ieTypeAtStartOf returns the type of the (possibly incomplete) IE at the start of
+// stream, which must not be empty.  For an extended type IE without a valid IE
+// Type Extension field, this is ExtensionType.
+func ieTypeAtStartOf(stream []byte) IEType {
+	if stream[0] == ExtensionType && len(stream) >= 6 {
+		if extendedType := IEType(binary.BigEndian.Uint16(stream[4:6])); extendedType >= MinimumExtendedIEType {
+			return extendedType
+		}
+	}
+
+	return IEType(stream[0])
+}
+
+// atIE returns a copy of the DecodeError for an IE that starts at offset in the
+// stream being decoded, and has the path segment segment
+func (decodeError *DecodeError) atIE(segment string, offset int) *DecodeError {
+	located := *decodeError
+	located.Offset += offset
+	located.Path = segment
+
+	return &located
+}
+
+// withinIE returns a copy of the DecodeError with its path prefixed by the path
+// segment of the grouped IE that contains it
+func (decodeError *DecodeError) withinIE(parentSegment string) *DecodeError {
+	located := *decodeError
+
+	if located.Path == "" {
+		located.Path = parentSegment
+	} else {
+		located.Path = parentSegment + "/" + located.Path
+	}
+
+	return &located
+}
+
+// typedDecodeError converts an error returned by a typed IE decoder for ie into a
+// *DecodeError.  If the error is, or wraps, a *DecodeError, that is returned.
+func typedDecodeError(ie *IE, err error) *DecodeError {
+	var decodeError *DecodeError
+	if errors.As(err, &decodeError) {
+		return decodeError
+	}
+
+	return &DecodeError{
+		Kind:         DecodeErrorInvalidIEData,
+		Path:         pathNameOfIEType(ie.Type),
+		ActualLength: len(ie.Data),
+		Err:          err,
+	}
+}
@@ -0,0 +1,148 @@
+package gtpv2
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+)
+
+func decodeErrorFrom(t *testing.T, testName string, err error) *DecodeError {
+	var decodeError *DecodeError
+	if !errors.As(err, &decodeError) {
+		t.Fatalf("[%s] expected *DecodeError, got = (%v)", testName, err)
+	}
+	return decodeError
+}
+
+func TestDecodeErrorsFromPDUHeader(t *testing.T) {
+	_, _, err := DecodePDU([]byte{0x48, 0x20, 0x00})
+	decodeError := decodeErrorFrom(t, "TestDecodeErrorsFromPDUHeader", err)
+	if decodeError.Kind != DecodeErrorTruncated || decodeError.ExpectedLength != 8 || decodeError.ActualLength != 3 {
+		t.Errorf("[TestDecodeErrorsFromPDUHeader] on short stream, expected kind (truncated), lengths (8, 3), got = (%s), (%d, %d)", decodeError.Kind, decodeError.ExpectedLength, decodeError.ActualLength)
+	}
+
+	_, _, err = DecodePDU([]byte{0x28, 0x20, 0x00, 0x08, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x01, 0x00})
+	if decodeError = decodeErrorFrom(t, "TestDecodeErrorsFromPDUHeader", err); decodeError.Kind != DecodeErrorBadVersion {
+		t.Errorf("[TestDecodeErrorsFromPDUHeader] on version 1, expected kind (bad version), got = (%s)", decodeError.Kind)
+	}
+
+	_, _, err = DecodePDU([]byte{0x48, 0x20, 0x00, 0x10, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x01, 0x00})
+	decodeError = decodeErrorFrom(t, "TestDecodeErrorsFromPDUHeader", err)
+	if decodeError.Kind != DecodeErrorTruncated || decodeError.ExpectedLength != 20 || decodeError.ActualLength != 12 {
+		t.Errorf("[TestDecodeErrorsFromPDUHeader] on length field too long, expected kind (truncated), lengths (20, 12), got = (%s), (%d, %d)", decodeError.Kind, decodeError.ExpectedLength, decodeError.ActualLength)
+	}
+
+	_, _, err = DecodePDUWithOptions([]byte{0x48, 0x01, 0x00, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00}, DecodeOptions{StrictHeader: true})
+	if decodeError = decodeErrorFrom(t, "TestDecodeErrorsFromPDUHeader", err); decodeError.Kind != DecodeErrorInvalidHeader {
+		t.Errorf("[TestDecodeErrorsFromPDUHeader] on strict decode of Echo Request with TEID, expected kind (invalid header), got = (%s)", decodeError.Kind)
+	}
+
+	var headerError *HeaderError
+	if !errors.As(err, &headerError) {
+		t.Errorf("[TestDecodeErrorsFromPDUHeader] on strict decode of Echo Request with TEID, expected wrapped *HeaderError, got none")
+	}
+}
+
+func TestDecodeErrorsFromPDUIEs(t *testing.T) {
+	fteid := (&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1), InterfaceType: 10, Key: 0x01}).ToIE()
+	pdu := NewPDU(CreateSessionRequest, 1, []*IE{
+		(&TypedIMSI{AsString: "001002789012345"}).ToIE(),
+		fteid,
+		fteid,
+	}).SetTEID(1)
+
+	encoded := pdu.Encode()
+	lastIEOffset := len(encoded) - int(fteid.TotalLength)
+	binary.BigEndian.PutUint16(encoded[lastIEOffset+1:lastIEOffset+3], 20)
+
+	_, _, err := DecodePDU(encoded)
+	decodeError := decodeErrorFrom(t, "TestDecodeErrorsFromPDUIEs", err)
+
+	if decodeError.Kind != DecodeErrorTruncated {
+		t.Errorf("[TestDecodeErrorsFromPDUIEs] expected kind (truncated), got = (%s)", decodeError.Kind)
+	}
+	if decodeError.Offset != lastIEOffset {
+		t.Errorf("[TestDecodeErrorsFromPDUIEs] expected offset (%d), got = (%d)", lastIEOffset, decodeError.Offset)
+	}
+	if decodeError.Path != "FTEID[1]" {
+		t.Errorf("[TestDecodeErrorsFromPDUIEs] expected path (FTEID[1]), got = (%s)", decodeError.Path)
+	}
+	if decodeError.ExpectedLength != 24 || decodeError.ActualLength != int(fteid.TotalLength) {
+		t.Errorf("[TestDecodeErrorsFromPDUIEs] expected lengths (24, %d), got = (%d, %d)", fteid.TotalLength, decodeError.ExpectedLength, decodeError.ActualLength)
+	}
+
+	piggybacked := NewPDU(CreateBearerRequest, 2, []*IE{fteid}).SetTEID(2)
+	encodedPiggybacked := piggybacked.Encode()
+	binary.BigEndian.PutUint16(encodedPiggybacked[13:15], 20)
+
+	primary := NewPDU(CreateSessionResponse, 1, nil).SetTEID(1)
+	primary.IsCarryingPiggybackedPDU = true
+	encoded = append(primary.Encode(), encodedPiggybacked...)
+	encoded[0] |= 0x10
+
+	_, _, err = DecodePDU(encoded)
+	decodeError = decodeErrorFrom(t, "TestDecodeErrorsFromPDUIEs", err)
+
+	if !decodeError.InPiggybackedPDU || decodeError.Offset != 24 || decodeError.Path != "FTEID" {
+		t.Errorf("[TestDecodeErrorsFromPDUIEs] on piggybacked PDU, expected offset (24) and path (FTEID) in piggybacked PDU, got = (%d), (%s), (%t)", decodeError.Offset, decodeError.Path, decodeError.InPiggybackedPDU)
+	}
+}
+
+func TestDecodeErrorsFromGroupedAndTypedIEs(t *testing.T) {
+	validFTEIDData := (&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1), InterfaceType: 10, Key: 0x01}).ToIE().Data
+
+	pdu := NewPDU(CreateSessionRequest, 1, []*IE{
+		NewGroupedIE(BearerContext, []*IE{
+			NewIEWithRawData(EBI, []byte{0x05}),
+			NewIEWithRawData(FTEID, validFTEIDData[:5]),
+		}),
+		NewIEWithRawData(BearerContext, append([]byte{0x49, 0x00, 0x01, 0x00, 0x06, 0x57, 0x00, 0x20, 0x00}, validFTEIDData...)),
+	}).SetTEID(1)
+
+	_, err := pdu.LookupIE("BearerContext[1]/FTEID")
+	decodeError := decodeErrorFrom(t, "TestDecodeErrorsFromGroupedAndTypedIEs", err)
+
+	if decodeError.Kind != DecodeErrorTruncated || decodeError.Path != "BearerContext[1]/FTEID" || decodeError.Offset != 5 {
+		t.Errorf("[TestDecodeErrorsFromGroupedAndTypedIEs] on truncated grouped IE, expected kind (truncated), path (BearerContext[1]/FTEID), offset (5), got = (%s), (%s), (%d)", decodeError.Kind, decodeError.Path, decodeError.Offset)
+	}
+
+	_, err = pdu.LookupTypedIE("BearerContext/FTEID")
+	decodeError = decodeErrorFrom(t, "TestDecodeErrorsFromGroupedAndTypedIEs", err)
+
+	if decodeError.Kind != DecodeErrorInvalidIEData || decodeError.Path != "BearerContext/FTEID" || decodeError.ActualLength != 5 || decodeError.Err == nil {
+		t.Errorf("[TestDecodeErrorsFromGroupedAndTypedIEs] on invalid F-TEID data, expected kind (invalid IE data), path (BearerContext/FTEID), actual length (5) and a cause, got = (%s), (%s), (%d), (%v)", decodeError.Kind, decodeError.Path, decodeError.ActualLength, decodeError.Err)
+	}
+
+	_, err = NewIEWithRawData(BearerContext, []byte{0x01}).TypedDataErrorable()
+	if decodeError = decodeErrorFrom(t, "TestDecodeErrorsFromGroupedAndTypedIEs", err); decodeError.Kind != DecodeErrorNoTypedDecoder {
+		t.Errorf("[TestDecodeErrorsFromGroupedAndTypedIEs] on Bearer Context typed data, expected kind (no typed decoder), got = (%s)", decodeError.Kind)
+	}
+
+	_, err = DecodeIE([]byte{0xfe, 0x00, 0x02, 0x00, 0x00, 0x10})
+	if decodeError = decodeErrorFrom(t, "TestDecodeErrorsFromGroupedAndTypedIEs", err); decodeError.Kind != DecodeErrorInvalidExtendedType {
+		t.Errorf("[TestDecodeErrorsFromGroupedAndTypedIEs] on extended type below 256, expected kind (invalid extended type), got = (%s)", decodeError.Kind)
+	}
+}
+
+func TestDecodeErrorWrappedByTypedDecoder(t *testing.T) {
+	RegisterTypedIE(Indication, func(ie *IE) (TypedIE, error) {
+		return nil, fmt.Errorf("invalid Indication: %w", &DecodeError{Kind: DecodeErrorTruncated, ExpectedLength: 2, ActualLength: len(ie.Data)})
+	})
+	defer RegisterTypedIE(Indication, nil)
+
+	pdu := NewPDU(CreateSessionRequest, 1, []*IE{
+		NewGroupedIE(BearerContext, []*IE{NewIEWithRawData(Indication, []byte{0x01})}),
+	}).SetTEID(1)
+
+	_, err := pdu.LookupTypedIE("BearerContext/Indication")
+	if decodeError := decodeErrorFrom(t, "TestDecodeErrorWrappedByTypedDecoder", err); decodeError.Kind != DecodeErrorTruncated || decodeError.Path != "BearerContext/Indication" || decodeError.ExpectedLength != 2 {
+		t.Errorf("[TestDecodeErrorWrappedByTypedDecoder] on PDU LookupTypedIE(), expected kind (truncated), path (BearerContext/Indication) and expected length (2), got = (%s), (%s), (%d)", decodeError.Kind, decodeError.Path, decodeError.ExpectedLength)
+	}
+
+	_, err = pdu.InformationElements[0].LookupTypedIE("Indication")
+	if decodeError := decodeErrorFrom(t, "TestDecodeErrorWrappedByTypedDecoder", err); decodeError.Kind != DecodeErrorTruncated || decodeError.Path != "Indication" {
+		t.Errorf("[TestDecodeErrorWrappedByTypedDecoder] on IE LookupTypedIE(), expected kind (truncated) and path (Indication), got = (%s), (%s)", decodeError.Kind, decodeError.Path)
+	}
+}
@@ -0,0 +1,681 @@
+package gtpv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultPort is the UDP port for GTPv2-C (TS 29.274 section 4.2)
+const DefaultPort = 2123
+
+// MaximumSequenceNumber is the largest GTPv2 sequence number, which is a 24-bit value
+const MaximumSequenceNumber uint32 = 0xffffff
+
+// ErrEndpointClosed is the error for a request that cannot be sent, or that is
+// still waiting for a response, when the Endpoint is closed
+var ErrEndpointClosed = errors.New("GTPv2 endpoint is closed")
+
+// ErrRequestTimeout is wrapped by every *RequestTimeoutError, so that
+// errors.Is(err, ErrRequestTimeout) is true for a request that was not answered
+var ErrRequestTimeout = errors.New("GTPv2 request timed out")
+
+// RequestTimeoutError is the error for a request that was not answered after it
+// was sent and retransmitted N3 times, waiting T3 after each (see EndpointOptions)
+type RequestTimeoutError struct {
+	MessageType    MessageType
+	SequenceNumber uint32
+	Peer           net.Addr
+	Transmissions  int
+}
+
+func (timeoutError *RequestTimeoutError) Error() string {
+	return fmt.Sprintf("no answer from (%s) to %s with sequence number (%d) after (%d) transmissions", timeoutError.Peer, NameOfMessageForType(timeoutError.MessageType), timeoutError.SequenceNumber, timeoutError.Transmissions)
+}
+
+// Unwrap returns ErrRequestTimeout
+func (timeoutError *RequestTimeoutError) Unwrap() error {
+	return ErrRequestTimeout
+}
+
+// Timeout returns true, so that code that checks for a Timeout() method (as for a
+// net.Error) treats a RequestTimeoutError as a timeout
+func (timeoutError *RequestTimeoutError) Timeout() bool {
+	return true
+}
+
+// Timer is a timer started by a Clock
+type Timer interface {
+	// Stop prevents the timer function from being called.  Returns false if it has
+	// already been called or the timer has already been stopped.
+	Stop() bool
+}
+
+// Clock is the source of time for an Endpoint.  It may be replaced (see
+// EndpointOptions) so that tests can control when timers expire.
+type Clock interface {
+	Now() time.Time
+
+	// AfterFunc calls f in its own goroutine after the duration has elapsed, unless
+	// the returned timer is stopped first
+	AfterFunc(duration time.Duration, f func()) Timer
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(duration time.Duration, f func()) Timer {
+	return time.AfterFunc(duration, f)
+}
+
+// SystemClock is the Clock that uses the system time, and is used by an Endpoint
+// unless another is provided
+var SystemClock Clock = systemClock{}
+
+// EndpointOptions changes the behavior of an Endpoint (see NewEndpointWithOptions())
+type EndpointOptions struct {
+	// IncomingQueueLength is the number of received messages that are held for
+	// Incoming() before further messages are dropped.  If it is 0,
+	// DefaultIncomingQueueLength is used.
+	IncomingQueueLength int
+
+	// T3 is the time to wait for the answer to a request before retransmitting it
+	// (T3-RESPONSE in TS 29.274 section 7.6).  If it is 0, DefaultT3 is used.  If it
+	// is negative, requests are neither retransmitted nor timed out, so only the
+	// context passed to SendRequest() limits the wait.  Echo Requests sent for path
+	// management are then timed out after EchoTimeoutWithoutT3.
+	T3 time.Duration
+
+	// N3 is the number of times an unanswered request is retransmitted
+	// (N3-REQUESTS in TS 29.274 section 7.6).  After the last retransmission, and a
+	// further wait of T3, the request fails with a *RequestTimeoutError.  If it is
+	// 0, DefaultN3 is used.  If it is negative, requests are not retransmitted.
+	N3 int
+
+	// Clock is the source of time for timers.  If it is nil, SystemClock is used.
+	Clock Clock
+
+	// ResponseCacheWindow is how long a received request is remembered, so that a
+	// retransmission of it by the peer is recognized (TS 29.274 section 7.6).  A
+	// retransmission received within the window is not delivered by Incoming().
+	// Instead, if the response has been sent (see SendResponse()), it is resent, and
+	// otherwise the retransmission is discarded.  The window starts when the
+	// request is received, and starts again when the response is sent.  If it is
+	// 0, DefaultResponseCacheWindow is used.  If it is negative, retransmissions
+	// are not recognized, and are delivered as new requests.
+	ResponseCacheWindow time.Duration
+
+	// PathManagement enables path management (TS 29.274 section 7.1).  The Endpoint
+	// answers Echo Requests itself, rather than delivering them by Incoming(), and
+	// sends Echo Requests to the peers added by MonitorPeer(), tracking the state
+	// of the path to each (see PathEvents()).
+	PathManagement bool
+
+	// RecoveryRestartCounter is the local Recovery restart counter, which is sent
+	// in Echo Requests and Echo Responses when PathManagement is true
+	RecoveryRestartCounter uint8
+
+	// EchoInterval is the time between an Echo Request to a monitored peer being
+	// answered (or failing) and the next Echo Request to that peer.  If it is 0,
+	// DefaultEchoInterval is used.
+	EchoInterval time.Duration
+
+	// PathFailureEchoes is the number of consecutive Echo Requests to a monitored
+	// peer that must fail, each after its retransmissions (see N3), before the path
+	// to the peer is considered down.  If it is 0, DefaultPathFailureEchoes is used.
+	PathFailureEchoes int
+
+	// ErrorLog receives errors from reading the socket, after which the Endpoint
+	// continues to read, unless the socket is closed.  If it is nil, the standard
+	// logger of the log package is used.
+	ErrorLog *log.Logger
+}
+
+// Default EndpointOptions values, used when none are provided.  The
+// DefaultResponseCacheWindow covers every retransmission by a peer that uses the
+// DefaultT3 and DefaultN3.
+const (
+	DefaultIncomingQueueLength = 64
+	DefaultT3                  = 3 * time.Second
+	DefaultN3                  = 3
+	DefaultResponseCacheWindow = (DefaultN3 + 1) * DefaultT3
+	DefaultEchoInterval        = 60 * time.Second
+	DefaultPathFailureEchoes   = 1
+)
+
+// IncomingMessage is a message received by an Endpoint that is not the answer to a
+// request sent by the Endpoint (see Endpoint.Incoming())
+type IncomingMessage struct {
+	PDU      *PDU
+	Peer     net.Addr
+	endpoint *Endpoint
+}
+
+// Respond sends the response to the peer that sent the message, with the
+// sequence number of the message (see Endpoint.SendResponse())
+func (message *IncomingMessage) Respond(response *PDU) error {
+	return message.endpoint.SendResponse(message.Peer, message.PDU, response)
+}
+
+// RequestResult is the outcome of a request sent by Endpoint.SendRequestAsync().
+// If Err is nil, Response is the message that answered Request.  Otherwise,
+// Response is nil.
+type RequestResult struct {
+	Request  *PDU
+	Response *PDU
+	Peer     net.Addr
+	Err      error
+}
+
+type pendingRequestKey struct {
+	peer           string
+	sequenceNumber uint32
+}
+
+type pendingRequest struct {
+	request         *PDU
+	peer            net.Addr
+	responseTypes   []MessageType
+	encoded         []byte
+	retransmissions int
+	timer           Timer
+	sentAt          time.Time
+	deliver         func(result *RequestResult, sentAt time.Time)
+	done            chan struct{}
+}
+
+func (pending *pendingRequest) isAnsweredBy(messageType MessageType) bool {
+	for _, responseType := range pending.responseTypes {
+		if responseType == messageType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Endpoint is a GTPv2-C node bound to a UDP socket.  It sends requests, allocating
+// a sequence number for each, and matches each received message to the pending
+// request that it answers, using the peer address, the sequence number and the
+// message types that may answer the request (see MessageType.TriggeredTypes()).
+// Received messages that do not answer a pending request, including a piggybacked
+// PDU, are delivered by Incoming().  An Endpoint is safe for concurrent use.
+type Endpoint struct {
+	conn            net.PacketConn
+	options         EndpointOptions
+	sequenceNumber  uint32
+	lock            sync.Mutex
+	pendingRequests map[pendingRequestKey]*pendingRequest
+	responses       *responseCache
+	paths           *pathManager
+	isClosed        bool
+	incoming        chan *IncomingMessage
+	closeOnce       sync.Once
+	closeRequested  chan struct{}
+	receiveLoopDone chan struct{}
+}
+
+// ListenEndpoint opens a UDP socket on the local address and returns an Endpoint
+// using it.  The address has the form accepted by net.ListenPacket().  If it has
+// no port, DefaultPort is used.
+func ListenEndpoint(localAddress string) (*Endpoint, error) {
+	return ListenEndpointWithOptions(localAddress, EndpointOptions{})
+}
+
+// ListenEndpointWithOptions is the same as ListenEndpoint(), but with behavior
+// changed by the provided options
+func ListenEndpointWithOptions(localAddress string, options EndpointOptions) (*Endpoint, error) {
+	if _, _, err := net.SplitHostPort(localAddress); err != nil {
+		localAddress = net.JoinHostPort(localAddress, strconv.Itoa(DefaultPort))
+	}
+
+	conn, err := net.ListenPacket("udp", localAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEndpointWithOptions(conn, options), nil
+}
+
+// NewEndpoint returns an Endpoint using the provided socket, which the Endpoint
+// then owns.  The Endpoint reads from the socket until Close() is called.
+func NewEndpoint(conn net.PacketConn) *Endpoint {
+	return NewEndpointWithOptions(conn, EndpointOptions{})
+}
+
+// NewEndpointWithOptions is the same as NewEndpoint(), but with behavior changed by
+// the provided options
+func NewEndpointWithOptions(conn net.PacketConn, options EndpointOptions) *Endpoint {
+	if options.IncomingQueueLength <= 0 {
+		options.IncomingQueueLength = DefaultIncomingQueueLength
+	}
+
+	if options.T3 == 0 {
+		options.T3 = DefaultT3
+	}
+
+	if options.N3 == 0 {
+		options.N3 = DefaultN3
+	} else if options.N3 < 0 {
+		options.N3 = 0
+	}
+
+	if options.Clock == nil {
+		options.Clock = SystemClock
+	}
+
+	if options.ResponseCacheWindow == 0 {
+		options.ResponseCacheWindow = DefaultResponseCacheWindow
+	}
+
+	if options.EchoInterval <= 0 {
+		options.EchoInterval = DefaultEchoInterval
+	}
+
+	if options.PathFailureEchoes <= 0 {
+		options.PathFailureEchoes = DefaultPathFailureEchoes
+	}
+
+	endpoint := &Endpoint{
+		conn:            conn,
+		options:         options,
+		pendingRequests: make(map[pendingRequestKey]*pendingRequest),
+		incoming:        make(chan *IncomingMessage, options.IncomingQueueLength),
+		closeRequested:  make(chan struct{}),
+		receiveLoopDone: make(chan struct{}),
+	}
+
+	if options.ResponseCacheWindow > 0 {
+		endpoint.responses = newResponseCache(options.ResponseCacheWindow, options.Clock)
+	}
+
+	if options.PathManagement {
+		endpoint.paths = newPathManager()
+	}
+
+	go endpoint.receiveLoop()
+
+	return endpoint
+}
+
+// LocalAddr returns the local address of the Endpoint socket
+func (endpoint *Endpoint) LocalAddr() net.Addr {
+	return endpoint.conn.LocalAddr()
+}
+
+// Incoming returns the channel on which received messages that do not answer a
+// pending request are delivered.  If the channel is full, further messages are
+// dropped (as they would be by a full socket buffer) until there is room.  The
+// channel is closed when the Endpoint is closed.
+func (endpoint *Endpoint) Incoming() <-chan *IncomingMessage {
+	return endpoint.incoming
+}
+
+// NextSequenceNumber allocates a sequence number.  Sequence numbers increase by 1
+// for each allocation, wrapping from MaximumSequenceNumber to 0.
+func (endpoint *Endpoint) NextSequenceNumber() uint32 {
+	return atomic.AddUint32(&endpoint.sequenceNumber, 1) & MaximumSequenceNumber
+}
+
+// Send encodes the PDU and sends it to the peer, without changing it or waiting for
+// an answer
+func (endpoint *Endpoint) Send(peer net.Addr, pdu *PDU) error {
+	_, err := endpoint.conn.WriteTo(pdu.AppendEncode(nil), peer)
+	return err
+}
+
+// SendResponse sets the sequence number of the response to that of the request
+// that it answers, and sends the response to the peer.  The encoded response is
+// kept, so that it can be resent if the peer retransmits the request (see
+// EndpointOptions ResponseCacheWindow).
+func (endpoint *Endpoint) SendResponse(peer net.Addr, request *PDU, response *PDU) error {
+	response.SequenceNumber = request.SequenceNumber
+	encoded := response.AppendEncode(nil)
+
+	if endpoint.responses != nil {
+		endpoint.responses.recordResponse(peer, request, encoded)
+	}
+
+	_, err := endpoint.conn.WriteTo(encoded, peer)
+	return err
+}
+
+// SendRequest sets the sequence number of the request to the next allocated
+// sequence number (see NextSequenceNumber()) that is not used by a request still
+// pending to the same peer, sends it to the peer, and waits for the message that
+// answers it.  If there is no answer within T3, the identical encoded request is
+// retransmitted, up to N3 times (see EndpointOptions).  Returns an error if the
+// request message type is not answered (see MessageType.ResponseType()), if the
+// request cannot be sent, if the context is done before the answer is received, or
+// if the Endpoint is closed.  If there is no answer after the last retransmission,
+// the error is a *RequestTimeoutError.
+func (endpoint *Endpoint) SendRequest(ctx context.Context, peer net.Addr, request *PDU) (*PDU, error) {
+	result := <-endpoint.SendRequestAsync(ctx, peer, request)
+	return result.Response, result.Err
+}
+
+// SendRequestAsync is the same as SendRequest(), but returns immediately.  The
+// outcome is delivered on the returned channel, which receives exactly one
+// RequestResult.
+func (endpoint *Endpoint) SendRequestAsync(ctx context.Context, peer net.Addr, request *PDU) <-chan *RequestResult {
+	result := make(chan *RequestResult, 1)
+
+	endpoint.sendRequest(ctx, peer, request, func(requestResult *RequestResult, sentAt time.Time) {
+		result <- requestResult
+	})
+
+	return result
+}
+
+// sendRequest is the same as SendRequestAsync(), but the outcome is passed to
+// deliver, which must not block, with the time at which the request was last
+// transmitted (or the zero time, if it was not).  deliver is called exactly once,
+// possibly before sendRequest returns.
+func (endpoint *Endpoint) sendRequest(ctx context.Context, peer net.Addr, request *PDU, deliver func(result *RequestResult, sentAt time.Time)) {
+	responseTypes := request.Type.TriggeredTypes()
+	if responseTypes == nil {
+		deliver(&RequestResult{Request: request, Peer: peer, Err: fmt.Errorf("%s is not answered, so it cannot be sent as a request", NameOfMessageForType(request.Type))}, time.Time{})
+		return
+	}
+
+	pending := &pendingRequest{
+		request:       request,
+		peer:          peer,
+		responseTypes: responseTypes,
+		deliver:       deliver,
+		done:          make(chan struct{}),
+	}
+
+	endpoint.lock.Lock()
+	if endpoint.isClosed {
+		endpoint.lock.Unlock()
+		deliver(&RequestResult{Request: request, Peer: peer, Err: ErrEndpointClosed}, time.Time{})
+		return
+	}
+
+	key, isAllocated := endpoint.allocatePendingRequestKey(peer)
+	if !isAllocated {
+		endpoint.lock.Unlock()
+		deliver(&RequestResult{Request: request, Peer: peer, Err: fmt.Errorf("every sequence number is in use by a request pending to (%s)", peer)}, time.Time{})
+		return
+	}
+
+	request.SequenceNumber = key.sequenceNumber
+	pending.encoded = request.AppendEncode(nil)
+	pending.sentAt = endpoint.options.Clock.Now()
+	endpoint.pendingRequests[key] = pending
+	endpoint.lock.Unlock()
+
+	if _, err := endpoint.conn.WriteTo(pending.encoded, peer); err != nil {
+		endpoint.completePendingRequest(key, pending, nil, err)
+		return
+	}
+
+	endpoint.lock.Lock()
+	if endpoint.pendingRequests[key] == pending {
+		endpoint.startRetransmissionTimer(key, pending)
+	}
+	endpoint.lock.Unlock()
+
+	if ctx.Done() != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				endpoint.completePendingRequest(key, pending, nil, ctx.Err())
+			case <-pending.done:
+			}
+		}()
+	}
+}
+
+// allocatePendingRequestKey allocates the next sequence number (see
+// NextSequenceNumber()) that is not used by a request pending to the peer, which can
+// happen when sequence numbers wrap.  Returns false if every sequence number is in
+// use.  The Endpoint lock must be held.
+func (endpoint *Endpoint) allocatePendingRequestKey(peer net.Addr) (pendingRequestKey, bool) {
+	key := pendingRequestKey{peer: peer.String()}
+
+	for attempt := uint32(0); attempt <= MaximumSequenceNumber; attempt++ {
+		key.sequenceNumber = endpoint.NextSequenceNumber()
+		if _, isPending := endpoint.pendingRequests[key]; !isPending {
+			return key, true
+		}
+	}
+
+	return key, false
+}
+
+// startRetransmissionTimer starts the T3 timer for the pending request.  The
+// Endpoint lock must be held.
+func (endpoint *Endpoint) startRetransmissionTimer(key pendingRequestKey, pending *pendingRequest) {
+	if endpoint.options.T3 < 0 {
+		return
+	}
+
+	pending.timer = endpoint.options.Clock.AfterFunc(endpoint.options.T3, func() {
+		endpoint.retransmitPendingRequest(key, pending)
+	})
+}
+
+// retransmitPendingRequest is called when the T3 timer for the pending request
+// expires.  It retransmits the request or, after N3 retransmissions, fails it with
+// a *RequestTimeoutError.
+func (endpoint *Endpoint) retransmitPendingRequest(key pendingRequestKey, pending *pendingRequest) {
+	endpoint.lock.Lock()
+	if endpoint.pendingRequests[key] != pending {
+		endpoint.lock.Unlock()
+		return
+	}
+
+	if pending.retransmissions >= endpoint.options.N3 {
+		endpoint.lock.Unlock()
+		endpoint.completePendingRequest(key, pending, nil, &RequestTimeoutError{
+			MessageType:    pending.request.Type,
+			SequenceNumber: key.sequenceNumber,
+			Peer:           pending.peer,
+			Transmissions:  pending.retransmissions + 1,
+		})
+		return
+	}
+
+	pending.retransmissions++
+	pending.sentAt = endpoint.options.Clock.Now()
+	endpoint.startRetransmissionTimer(key, pending)
+	endpoint.lock.Unlock()
+
+	if _, err := endpoint.conn.WriteTo(pending.encoded, pending.peer); err != nil {
+		endpoint.completePendingRequest(key, pending, nil, err)
+	}
+}
+
+// completePendingRequest delivers the result for the pending request, if it is
+// still pending under the key, and stops its T3 timer.  Returns false if it is not
+// pending.
+func (endpoint *Endpoint) completePendingRequest(key pendingRequestKey, pending *pendingRequest, response *PDU, err error) bool {
+	endpoint.lock.Lock()
+	if endpoint.pendingRequests[key] != pending {
+		endpoint.lock.Unlock()
+		return false
+	}
+	delete(endpoint.pendingRequests, key)
+	if pending.timer != nil {
+		pending.timer.Stop()
+	}
+	endpoint.lock.Unlock()
+
+	close(pending.done)
+	pending.deliver(&RequestResult{Request: pending.request, Response: response, Peer: pending.peer, Err: err}, pending.sentAt)
+
+	return true
+}
+
+// receiveLoop reads and dispatches messages until the socket is closed.  Other read
+// errors, such as a connection reset caused by an ICMP message on some platforms, or
+// a timeout from a PacketConn with a deadline, are logged and reading continues.
+func (endpoint *Endpoint) receiveLoop() {
+	defer close(endpoint.receiveLoopDone)
+
+	buffer := make([]byte, 65535)
+	var readErrorDelay time.Duration
+
+	for {
+		datagramLength, peer, err := endpoint.conn.ReadFrom(buffer)
+		if err != nil {
+			if isClosedConnectionError(err) || endpoint.closeIsRequested() {
+				endpoint.shutdown()
+				return
+			}
+
+			readErrorDelay = nextReadErrorDelay(readErrorDelay)
+			endpoint.logf("GTPv2 endpoint read error: %s; retrying in %s", err, readErrorDelay)
+
+			select {
+			case <-time.After(readErrorDelay):
+			case <-endpoint.closeRequested:
+			}
+			continue
+		}
+		readErrorDelay = 0
+
+		pdu, piggybackedPdu, err := DecodePDU(buffer[:datagramLength])
+		if err != nil {
+			continue
+		}
+
+		endpoint.dispatch(peer, pdu)
+		if piggybackedPdu != nil {
+			endpoint.dispatch(peer, piggybackedPdu)
+		}
+	}
+}
+
+// nextReadErrorDelay returns the time to wait before reading the socket again after
+// a read error, which doubles from 5 milliseconds up to 1 second while errors
+// continue, so that a persistent error does not occupy the receive loop
+func nextReadErrorDelay(previousDelay time.Duration) time.Duration {
+	if previousDelay == 0 {
+		return 5 * time.Millisecond
+	}
+
+	if nextDelay := 2 * previousDelay; nextDelay < time.Second {
+		return nextDelay
+	}
+
+	return time.Second
+}
+
+func (endpoint *Endpoint) closeIsRequested() bool {
+	select {
+	case <-endpoint.closeRequested:
+		return true
+	default:
+		return false
+	}
+}
+
+func (endpoint *Endpoint) logf(format string, args ...interface{}) {
+	if endpoint.options.ErrorLog != nil {
+		endpoint.options.ErrorLog.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
+
+// dispatch completes the pending request answered by the PDU or, if there is none,
+// delivers the PDU to Incoming().  A PDU that is a retransmission of a request
+// already received is instead answered from the response cache or discarded.  With
+// path management, an Echo Request is answered rather than delivered.
+func (endpoint *Endpoint) dispatch(peer net.Addr, pdu *PDU) {
+	if endpoint.paths != nil {
+		endpoint.paths.recordMessageFrom(peer, pdu, endpoint.options.Clock.Now())
+	}
+
+	_, isAnswered := pdu.Type.ResponseType()
+	isRecordedRequest := false
+
+	if isAnswered && endpoint.responses != nil {
+		encodedResponse, isDuplicate := endpoint.responses.recordRequest(peer, pdu)
+		if isDuplicate {
+			if encodedResponse != nil {
+				endpoint.conn.WriteTo(encodedResponse, peer)
+			}
+			return
+		}
+		isRecordedRequest = true
+	}
+
+	if endpoint.paths != nil && pdu.Type == EchoRequest {
+		endpoint.answerEchoRequest(peer, pdu)
+		return
+	}
+
+	key := pendingRequestKey{peer: peer.String(), sequenceNumber: pdu.SequenceNumber}
+
+	endpoint.lock.Lock()
+	pending, isPending := endpoint.pendingRequests[key]
+	endpoint.lock.Unlock()
+
+	if isPending && pending.isAnsweredBy(pdu.Type) && endpoint.completePendingRequest(key, pending, pdu, nil) {
+		return
+	}
+
+	select {
+	case endpoint.incoming <- &IncomingMessage{PDU: pdu, Peer: peer, endpoint: endpoint}:
+	default:
+		if isRecordedRequest {
+			endpoint.responses.forgetRequest(peer, pdu)
+		}
+	}
+}
+
+// shutdown stops path management, fails every pending request with
+// ErrEndpointClosed, and closes the Incoming() and PathEvents() channels.  It is
+// called by the receive loop when it ends.
+func (endpoint *Endpoint) shutdown() {
+	if endpoint.paths != nil {
+		endpoint.paths.close()
+	}
+
+	endpoint.lock.Lock()
+	endpoint.isClosed = true
+	pendingRequests := endpoint.pendingRequests
+	endpoint.pendingRequests = make(map[pendingRequestKey]*pendingRequest)
+	endpoint.lock.Unlock()
+
+	for _, pending := range pendingRequests {
+		if pending.timer != nil {
+			pending.timer.Stop()
+		}
+		close(pending.done)
+		pending.deliver(&RequestResult{Request: pending.request, Peer: pending.peer, Err: ErrEndpointClosed}, pending.sentAt)
+	}
+
+	close(endpoint.incoming)
+}
+
+// Close closes the Endpoint socket.  Requests waiting for an answer fail with
+// ErrEndpointClosed, path management stops, and the Incoming() and PathEvents()
+// channels are closed.
+func (endpoint *Endpoint) Close() error {
+	endpoint.lock.Lock()
+	endpoint.isClosed = true
+	endpoint.lock.Unlock()
+
+	endpoint.closeOnce.Do(func() {
+		close(endpoint.closeRequested)
+	})
+
+	err := endpoint.conn.Close()
+	<-endpoint.receiveLoopDone
+
+	return err
+}
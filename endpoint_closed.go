@@ -0,0 +1,15 @@
+//go:build go1.16
+// +build go1.16
+
+package gtpv2
+
+import (
+	"errors"
+	"net"
+)
+
+// isClosedConnectionError returns true if err is returned by an operation on a
+// closed socket
+func isClosedConnectionError(err error) bool {
+	return errors.Is(err, net.ErrClosed)
+}
@@ -0,0 +1,13 @@
+//go:build !go1.16
+// +build !go1.16
+
+package gtpv2
+
+import "strings"
+
+// isClosedConnectionError returns true if err is returned by an operation on a
+// closed socket.  Before go 1.16, which added net.ErrClosed, the error can only be
+// recognized by its message.
+func isClosedConnectionError(err error) bool {
+	return strings.Contains(err.Error(), "use of closed network connection")
+}
@@ -0,0 +1,520 @@
+package gtpv2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func listenEndpointForTest(t *testing.T) *Endpoint {
+	endpoint, err := ListenEndpoint("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("on ListenEndpoint() expected no error, got = (%s)", err)
+	}
+
+	return endpoint
+}
+
+// answerEchoRequests responds to each Echo Request received by the endpoint with an
+// Echo Response, and passes any other message to others
+func answerEchoRequests(endpoint *Endpoint, others chan<- *IncomingMessage) {
+	for message := range endpoint.Incoming() {
+		if message.PDU.Type == EchoRequest {
+			message.Respond(NewPDU(EchoResponse, 0, []*IE{NewIEWithRawData(RecoveryRestartCounter, []byte{0x07})}))
+		} else if others != nil {
+			others <- message
+		}
+	}
+}
+
+func TestEndpointSendRequest(t *testing.T) {
+	client, server := listenEndpointForTest(t), listenEndpointForTest(t)
+	defer client.Close()
+	defer server.Close()
+
+	go answerEchoRequests(server, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		request := NewPDU(EchoRequest, 0, []*IE{NewIEWithRawData(RecoveryRestartCounter, []byte{0x01})})
+
+		response, err := client.SendRequest(ctx, server.LocalAddr(), request)
+		if err != nil {
+			t.Fatalf("[TestEndpointSendRequest] on SendRequest() expected no error, got = (%s)", err)
+		}
+
+		if response.Type != EchoResponse {
+			t.Errorf("[TestEndpointSendRequest] expected response type (Echo Response), got (%s)", NameOfMessageForType(response.Type))
+		}
+
+		if response.SequenceNumber != request.SequenceNumber {
+			t.Errorf("[TestEndpointSendRequest] expected response sequence number (%d), got (%d)", request.SequenceNumber, response.SequenceNumber)
+		}
+
+		if request.SequenceNumber != uint32(i+1) {
+			t.Errorf("[TestEndpointSendRequest] expected allocated sequence number (%d), got (%d)", i+1, request.SequenceNumber)
+		}
+	}
+
+	results := make([]<-chan *RequestResult, 0, 10)
+	for i := 0; i < 10; i++ {
+		results = append(results, client.SendRequestAsync(ctx, server.LocalAddr(), NewPDU(EchoRequest, 0, nil)))
+	}
+
+	for _, resultChannel := range results {
+		result := <-resultChannel
+		if result.Err != nil {
+			t.Errorf("[TestEndpointSendRequest] on SendRequestAsync() expected no error, got = (%s)", result.Err)
+		} else if result.Response.SequenceNumber != result.Request.SequenceNumber {
+			t.Errorf("[TestEndpointSendRequest] on SendRequestAsync() expected response sequence number (%d), got (%d)", result.Request.SequenceNumber, result.Response.SequenceNumber)
+		}
+	}
+
+	if _, err := client.SendRequest(ctx, server.LocalAddr(), NewPDU(EchoResponse, 0, nil)); err == nil {
+		t.Errorf("[TestEndpointSendRequest] on SendRequest() for Echo Response expected error, got none")
+	}
+}
+
+func TestEndpointResponseMatching(t *testing.T) {
+	client, server := listenEndpointForTest(t), listenEndpointForTest(t)
+	defer client.Close()
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result := client.SendRequestAsync(ctx, server.LocalAddr(), NewPDU(EchoRequest, 0, nil))
+
+	var request *IncomingMessage
+	select {
+	case request = <-server.Incoming():
+	case <-ctx.Done():
+		t.Fatalf("[TestEndpointResponseMatching] server did not receive Echo Request")
+	}
+
+	// a message of the wrong type, or with the wrong sequence number, does not
+	// answer the request, and is delivered to Incoming()
+	server.SendResponse(client.LocalAddr(), request.PDU, NewPDU(CreateSessionResponse, 0, nil).AddTEID(1))
+	server.Send(client.LocalAddr(), NewPDU(EchoResponse, request.PDU.SequenceNumber+1, nil))
+
+	for _, expectedType := range []MessageType{CreateSessionResponse, EchoResponse} {
+		select {
+		case message := <-client.Incoming():
+			if message.PDU.Type != expectedType {
+				t.Errorf("[TestEndpointResponseMatching] expected incoming (%s), got (%s)", NameOfMessageForType(expectedType), NameOfMessageForType(message.PDU.Type))
+			}
+		case <-ctx.Done():
+			t.Fatalf("[TestEndpointResponseMatching] expected incoming (%s), got none", NameOfMessageForType(expectedType))
+		}
+	}
+
+	request.Respond(NewPDU(EchoResponse, 0, nil))
+
+	select {
+	case r := <-result:
+		if r.Err != nil {
+			t.Errorf("[TestEndpointResponseMatching] expected no error, got = (%s)", r.Err)
+		}
+	case <-ctx.Done():
+		t.Fatalf("[TestEndpointResponseMatching] expected result, got none")
+	}
+}
+
+func TestEndpointCancelAndClose(t *testing.T) {
+	client := listenEndpointForTest(t)
+	silentPeer := listenEndpointForTest(t)
+	defer silentPeer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := client.SendRequest(ctx, silentPeer.LocalAddr(), NewPDU(EchoRequest, 0, nil)); err != context.DeadlineExceeded {
+		t.Errorf("[TestEndpointCancelAndClose] expected error (%s), got = (%v)", context.DeadlineExceeded, err)
+	}
+
+	result := client.SendRequestAsync(context.Background(), silentPeer.LocalAddr(), NewPDU(EchoRequest, 0, nil))
+
+	if err := client.Close(); err != nil {
+		t.Errorf("[TestEndpointCancelAndClose] on Close() expected no error, got = (%s)", err)
+	}
+
+	if r := <-result; r.Err != ErrEndpointClosed {
+		t.Errorf("[TestEndpointCancelAndClose] expected error (%s) for pending request, got = (%v)", ErrEndpointClosed, r.Err)
+	}
+
+	if _, err := client.SendRequest(context.Background(), silentPeer.LocalAddr(), NewPDU(EchoRequest, 0, nil)); err != ErrEndpointClosed {
+		t.Errorf("[TestEndpointCancelAndClose] after Close() expected error (%s), got = (%v)", ErrEndpointClosed, err)
+	}
+
+	if _, isOpen := <-client.Incoming(); isOpen {
+		t.Errorf("[TestEndpointCancelAndClose] expected Incoming() to be closed after Close()")
+	}
+}
+
+func TestEndpointSequenceNumberWrap(t *testing.T) {
+	client := listenEndpointForTest(t)
+	defer client.Close()
+
+	client.sequenceNumber = MaximumSequenceNumber - 1
+
+	for _, expected := range []uint32{MaximumSequenceNumber, 0, 1} {
+		if got := client.NextSequenceNumber(); got != expected {
+			t.Errorf("[TestEndpointSequenceNumberWrap] expected sequence number (%d), got (%d)", expected, got)
+		}
+	}
+}
+
+func TestEndpointSequenceNumberSkipsPendingRequest(t *testing.T) {
+	client, err := ListenEndpointWithOptions("127.0.0.1:0", EndpointOptions{T3: -1})
+	if err != nil {
+		t.Fatalf("[TestEndpointSequenceNumberSkipsPendingRequest] on ListenEndpointWithOptions() expected no error, got = (%s)", err)
+	}
+	defer client.Close()
+
+	silentPeer, otherPeer := listenEndpointForTest(t), listenEndpointForTest(t)
+	defer silentPeer.Close()
+	defer otherPeer.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pendingResult := client.SendRequestAsync(ctx, silentPeer.LocalAddr(), NewPDU(EchoRequest, 0, nil))
+
+	// after wrapping, the sequence number of the pending request is skipped for the
+	// same peer, but not for another peer
+	client.sequenceNumber = MaximumSequenceNumber + 1
+	request := NewPDU(EchoRequest, 0, nil)
+	client.SendRequestAsync(ctx, silentPeer.LocalAddr(), request)
+
+	if request.SequenceNumber != 2 {
+		t.Errorf("[TestEndpointSequenceNumberSkipsPendingRequest] expected sequence number (2) for same peer, got (%d)", request.SequenceNumber)
+	}
+
+	client.sequenceNumber = MaximumSequenceNumber + 1
+	request = NewPDU(EchoRequest, 0, nil)
+	client.SendRequestAsync(ctx, otherPeer.LocalAddr(), request)
+
+	if request.SequenceNumber != 1 {
+		t.Errorf("[TestEndpointSequenceNumberSkipsPendingRequest] expected sequence number (1) for other peer, got (%d)", request.SequenceNumber)
+	}
+
+	cancel()
+
+	if r := <-pendingResult; r.Err != context.Canceled {
+		t.Errorf("[TestEndpointSequenceNumberSkipsPendingRequest] expected first request to fail with (%s), got = (%v)", context.Canceled, r.Err)
+	}
+}
+
+// failingPacketConn is a PacketConn that returns an error from the first reads,
+// before reading from the wrapped PacketConn
+type failingPacketConn struct {
+	net.PacketConn
+	lock           sync.Mutex
+	failuresToSend int
+}
+
+func (conn *failingPacketConn) ReadFrom(buffer []byte) (int, net.Addr, error) {
+	conn.lock.Lock()
+	if conn.failuresToSend > 0 {
+		conn.failuresToSend--
+		conn.lock.Unlock()
+		return 0, nil, errors.New("connection reset by peer")
+	}
+	conn.lock.Unlock()
+
+	return conn.PacketConn.ReadFrom(buffer)
+}
+
+func TestEndpointContinuesAfterReadError(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("[TestEndpointContinuesAfterReadError] on ListenPacket() expected no error, got = (%s)", err)
+	}
+
+	var logged bytes.Buffer
+	var logLock sync.Mutex
+	server := NewEndpointWithOptions(&failingPacketConn{PacketConn: conn, failuresToSend: 2}, EndpointOptions{ErrorLog: log.New(&lockedWriter{writer: &logged, lock: &logLock}, "", 0)})
+	defer server.Close()
+
+	client := listenEndpointForTest(t)
+	defer client.Close()
+
+	go answerEchoRequests(server, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := client.SendRequest(ctx, server.LocalAddr(), NewPDU(EchoRequest, 0, nil)); err != nil {
+		t.Errorf("[TestEndpointContinuesAfterReadError] on SendRequest() expected no error, got = (%s)", err)
+	}
+
+	logLock.Lock()
+	defer logLock.Unlock()
+	if count := strings.Count(logged.String(), "connection reset by peer"); count != 2 {
+		t.Errorf("[TestEndpointContinuesAfterReadError] expected (2) logged read errors, got (%d)", count)
+	}
+}
+
+type lockedWriter struct {
+	writer io.Writer
+	lock   *sync.Mutex
+}
+
+func (writer *lockedWriter) Write(p []byte) (int, error) {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+	return writer.writer.Write(p)
+}
+
+func TestEndpointSurvivesMalformedDatagrams(t *testing.T) {
+	server, client := listenEndpointForTest(t), listenEndpointForTest(t)
+	defer server.Close()
+	defer client.Close()
+
+	go answerEchoRequests(server, nil)
+
+	sender, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("[TestEndpointSurvivesMalformedDatagrams] on ListenPacket() expected no error, got = (%s)", err)
+	}
+	defer sender.Close()
+
+	for _, datagram := range [][]byte{
+		// IE length field that wraps when 4 is added
+		{0x48, 0x20, 0x00, 0x0e, 0, 0, 0, 1, 0, 0, 1, 0, 0x01, 0xff, 0xfc, 0x00, 0x01, 0x02},
+		// piggybacked PDU with a primary length field shorter than the header
+		{0x50, 0x21, 0x00, 0x00, 0x40, 0x5f, 0x00, 0x04, 0, 0, 2, 0},
+	} {
+		if _, err := sender.WriteTo(datagram, server.LocalAddr()); err != nil {
+			t.Fatalf("[TestEndpointSurvivesMalformedDatagrams] on WriteTo() expected no error, got = (%s)", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := client.SendRequest(ctx, server.LocalAddr(), NewPDU(EchoRequest, 0, nil)); err != nil {
+		t.Errorf("[TestEndpointSurvivesMalformedDatagrams] on SendRequest() after malformed datagrams expected no error, got = (%s)", err)
+	}
+}
+
+func TestListenEndpointDefaultPort(t *testing.T) {
+	endpoint, err := ListenEndpoint("127.0.0.1")
+	if err != nil {
+		t.Skipf("[TestListenEndpointDefaultPort] cannot listen on default port: %s", err)
+	}
+	defer endpoint.Close()
+
+	if port := endpoint.LocalAddr().(*net.UDPAddr).Port; port != DefaultPort {
+		t.Errorf("[TestListenEndpointDefaultPort] expected port (%d), got (%d)", DefaultPort, port)
+	}
+}
+
+// testClock is a Clock for tests.  Time advances only when Advance() is called,
+// which calls the functions of expired timers synchronously.
+type testClock struct {
+	lock   sync.Mutex
+	now    time.Time
+	timers []*testTimer
+}
+
+type testTimer struct {
+	clock     *testClock
+	expiresAt time.Time
+	f         func()
+}
+
+func newTestClock() *testClock {
+	return &testClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (clock *testClock) Now() time.Time {
+	clock.lock.Lock()
+	defer clock.lock.Unlock()
+	return clock.now
+}
+
+func (clock *testClock) AfterFunc(duration time.Duration, f func()) Timer {
+	clock.lock.Lock()
+	defer clock.lock.Unlock()
+
+	timer := &testTimer{clock: clock, expiresAt: clock.now.Add(duration), f: f}
+	clock.timers = append(clock.timers, timer)
+
+	return timer
+}
+
+func (timer *testTimer) Stop() bool {
+	timer.clock.lock.Lock()
+	defer timer.clock.lock.Unlock()
+
+	for i, activeTimer := range timer.clock.timers {
+		if activeTimer == timer {
+			timer.clock.timers = append(timer.clock.timers[:i], timer.clock.timers[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (clock *testClock) Advance(duration time.Duration) {
+	clock.lock.Lock()
+	clock.now = clock.now.Add(duration)
+
+	expired := make([]*testTimer, 0)
+	active := make([]*testTimer, 0, len(clock.timers))
+	for _, timer := range clock.timers {
+		if timer.expiresAt.After(clock.now) {
+			active = append(active, timer)
+		} else {
+			expired = append(expired, timer)
+		}
+	}
+	clock.timers = active
+	clock.lock.Unlock()
+
+	for _, timer := range expired {
+		timer.f()
+	}
+}
+
+func (clock *testClock) activeTimerCount() int {
+	clock.lock.Lock()
+	defer clock.lock.Unlock()
+	return len(clock.timers)
+}
+
+// readDatagram returns the next datagram received on conn, or nil if there is none
+// within the wait
+func readDatagram(conn net.PacketConn, wait time.Duration) []byte {
+	buffer := make([]byte, 65535)
+
+	conn.SetReadDeadline(time.Now().Add(wait))
+	datagramLength, _, err := conn.ReadFrom(buffer)
+	if err != nil {
+		return nil
+	}
+
+	return buffer[:datagramLength]
+}
+
+func TestEndpointRetransmission(t *testing.T) {
+	clock := newTestClock()
+
+	client, err := ListenEndpointWithOptions("127.0.0.1:0", EndpointOptions{T3: 2 * time.Second, N3: 2, Clock: clock})
+	if err != nil {
+		t.Fatalf("[TestEndpointRetransmission] on ListenEndpointWithOptions() expected no error, got = (%s)", err)
+	}
+	defer client.Close()
+
+	peer, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("[TestEndpointRetransmission] on ListenPacket() expected no error, got = (%s)", err)
+	}
+	defer peer.Close()
+
+	result := client.SendRequestAsync(context.Background(), peer.LocalAddr(), NewPDU(EchoRequest, 0, []*IE{NewIEWithRawData(RecoveryRestartCounter, []byte{0x01})}))
+
+	firstTransmission := readDatagram(peer, time.Second)
+	if firstTransmission == nil {
+		t.Fatalf("[TestEndpointRetransmission] expected request, got none")
+	}
+
+	clock.Advance(time.Second)
+	if datagram := readDatagram(peer, 50*time.Millisecond); datagram != nil {
+		t.Errorf("[TestEndpointRetransmission] expected no retransmission before T3, got one")
+	}
+
+	// the first retransmission is T3 after the request, and the second is T3 after that
+	for i, advance := range []time.Duration{time.Second, 2 * time.Second} {
+		clock.Advance(advance)
+
+		retransmission := readDatagram(peer, time.Second)
+		if retransmission == nil {
+			t.Fatalf("[TestEndpointRetransmission] expected retransmission (%d), got none", i+1)
+		}
+		if err := compareByteArrays(firstTransmission, retransmission); err != nil {
+			t.Errorf("[TestEndpointRetransmission] on retransmission (%d): %s", i+1, err)
+		}
+	}
+
+	select {
+	case <-result:
+		t.Fatalf("[TestEndpointRetransmission] expected no result before final T3 expires")
+	default:
+	}
+
+	clock.Advance(2 * time.Second)
+
+	r := <-result
+	timeoutError, isTimeoutError := r.Err.(*RequestTimeoutError)
+	if !isTimeoutError {
+		t.Fatalf("[TestEndpointRetransmission] expected *RequestTimeoutError, got = (%v)", r.Err)
+	}
+	if timeoutError.Transmissions != 3 || timeoutError.MessageType != EchoRequest {
+		t.Errorf("[TestEndpointRetransmission] expected timeout after (3) transmissions of Echo Request, got (%d) of (%s)", timeoutError.Transmissions, NameOfMessageForType(timeoutError.MessageType))
+	}
+	if !errors.Is(r.Err, ErrRequestTimeout) {
+		t.Errorf("[TestEndpointRetransmission] expected errors.Is(err, ErrRequestTimeout) to be true")
+	}
+
+	if datagram := readDatagram(peer, 50*time.Millisecond); datagram != nil {
+		t.Errorf("[TestEndpointRetransmission] expected no further transmissions after timeout, got one")
+	}
+	if count := clock.activeTimerCount(); count != 0 {
+		t.Errorf("[TestEndpointRetransmission] expected no active timers after timeout, got (%d)", count)
+	}
+}
+
+func TestEndpointRetransmissionStopsOnResponse(t *testing.T) {
+	clock := newTestClock()
+
+	client, err := ListenEndpointWithOptions("127.0.0.1:0", EndpointOptions{Clock: clock})
+	if err != nil {
+		t.Fatalf("[TestEndpointRetransmissionStopsOnResponse] on ListenEndpointWithOptions() expected no error, got = (%s)", err)
+	}
+	defer client.Close()
+
+	// the server is a bare socket, since an Endpoint does not deliver
+	// retransmissions (see EndpointOptions ResponseCacheWindow)
+	server, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("[TestEndpointRetransmissionStopsOnResponse] on ListenPacket() expected no error, got = (%s)", err)
+	}
+	defer server.Close()
+
+	result := client.SendRequestAsync(context.Background(), server.LocalAddr(), NewPDU(EchoRequest, 0, nil))
+
+	request := readDatagram(server, time.Second)
+	clock.Advance(DefaultT3)
+
+	retransmittedRequest := readDatagram(server, time.Second)
+	if err := compareByteArrays(request, retransmittedRequest); err != nil {
+		t.Fatalf("[TestEndpointRetransmissionStopsOnResponse] on retransmission: %s", err)
+	}
+
+	requestPdu, _, err := DecodePDU(retransmittedRequest)
+	if err != nil {
+		t.Fatalf("[TestEndpointRetransmissionStopsOnResponse] on DecodePDU() of retransmission expected no error, got = (%s)", err)
+	}
+
+	server.WriteTo(NewPDU(EchoResponse, requestPdu.SequenceNumber, nil).Encode(), client.LocalAddr())
+
+	if r := <-result; r.Err != nil {
+		t.Fatalf("[TestEndpointRetransmissionStopsOnResponse] expected no error, got = (%s)", r.Err)
+	}
+
+	if count := clock.activeTimerCount(); count != 0 {
+		t.Errorf("[TestEndpointRetransmissionStopsOnResponse] expected no active timers after response, got (%d)", count)
+	}
+}
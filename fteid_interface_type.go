@@ -0,0 +1,191 @@
+package gtpv2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FTEIDInterfaceType is the interface type of an F-TEID (TS 29.274 Table 8.22-1).
+// It is a 6-bit value.
+type FTEIDInterfaceType uint8
+
+// Possible FTEIDInterfaceType values
+const (
+	FTEIDInterfaceS1UeNodeBGTPU                       FTEIDInterfaceType = 0
+	FTEIDInterfaceS1USGWGTPU                          FTEIDInterfaceType = 1
+	FTEIDInterfaceS12RNCGTPU                          FTEIDInterfaceType = 2
+	FTEIDInterfaceS12SGWGTPU                          FTEIDInterfaceType = 3
+	FTEIDInterfaceS5S8SGWGTPU                         FTEIDInterfaceType = 4
+	FTEIDInterfaceS5S8PGWGTPU                         FTEIDInterfaceType = 5
+	FTEIDInterfaceS5S8SGWGTPC                         FTEIDInterfaceType = 6
+	FTEIDInterfaceS5S8PGWGTPC                         FTEIDInterfaceType = 7
+	FTEIDInterfaceS5S8SGWPMIPv6                       FTEIDInterfaceType = 8
+	FTEIDInterfaceS5S8PGWPMIPv6                       FTEIDInterfaceType = 9
+	FTEIDInterfaceS11MMEGTPC                          FTEIDInterfaceType = 10
+	FTEIDInterfaceS11S4SGWGTPC                        FTEIDInterfaceType = 11
+	FTEIDInterfaceS10N26MMEGTPC                       FTEIDInterfaceType = 12
+	FTEIDInterfaceS3MMEGTPC                           FTEIDInterfaceType = 13
+	FTEIDInterfaceS3SGSNGTPC                          FTEIDInterfaceType = 14
+	FTEIDInterfaceS4SGSNGTPU                          FTEIDInterfaceType = 15
+	FTEIDInterfaceS4SGWGTPU                           FTEIDInterfaceType = 16
+	FTEIDInterfaceS4SGSNGTPC                          FTEIDInterfaceType = 17
+	FTEIDInterfaceS16SGSNGTPC                         FTEIDInterfaceType = 18
+	FTEIDInterfaceENodeBGNodeBGTPUForDLDataForwarding FTEIDInterfaceType = 19
+	FTEIDInterfaceENodeBGTPUForULDataForwarding       FTEIDInterfaceType = 20
+	FTEIDInterfaceRNCGTPUForDataForwarding            FTEIDInterfaceType = 21
+	FTEIDInterfaceSGSNGTPUForDataForwarding           FTEIDInterfaceType = 22
+	FTEIDInterfaceSGWUPFGTPUForDLDataForwarding       FTEIDInterfaceType = 23
+	FTEIDInterfaceSmMBMSGWGTPC                        FTEIDInterfaceType = 24
+	FTEIDInterfaceSnMBMSGWGTPC                        FTEIDInterfaceType = 25
+	FTEIDInterfaceSmMMEGTPC                           FTEIDInterfaceType = 26
+	FTEIDInterfaceSnSGSNGTPC                          FTEIDInterfaceType = 27
+	FTEIDInterfaceSGWGTPUForULDataForwarding          FTEIDInterfaceType = 28
+	FTEIDInterfaceSnSGSNGTPU                          FTEIDInterfaceType = 29
+	FTEIDInterfaceS2bePDGGTPC                         FTEIDInterfaceType = 30
+	FTEIDInterfaceS2bUePDGGTPU                        FTEIDInterfaceType = 31
+	FTEIDInterfaceS2bPGWGTPC                          FTEIDInterfaceType = 32
+	FTEIDInterfaceS2bUPGWGTPU                         FTEIDInterfaceType = 33
+	FTEIDInterfaceS2aTWANGTPU                         FTEIDInterfaceType = 34
+	FTEIDInterfaceS2aTWANGTPC                         FTEIDInterfaceType = 35
+	FTEIDInterfaceS2aPGWGTPC                          FTEIDInterfaceType = 36
+	FTEIDInterfaceS2aPGWGTPU                          FTEIDInterfaceType = 37
+	FTEIDInterfaceS11MMEGTPU                          FTEIDInterfaceType = 38
+	FTEIDInterfaceS11SGWGTPU                          FTEIDInterfaceType = 39
+	FTEIDInterfaceN26AMFGTPC                          FTEIDInterfaceType = 40
+	FTEIDInterfaceN19mbUPFGTPU                        FTEIDInterfaceType = 41
+)
+
+// MaximumFTEIDInterfaceType is the largest value that fits in the F-TEID
+// interface type field
+const MaximumFTEIDInterfaceType FTEIDInterfaceType = 0x3f
+
+type fteidInterfaceTypePlane int
+
+const (
+	fteidControlPlane fteidInterfaceTypePlane = iota + 1
+	fteidUserPlane
+)
+
+type fteidInterfaceTypeDescription struct {
+	name  string
+	plane fteidInterfaceTypePlane
+}
+
+var fteidInterfaceTypeDescriptions = map[FTEIDInterfaceType]fteidInterfaceTypeDescription{
+	FTEIDInterfaceS1UeNodeBGTPU:                       {"S1-U eNodeB GTP-U", fteidUserPlane},
+	FTEIDInterfaceS1USGWGTPU:                          {"S1-U SGW GTP-U", fteidUserPlane},
+	FTEIDInterfaceS12RNCGTPU:                          {"S12 RNC GTP-U", fteidUserPlane},
+	FTEIDInterfaceS12SGWGTPU:                          {"S12 SGW GTP-U", fteidUserPlane},
+	FTEIDInterfaceS5S8SGWGTPU:                         {"S5/S8 SGW GTP-U", fteidUserPlane},
+	FTEIDInterfaceS5S8PGWGTPU:                         {"S5/S8 PGW GTP-U", fteidUserPlane},
+	FTEIDInterfaceS5S8SGWGTPC:                         {"S5/S8 SGW GTP-C", fteidControlPlane},
+	FTEIDInterfaceS5S8PGWGTPC:                         {"S5/S8 PGW GTP-C", fteidControlPlane},
+	FTEIDInterfaceS5S8SGWPMIPv6:                       {"S5/S8 SGW PMIPv6", fteidControlPlane},
+	FTEIDInterfaceS5S8PGWPMIPv6:                       {"S5/S8 PGW PMIPv6", fteidControlPlane},
+	FTEIDInterfaceS11MMEGTPC:                          {"S11 MME GTP-C", fteidControlPlane},
+	FTEIDInterfaceS11S4SGWGTPC:                        {"S11/S4 SGW GTP-C", fteidControlPlane},
+	FTEIDInterfaceS10N26MMEGTPC:                       {"S10/N26 MME GTP-C", fteidControlPlane},
+	FTEIDInterfaceS3MMEGTPC:                           {"S3 MME GTP-C", fteidControlPlane},
+	FTEIDInterfaceS3SGSNGTPC:                          {"S3 SGSN GTP-C", fteidControlPlane},
+	FTEIDInterfaceS4SGSNGTPU:                          {"S4 SGSN GTP-U", fteidUserPlane},
+	FTEIDInterfaceS4SGWGTPU:                           {"S4 SGW GTP-U", fteidUserPlane},
+	FTEIDInterfaceS4SGSNGTPC:                          {"S4 SGSN GTP-C", fteidControlPlane},
+	FTEIDInterfaceS16SGSNGTPC:                         {"S16 SGSN GTP-C", fteidControlPlane},
+	FTEIDInterfaceENodeBGNodeBGTPUForDLDataForwarding: {"eNodeB/gNodeB GTP-U for DL data forwarding", fteidUserPlane},
+	FTEIDInterfaceENodeBGTPUForULDataForwarding:       {"eNodeB GTP-U for UL data forwarding", fteidUserPlane},
+	FTEIDInterfaceRNCGTPUForDataForwarding:            {"RNC GTP-U for data forwarding", fteidUserPlane},
+	FTEIDInterfaceSGSNGTPUForDataForwarding:           {"SGSN GTP-U for data forwarding", fteidUserPlane},
+	FTEIDInterfaceSGWUPFGTPUForDLDataForwarding:       {"SGW/UPF GTP-U for DL data forwarding", fteidUserPlane},
+	FTEIDInterfaceSmMBMSGWGTPC:                        {"Sm MBMS GW GTP-C", fteidControlPlane},
+	FTEIDInterfaceSnMBMSGWGTPC:                        {"Sn MBMS GW GTP-C", fteidControlPlane},
+	FTEIDInterfaceSmMMEGTPC:                           {"Sm MME GTP-C", fteidControlPlane},
+	FTEIDInterfaceSnSGSNGTPC:                          {"Sn SGSN GTP-C", fteidControlPlane},
+	FTEIDInterfaceSGWGTPUForULDataForwarding:          {"SGW GTP-U for UL data forwarding", fteidUserPlane},
+	FTEIDInterfaceSnSGSNGTPU:                          {"Sn SGSN GTP-U", fteidUserPlane},
+	FTEIDInterfaceS2bePDGGTPC:                         {"S2b ePDG GTP-C", fteidControlPlane},
+	FTEIDInterfaceS2bUePDGGTPU:                        {"S2b-U ePDG GTP-U", fteidUserPlane},
+	FTEIDInterfaceS2bPGWGTPC:                          {"S2b PGW GTP-C", fteidControlPlane},
+	FTEIDInterfaceS2bUPGWGTPU:                         {"S2b-U PGW GTP-U", fteidUserPlane},
+	FTEIDInterfaceS2aTWANGTPU:                         {"S2a TWAN GTP-U", fteidUserPlane},
+	FTEIDInterfaceS2aTWANGTPC:                         {"S2a TWAN GTP-C", fteidControlPlane},
+	FTEIDInterfaceS2aPGWGTPC:                          {"S2a PGW GTP-C", fteidControlPlane},
+	FTEIDInterfaceS2aPGWGTPU:                          {"S2a PGW GTP-U", fteidUserPlane},
+	FTEIDInterfaceS11MMEGTPU:                          {"S11 MME GTP-U", fteidUserPlane},
+	FTEIDInterfaceS11SGWGTPU:                          {"S11 SGW GTP-U", fteidUserPlane},
+	FTEIDInterfaceN26AMFGTPC:                          {"N26 AMF GTP-C", fteidControlPlane},
+	FTEIDInterfaceN19mbUPFGTPU:                        {"N19mb UPF GTP-U", fteidUserPlane},
+}
+
+// fteidInterfaceTypesByNormalizedName maps the normalized form (see
+// normalizeFTEIDInterfaceTypeName()) of each name in fteidInterfaceTypeDescriptions
+// to its type
+var fteidInterfaceTypesByNormalizedName = func() map[string]FTEIDInterfaceType {
+	typesByName := make(map[string]FTEIDInterfaceType)
+	for interfaceType, description := range fteidInterfaceTypeDescriptions {
+		typesByName[normalizeFTEIDInterfaceTypeName(description.name)] = interfaceType
+	}
+	return typesByName
+}()
+
+// normalizeFTEIDInterfaceTypeName lowercases the name and removes everything but
+// letters and digits, so that "S5/S8 SGW GTP-U" and "S5S8SGWGTPU" are the same
+func normalizeFTEIDInterfaceTypeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		}
+		return -1
+	}, name)
+}
+
+// ParseFTEIDInterfaceType returns the F-TEID interface type with the provided name.
+// The name may be the one returned by String() (e.g., "S5/S8 SGW GTP-U") or the
+// constant name with or without the FTEIDInterface prefix (e.g., "S5S8SGWGTPU").
+// Case, spaces and punctuation are ignored.  A decimal value (e.g., "4") is also
+// accepted.  Returns an error if the name is not known.
+func ParseFTEIDInterfaceType(name string) (FTEIDInterfaceType, error) {
+	normalizedName := strings.TrimPrefix(normalizeFTEIDInterfaceTypeName(name), "fteidinterface")
+
+	if interfaceType, isKnown := fteidInterfaceTypesByNormalizedName[normalizedName]; isKnown {
+		return interfaceType, nil
+	}
+
+	if value, err := strconv.ParseUint(strings.TrimSpace(name), 10, 8); err == nil {
+		if value > uint64(MaximumFTEIDInterfaceType) {
+			return 0, fmt.Errorf("F-TEID interface type (%d) exceeds 6 bits", value)
+		}
+		return FTEIDInterfaceType(value), nil
+	}
+
+	return 0, fmt.Errorf("unknown F-TEID interface type (%s)", name)
+}
+
+func (interfaceType FTEIDInterfaceType) String() string {
+	if description, isKnown := fteidInterfaceTypeDescriptions[interfaceType]; isKnown {
+		return description.name
+	}
+
+	return fmt.Sprintf("unknown F-TEID interface type (%d)", uint8(interfaceType))
+}
+
+// IsKnown returns true if the interface type is defined in TS 29.274 Table 8.22-1
+func (interfaceType FTEIDInterfaceType) IsKnown() bool {
+	_, isKnown := fteidInterfaceTypeDescriptions[interfaceType]
+	return isKnown
+}
+
+// IsControlPlane returns true if the interface type is a control plane endpoint
+// (GTP-C or PMIPv6)
+func (interfaceType FTEIDInterfaceType) IsControlPlane() bool {
+	return fteidInterfaceTypeDescriptions[interfaceType].plane == fteidControlPlane
+}
+
+// IsUserPlane returns true if the interface type is a user plane (GTP-U) endpoint,
+// including the data forwarding endpoints
+func (interfaceType FTEIDInterfaceType) IsUserPlane() bool {
+	return fteidInterfaceTypeDescriptions[interfaceType].plane == fteidUserPlane
+}
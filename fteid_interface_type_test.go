@@ -0,0 +1,131 @@
+package gtpv2
+
+import (
+	"net"
+	"testing"
+)
+
+func TestFTEIDInterfaceTypeString(t *testing.T) {
+	for _, testCase := range []struct {
+		interfaceType FTEIDInterfaceType
+		expected      string
+	}{
+		{FTEIDInterfaceS1UeNodeBGTPU, "S1-U eNodeB GTP-U"},
+		{FTEIDInterfaceS5S8SGWGTPU, "S5/S8 SGW GTP-U"},
+		{FTEIDInterfaceS11MMEGTPC, "S11 MME GTP-C"},
+		{FTEIDInterfaceS4SGWGTPU, "S4 SGW GTP-U"},
+		{FTEIDInterfaceType(63), "unknown F-TEID interface type (63)"},
+	} {
+		if got := testCase.interfaceType.String(); got != testCase.expected {
+			t.Errorf("[TestFTEIDInterfaceTypeString] for value (%d) expected (%s), got (%s)", uint8(testCase.interfaceType), testCase.expected, got)
+		}
+	}
+}
+
+func TestParseFTEIDInterfaceType(t *testing.T) {
+	for _, testCase := range []struct {
+		name          string
+		expected      FTEIDInterfaceType
+		expectAnError bool
+	}{
+		{name: "S5/S8 SGW GTP-U", expected: FTEIDInterfaceS5S8SGWGTPU},
+		{name: "S5S8SGWGTPU", expected: FTEIDInterfaceS5S8SGWGTPU},
+		{name: "s5/s8 sgw gtp-u", expected: FTEIDInterfaceS5S8SGWGTPU},
+		{name: "FTEIDInterfaceS11MMEGTPC", expected: FTEIDInterfaceS11MMEGTPC},
+		{name: "4", expected: FTEIDInterfaceS5S8SGWGTPU},
+		{name: "63", expected: FTEIDInterfaceType(63)},
+		{name: "S99 Nowhere GTP-C", expectAnError: true},
+		{name: "64", expectAnError: true},
+		{name: "", expectAnError: true},
+	} {
+		got, err := ParseFTEIDInterfaceType(testCase.name)
+		if testCase.expectAnError {
+			if err == nil {
+				t.Errorf("[TestParseFTEIDInterfaceType] for (%s) expected an error, got none", testCase.name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("[TestParseFTEIDInterfaceType] for (%s) expected no error, got (%s)", testCase.name, err)
+		} else if got != testCase.expected {
+			t.Errorf("[TestParseFTEIDInterfaceType] for (%s) expected (%d), got (%d)", testCase.name, uint8(testCase.expected), uint8(got))
+		}
+	}
+
+	for interfaceType := FTEIDInterfaceType(0); interfaceType <= MaximumFTEIDInterfaceType; interfaceType++ {
+		if !interfaceType.IsKnown() {
+			continue
+		}
+
+		got, err := ParseFTEIDInterfaceType(interfaceType.String())
+		if err != nil {
+			t.Errorf("[TestParseFTEIDInterfaceType] for (%s) expected no error, got (%s)", interfaceType, err)
+		} else if got != interfaceType {
+			t.Errorf("[TestParseFTEIDInterfaceType] for (%s) expected (%d), got (%d)", interfaceType, uint8(interfaceType), uint8(got))
+		}
+	}
+}
+
+func TestFTEIDInterfaceTypePlane(t *testing.T) {
+	for _, testCase := range []struct {
+		interfaceType      FTEIDInterfaceType
+		expectControlPlane bool
+		expectUserPlane    bool
+	}{
+		{FTEIDInterfaceS11MMEGTPC, true, false},
+		{FTEIDInterfaceS1UeNodeBGTPU, false, true},
+		{FTEIDInterfaceS5S8SGWGTPU, false, true},
+		{FTEIDInterfaceS2bePDGGTPC, true, false},
+		{FTEIDInterfaceType(63), false, false},
+	} {
+		if got := testCase.interfaceType.IsControlPlane(); got != testCase.expectControlPlane {
+			t.Errorf("[TestFTEIDInterfaceTypePlane] for (%s) expected IsControlPlane() (%t), got (%t)", testCase.interfaceType, testCase.expectControlPlane, got)
+		}
+		if got := testCase.interfaceType.IsUserPlane(); got != testCase.expectUserPlane {
+			t.Errorf("[TestFTEIDInterfaceTypePlane] for (%s) expected IsUserPlane() (%t), got (%t)", testCase.interfaceType, testCase.expectUserPlane, got)
+		}
+	}
+}
+
+func TestFTEIDInterfaceTypeEncoding(t *testing.T) {
+	ie := (&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1), InterfaceType: FTEIDInterfaceS4SGWGTPU, Key: 1}).ToIE()
+	if ie.Data[0]&0x3f != 16 {
+		t.Errorf("[TestFTEIDInterfaceTypeEncoding] expected interface type bits (16), got (%d)", ie.Data[0]&0x3f)
+	}
+
+	typedData, err := ie.TypedDataErrorable()
+	if err != nil {
+		t.Fatalf("[TestFTEIDInterfaceTypeEncoding] expected no error on TypedDataErrorable(), got (%s)", err)
+	}
+	if got := typedData.(*TypedFTEID).InterfaceType; got != FTEIDInterfaceS4SGWGTPU {
+		t.Errorf("[TestFTEIDInterfaceTypeEncoding] expected decoded interface type (%s), got (%s)", FTEIDInterfaceS4SGWGTPU, got)
+	}
+
+	if _, err := (&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1), InterfaceType: 0x40, Key: 1}).ToIEErrorable(); err == nil {
+		t.Errorf("[TestFTEIDInterfaceTypeEncoding] expected error on interface type (0x40), got none")
+	}
+}
+
+func TestTypedFTEIDShortData(t *testing.T) {
+	for _, data := range [][]byte{{}, {0x8a}, {0x8a, 0x00, 0x00, 0x00}} {
+		if _, err := NewIEWithRawData(FTEID, data).TypedDataErrorable(); err == nil {
+			t.Errorf("[TestTypedFTEIDShortData] for data of length (%d) expected error on TypedDataErrorable(), got none", len(data))
+		}
+	}
+
+	wire := NewPDU(CreateSessionRequest, 1, []*IE{NewIEWithRawData(FTEID, []byte{})}).SetTEID(0).Encode()
+
+	pdu, _, err := DecodePDU(wire)
+	if err != nil {
+		t.Fatalf("[TestTypedFTEIDShortData] on DecodePDU() expected no error, got (%s)", err)
+	}
+
+	if _, err := DecodeTypedMessage(pdu); err == nil {
+		t.Errorf("[TestTypedFTEIDShortData] on DecodeTypedMessage() with empty F-TEID expected error, got none")
+	}
+
+	if _, err := pdu.LookupTypedIE("FTEID"); err == nil {
+		t.Errorf("[TestTypedFTEIDShortData] on LookupTypedIE() with empty F-TEID expected error, got none")
+	}
+}
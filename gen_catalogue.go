@@ -0,0 +1,287 @@
+//go:build ignore
+// +build ignore
+
+// This program generates catalogue.go from catalogue.yaml.  It is invoked
+// by "go generate".
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+type catalogueEntry struct {
+	Value     int      `yaml:"Value"`
+	Name      string   `yaml:"Name"`
+	Constants []string `yaml:"Constants"`
+	YamlKeys  []string `yaml:"YamlKeys"`
+	Grouped   bool     `yaml:"Grouped"`
+	Release   int      `yaml:"Release"`
+
+	// These apply only to message types
+	Class      string   `yaml:"Class"`
+	Response   string   `yaml:"Response"`
+	Triggers   []string `yaml:"Triggers"`
+	Interfaces []string `yaml:"Interfaces"`
+}
+
+type catalogue struct {
+	IETypes      []catalogueEntry `yaml:"IETypes"`
+	MessageTypes []catalogueEntry `yaml:"MessageTypes"`
+}
+
+func validateEntries(kind string, entries []catalogueEntry, maximumValue int) {
+	seenValues := make(map[int]bool)
+
+	for _, entry := range entries {
+		if entry.Value < 0 || entry.Value > maximumValue {
+			log.Fatalf("%s value (%d) is out of range", kind, entry.Value)
+		}
+		if seenValues[entry.Value] {
+			log.Fatalf("%s value (%d) appears more than once", kind, entry.Value)
+		}
+		if len(entry.Constants) == 0 {
+			log.Fatalf("%s value (%d) has no constants", kind, entry.Value)
+		}
+		if entry.Release < 8 {
+			log.Fatalf("%s value (%d) has invalid release (%d)", kind, entry.Value, entry.Release)
+		}
+
+		seenValues[entry.Value] = true
+	}
+}
+
+var messageClassConstants = map[string]string{
+	"Request":           "MessageClassRequest",
+	"Response":          "MessageClassResponse",
+	"Command":           "MessageClassCommand",
+	"FailureIndication": "MessageClassFailureIndication",
+	"Notification":      "MessageClassNotification",
+	"Acknowledge":       "MessageClassAcknowledge",
+	"Indication":        "MessageClassIndication",
+}
+
+var interfaceConstants = map[string]string{
+	"S2a":  "InterfaceS2a",
+	"S2b":  "InterfaceS2b",
+	"S3":   "InterfaceS3",
+	"S4":   "InterfaceS4",
+	"S5S8": "InterfaceS5S8",
+	"S10":  "InterfaceS10",
+	"S11":  "InterfaceS11",
+	"S16":  "InterfaceS16",
+	"Sm":   "InterfaceSm",
+	"Sn":   "InterfaceSn",
+}
+
+func validateMessageMetadata(entries []catalogueEntry) {
+	messageConstants := make(map[string]bool)
+	for _, entry := range entries {
+		messageConstants[entry.Constants[0]] = true
+	}
+
+	answeredBy := make(map[string]string)
+
+	for _, entry := range entries {
+		name := entry.Constants[0]
+
+		if _, isKnown := messageClassConstants[entry.Class]; !isKnown {
+			log.Fatalf("message type (%s) has invalid class (%s)", name, entry.Class)
+		}
+		if len(entry.Interfaces) == 0 {
+			log.Fatalf("message type (%s) has no interfaces", name)
+		}
+		for _, interfaceName := range entry.Interfaces {
+			if _, isKnown := interfaceConstants[interfaceName]; !isKnown {
+				log.Fatalf("message type (%s) has invalid interface (%s)", name, interfaceName)
+			}
+		}
+		for _, triggered := range append([]string{entry.Response}, entry.Triggers...) {
+			if triggered != "" && !messageConstants[triggered] {
+				log.Fatalf("message type (%s) refers to unknown message type (%s)", name, triggered)
+			}
+		}
+		if len(entry.Triggers) > 0 && entry.Class != "Command" {
+			log.Fatalf("message type (%s) has Triggers but is not a Command", name)
+		}
+		if entry.Response != "" {
+			if previous, isAnswered := answeredBy[entry.Response]; isAnswered {
+				log.Fatalf("message type (%s) is the response to both (%s) and (%s)", entry.Response, previous, name)
+			}
+			answeredBy[entry.Response] = name
+		}
+	}
+}
+
+func writeNameTable(out *bytes.Buffer, variableName string, entries []catalogueEntry) {
+	namesByValue := make(map[int]string)
+	for _, entry := range entries {
+		if entry.Value <= 255 {
+			namesByValue[entry.Value] = entry.Name
+		}
+	}
+
+	fmt.Fprintf(out, "var %s = []string{\n", variableName)
+	for value := 0; value <= 255; value++ {
+		name, isKnown := namesByValue[value]
+		if !isKnown {
+			name = "Reserved"
+		}
+		fmt.Fprintf(out, "\t%q, // %d\n", name, value)
+	}
+	fmt.Fprintf(out, "}\n\n")
+}
+
+func main() {
+	source, err := ioutil.ReadFile("catalogue.yaml")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var c catalogue
+	if err := yaml.UnmarshalStrict(source, &c); err != nil {
+		log.Fatal(err)
+	}
+
+	validateEntries("IE type", c.IETypes, 0xffff)
+	validateEntries("message type", c.MessageTypes, 0xff)
+	validateMessageMetadata(c.MessageTypes)
+
+	for _, entry := range c.IETypes {
+		if entry.Class != "" || entry.Response != "" || len(entry.Triggers) > 0 || len(entry.Interfaces) > 0 {
+			log.Fatalf("IE type value (%d) has message type fields", entry.Value)
+		}
+	}
+
+	sort.SliceStable(c.IETypes, func(i, j int) bool { return c.IETypes[i].Value < c.IETypes[j].Value })
+	sort.SliceStable(c.MessageTypes, func(i, j int) bool { return c.MessageTypes[i].Value < c.MessageTypes[j].Value })
+
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "// Code generated by gen_catalogue.go from catalogue.yaml; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package gtpv2\n\n")
+
+	fmt.Fprintf(&out, "// These represent possible GTPv2 IE types.  In some cases, includes the\n")
+	fmt.Fprintf(&out, "// full name and its abbreviation (e.g., for IMSI)\n")
+	fmt.Fprintf(&out, "const (\n")
+	for _, entry := range c.IETypes {
+		for _, constant := range entry.Constants {
+			fmt.Fprintf(&out, "\t%s = %d\n", constant, entry.Value)
+		}
+	}
+	fmt.Fprintf(&out, ")\n\n")
+
+	fmt.Fprintf(&out, "// GTPv2 MessageTypes\n")
+	fmt.Fprintf(&out, "const (\n")
+	for _, entry := range c.MessageTypes {
+		for _, constant := range entry.Constants {
+			fmt.Fprintf(&out, "\t%s MessageType = %d\n", constant, entry.Value)
+		}
+	}
+	fmt.Fprintf(&out, ")\n\n")
+
+	writeNameTable(&out, "ieNames", c.IETypes)
+
+	fmt.Fprintf(&out, "var extendedIENames = map[IEType]string{\n")
+	for _, entry := range c.IETypes {
+		if entry.Value > 255 {
+			fmt.Fprintf(&out, "\t%d: %q,\n", entry.Value, entry.Name)
+		}
+	}
+	fmt.Fprintf(&out, "}\n\n")
+
+	fmt.Fprintf(&out, "var ieTypeReleases = map[IEType]Release{\n")
+	for _, entry := range c.IETypes {
+		fmt.Fprintf(&out, "\t%s: Release%d,\n", entry.Constants[0], entry.Release)
+	}
+	fmt.Fprintf(&out, "}\n\n")
+
+	fmt.Fprintf(&out, "var ieShortNames = map[IEType]string{\n")
+	for _, entry := range c.IETypes {
+		fmt.Fprintf(&out, "\t%s: %q,\n", entry.Constants[0], entry.Constants[len(entry.Constants)-1])
+	}
+	fmt.Fprintf(&out, "}\n\n")
+
+	fmt.Fprintf(&out, "var groupedIETypes = map[IEType]bool{\n")
+	for _, entry := range c.IETypes {
+		if entry.Grouped {
+			fmt.Fprintf(&out, "\t%s: true,\n", entry.Constants[0])
+		}
+	}
+	fmt.Fprintf(&out, "}\n\n")
+
+	fmt.Fprintf(&out, "var mapOfYamlIETypeToIEType = map[string]IEType{\n")
+	for _, entry := range c.IETypes {
+		for _, key := range append(append([]string{}, entry.Constants...), entry.YamlKeys...) {
+			fmt.Fprintf(&out, "\t%q: %s,\n", key, entry.Constants[0])
+		}
+	}
+	fmt.Fprintf(&out, "}\n\n")
+
+	writeNameTable(&out, "messageNames", c.MessageTypes)
+
+	fmt.Fprintf(&out, "var messageTypeReleases = map[MessageType]Release{\n")
+	for _, entry := range c.MessageTypes {
+		fmt.Fprintf(&out, "\t%s: Release%d,\n", entry.Constants[0], entry.Release)
+	}
+	fmt.Fprintf(&out, "}\n\n")
+
+	fmt.Fprintf(&out, "var messageTypeClasses = map[MessageType]MessageClass{\n")
+	for _, entry := range c.MessageTypes {
+		fmt.Fprintf(&out, "\t%s: %s,\n", entry.Constants[0], messageClassConstants[entry.Class])
+	}
+	fmt.Fprintf(&out, "}\n\n")
+
+	fmt.Fprintf(&out, "var messageTypeResponses = map[MessageType]MessageType{\n")
+	for _, entry := range c.MessageTypes {
+		if entry.Response != "" {
+			fmt.Fprintf(&out, "\t%s: %s,\n", entry.Constants[0], entry.Response)
+		}
+	}
+	fmt.Fprintf(&out, "}\n\n")
+
+	fmt.Fprintf(&out, "var messageTypeTriggers = map[MessageType][]MessageType{\n")
+	for _, entry := range c.MessageTypes {
+		if len(entry.Triggers) > 0 {
+			fmt.Fprintf(&out, "\t%s: {", entry.Constants[0])
+			for _, triggered := range entry.Triggers {
+				fmt.Fprintf(&out, "%s, ", triggered)
+			}
+			fmt.Fprintf(&out, "},\n")
+		}
+	}
+	fmt.Fprintf(&out, "}\n\n")
+
+	fmt.Fprintf(&out, "var messageTypeInterfaces = map[MessageType][]Interface{\n")
+	for _, entry := range c.MessageTypes {
+		fmt.Fprintf(&out, "\t%s: {", entry.Constants[0])
+		for _, interfaceName := range entry.Interfaces {
+			fmt.Fprintf(&out, "%s, ", interfaceConstants[interfaceName])
+		}
+		fmt.Fprintf(&out, "},\n")
+	}
+	fmt.Fprintf(&out, "}\n\n")
+
+	fmt.Fprintf(&out, "var mapOfYamlPduTypeToMessageType = map[string]MessageType{\n")
+	for _, entry := range c.MessageTypes {
+		for _, key := range append(append([]string{}, entry.Constants...), entry.YamlKeys...) {
+			fmt.Fprintf(&out, "\t%q: %s,\n", key, entry.Constants[0])
+		}
+	}
+	fmt.Fprintf(&out, "}\n")
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("formatting generated source: %s", err)
+	}
+
+	if err := ioutil.WriteFile("catalogue.go", formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,346 @@
+//go:build ignore
+// +build ignore
+
+// This program generates schemas.go and messages.go from schemas.yaml.  It is
+// invoked by "go generate".
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+
+	"gopkg.in/yaml.v2"
+)
+
+type ieSchemaEntry struct {
+	Role     string          `yaml:"Role"`
+	Type     string          `yaml:"Type"`
+	Instance int             `yaml:"Instance"`
+	Presence string          `yaml:"Presence"`
+	Multiple bool            `yaml:"Multiple"`
+	Group    string          `yaml:"Group"`
+	IEs      []ieSchemaEntry `yaml:"IEs"`
+
+	Interfaces          []string           `yaml:"Interfaces"`
+	PresenceOn          map[string]string  `yaml:"PresenceOn"`
+	FTEIDInterfaceTypes map[string][]uint8 `yaml:"FTEIDInterfaceTypes"`
+}
+
+type messageSchemaEntry struct {
+	Message string          `yaml:"Message"`
+	IEs     []ieSchemaEntry `yaml:"IEs"`
+}
+
+type schemas struct {
+	Messages []messageSchemaEntry `yaml:"Messages"`
+}
+
+var presenceConstants = map[string]string{
+	"M":  "PresenceMandatory",
+	"C":  "PresenceConditional",
+	"CO": "PresenceConditionalOptional",
+	"O":  "PresenceOptional",
+}
+
+// interfaceNames are the interface names accepted in schemas.yaml, in the order
+// in which they are written to schemas.go
+var interfaceNames = []string{"S2a", "S2b", "S3", "S4", "S5S8", "S10", "S11", "S16", "Sm", "Sn"}
+
+func interfaceNameIsKnown(name string) bool {
+	for _, known := range interfaceNames {
+		if known == name {
+			return true
+		}
+	}
+	return false
+}
+
+// typedFieldTypes maps an IE type constant to the Go type of a typed message field
+// for an IE of that type.  It must agree with the decoders built into the package.
+// IE types that are not listed have fields of type *IE.
+var typedFieldTypes = map[string]string{
+	"IMSI":                               "*TypedIMSI",
+	"FTEID":                              "*TypedFTEID",
+	"SecondaryRATUsageDataReport":        "*TypedSecondaryRATUsageDataReport",
+	"UPFunctionSelectionIndicationFlags": "*TypedUPFunctionSelectionIndicationFlags",
+	"Cause":                              "*TypedCause",
+	"RecoveryRestartCounter":             "*TypedRecovery",
+	"AMBR":                               "*TypedAMBR",
+	"EBI":                                "*TypedEBI",
+	"MEI":                                "*TypedMEI",
+	"MSISDN":                             "*TypedMSISDN",
+	"BearerQoS":                          "*TypedBearerQoS",
+	"FlowQoS":                            "*TypedFlowQoS",
+	"RATType":                            "*TypedRATType",
+	"ServingNetwork":                     "*TypedServingNetwork",
+	"DelayValue":                         "*TypedDelayValue",
+	"ChargingID":                         "*TypedChargingID",
+	"ChargingCharacteristics":            "*TypedChargingCharacteristics",
+	"PDNType":                            "*TypedPDNType",
+	"ProcedureTransactionID":             "*TypedProcedureTransactionID",
+	"HopCounter":                         "*TypedHopCounter",
+	"UETimeZone":                         "*TypedUETimeZone",
+	"PortNumber":                         "*TypedPortNumber",
+	"APNRestriction":                     "*TypedAPNRestriction",
+	"SelectionMode":                      "*TypedSelectionMode",
+	"CSGID":                              "*TypedCSGID",
+	"NodeType":                           "*TypedNodeType",
+	"RFSPIndex":                          "*TypedRFSPIndex",
+	"DetachType":                         "*TypedDetachType",
+	"Throttling":                         "*TypedThrottling",
+	"ARP":                                "*TypedARP",
+	"EPCTimer":                           "*TypedEPCTimer",
+	"Metric":                             "*TypedMetric",
+	"SequenceNumber":                     "*TypedSequenceNumber",
+	"ServingPLMNRateControl":             "*TypedServingPLMNRateControl",
+	"Counter":                            "*TypedCounter",
+	"MappedUEUsageType":                  "*TypedMappedUEUsageType",
+	"MaximumPacketLossRate":              "*TypedMaximumPacketLossRate",
+}
+
+// reservedFieldNames are the typed message struct fields that are not roles
+var reservedFieldNames = map[string]bool{
+	"Header":     true,
+	"UnknownIEs": true,
+}
+
+var seenGroups = make(map[string]bool)
+
+func validateIEs(context string, entries []ieSchemaEntry) {
+	seenRoles := make(map[string]bool)
+	seenTypeAndInstance := make(map[string]bool)
+
+	for _, entry := range entries {
+		if entry.Role == "" || entry.Type == "" {
+			log.Fatalf("%s has an IE without Role or Type", context)
+		}
+		if reservedFieldNames[entry.Role] {
+			log.Fatalf("%s has reserved role name (%s)", context, entry.Role)
+		}
+		if seenRoles[entry.Role] {
+			log.Fatalf("%s has role (%s) more than once", context, entry.Role)
+		}
+		typeAndInstance := fmt.Sprintf("%s#%d", entry.Type, entry.Instance)
+		if seenTypeAndInstance[typeAndInstance] {
+			log.Fatalf("%s has type and instance (%s) more than once", context, typeAndInstance)
+		}
+		if entry.Instance < 0 || entry.Instance > 15 {
+			log.Fatalf("%s role (%s) has invalid instance (%d)", context, entry.Role, entry.Instance)
+		}
+		if _, isKnown := presenceConstants[entry.Presence]; !isKnown {
+			log.Fatalf("%s role (%s) has invalid presence (%s)", context, entry.Role, entry.Presence)
+		}
+		for _, interfaceName := range entry.Interfaces {
+			if !interfaceNameIsKnown(interfaceName) {
+				log.Fatalf("%s role (%s) has invalid interface (%s)", context, entry.Role, interfaceName)
+			}
+		}
+		for interfaceName, presence := range entry.PresenceOn {
+			if !interfaceNameIsKnown(interfaceName) {
+				log.Fatalf("%s role (%s) has invalid interface (%s) in PresenceOn", context, entry.Role, interfaceName)
+			}
+			if _, isKnown := presenceConstants[presence]; !isKnown {
+				log.Fatalf("%s role (%s) has invalid presence (%s) in PresenceOn", context, entry.Role, presence)
+			}
+		}
+		if len(entry.FTEIDInterfaceTypes) > 0 && entry.Type != "FTEID" {
+			log.Fatalf("%s role (%s) has FTEIDInterfaceTypes but is not an F-TEID", context, entry.Role)
+		}
+		for interfaceName, values := range entry.FTEIDInterfaceTypes {
+			if !interfaceNameIsKnown(interfaceName) {
+				log.Fatalf("%s role (%s) has invalid interface (%s) in FTEIDInterfaceTypes", context, entry.Role, interfaceName)
+			}
+			for _, value := range values {
+				if value > 0x3f {
+					log.Fatalf("%s role (%s) has invalid F-TEID interface type (%d)", context, entry.Role, value)
+				}
+			}
+		}
+		if (entry.Group == "") != (len(entry.IEs) == 0) {
+			log.Fatalf("%s role (%s) must have both Group and IEs, or neither", context, entry.Role)
+		}
+		if entry.Group != "" {
+			if seenGroups[entry.Group] {
+				log.Fatalf("group (%s) appears more than once", entry.Group)
+			}
+			seenGroups[entry.Group] = true
+			validateIEs(context+"/"+entry.Role, entry.IEs)
+		}
+
+		seenRoles[entry.Role] = true
+		seenTypeAndInstance[typeAndInstance] = true
+	}
+}
+
+func writeIEs(out *bytes.Buffer, entries []ieSchemaEntry) {
+	fmt.Fprintf(out, "[]*IESchema{\n")
+	for _, entry := range entries {
+		fmt.Fprintf(out, "{Role: %q, Type: %s, Instance: %d, Presence: %s", entry.Role, entry.Type, entry.Instance, presenceConstants[entry.Presence])
+		if entry.Multiple {
+			fmt.Fprintf(out, ", Multiple: true")
+		}
+		if len(entry.Interfaces) > 0 {
+			fmt.Fprintf(out, ", Interfaces: []Interface{")
+			for _, interfaceName := range entry.Interfaces {
+				fmt.Fprintf(out, "Interface%s, ", interfaceName)
+			}
+			fmt.Fprintf(out, "}")
+		}
+		if len(entry.PresenceOn) > 0 {
+			fmt.Fprintf(out, ", PresenceByInterface: map[Interface]IEPresence{")
+			for _, interfaceName := range interfaceNames {
+				if presence, isPresent := entry.PresenceOn[interfaceName]; isPresent {
+					fmt.Fprintf(out, "Interface%s: %s, ", interfaceName, presenceConstants[presence])
+				}
+			}
+			fmt.Fprintf(out, "}")
+		}
+		if len(entry.FTEIDInterfaceTypes) > 0 {
+			fmt.Fprintf(out, ", FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{")
+			for _, interfaceName := range interfaceNames {
+				if values, isPresent := entry.FTEIDInterfaceTypes[interfaceName]; isPresent {
+					fmt.Fprintf(out, "Interface%s: {", interfaceName)
+					for _, value := range values {
+						fmt.Fprintf(out, "%d, ", value)
+					}
+					fmt.Fprintf(out, "}, ")
+				}
+			}
+			fmt.Fprintf(out, "}")
+		}
+		if entry.Group != "" {
+			fmt.Fprintf(out, ", Group: %q, GroupedIEs: ", entry.Group)
+			writeIEs(out, entry.IEs)
+		}
+		fmt.Fprintf(out, "},\n")
+	}
+	fmt.Fprintf(out, "}")
+}
+
+func fieldTypeFor(entry ieSchemaEntry) string {
+	fieldType := "*IE"
+	if entry.Group != "" {
+		fieldType = "*" + entry.Group
+	} else if typedType, isKnown := typedFieldTypes[entry.Type]; isKnown {
+		fieldType = typedType
+	}
+
+	if entry.Multiple {
+		return "[]" + fieldType
+	}
+
+	return fieldType
+}
+
+func writeRoleFields(out *bytes.Buffer, entries []ieSchemaEntry) {
+	for _, entry := range entries {
+		fmt.Fprintf(out, "%s %s // %s instance %d, %s", entry.Role, fieldTypeFor(entry), entry.Type, entry.Instance, entry.Presence)
+		if entry.Multiple {
+			fmt.Fprintf(out, ", multiple")
+		}
+		fmt.Fprintf(out, "\n")
+	}
+	fmt.Fprintf(out, "UnknownIEs []*IE\n")
+	fmt.Fprintf(out, "decodedIEs\n")
+}
+
+func writeGroupStructs(out *bytes.Buffer, context string, entries []ieSchemaEntry) {
+	for _, entry := range entries {
+		if entry.Group == "" {
+			continue
+		}
+
+		fmt.Fprintf(out, "// %s is the content of the %s grouped IE in %s\n", entry.Group, entry.Role, context)
+		fmt.Fprintf(out, "type %s struct {\n", entry.Group)
+		writeRoleFields(out, entry.IEs)
+		fmt.Fprintf(out, "}\n\n")
+
+		writeGroupStructs(out, context, entry.IEs)
+	}
+}
+
+func writeMessages(s *schemas) []byte {
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "// Code generated by gen_schemas.go from schemas.yaml; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package gtpv2\n\n")
+
+	for _, message := range s.Messages {
+		structName := message.Message + "Message"
+
+		fmt.Fprintf(&out, "// %s is the typed message (see TypedMessage) for %s\n", structName, message.Message)
+		fmt.Fprintf(&out, "type %s struct {\n", structName)
+		fmt.Fprintf(&out, "Header MessageHeader\n")
+		writeRoleFields(&out, message.IEs)
+		fmt.Fprintf(&out, "}\n\n")
+
+		fmt.Fprintf(&out, "// MessageType returns %s\n", message.Message)
+		fmt.Fprintf(&out, "func (message *%s) MessageType() MessageType {\nreturn %s\n}\n\n", structName, message.Message)
+		fmt.Fprintf(&out, "// ToPDU converts the typed message to a PDU\n")
+		fmt.Fprintf(&out, "func (message *%s) ToPDU() (*PDU, error) {\nreturn typedMessageToPDU(%s, &message.Header, message)\n}\n\n", structName, message.Message)
+		fmt.Fprintf(&out, "// FromPDU replaces the content of the typed message with the content of the PDU,\n")
+		fmt.Fprintf(&out, "// which must have the message type %s\n", message.Message)
+		fmt.Fprintf(&out, "func (message *%s) FromPDU(pdu *PDU) error {\nreturn typedMessageFromPDU(pdu, %s, &message.Header, message)\n}\n\n", structName, message.Message)
+
+		writeGroupStructs(&out, message.Message, message.IEs)
+	}
+
+	fmt.Fprintf(&out, "var typedMessageConstructors = map[MessageType]func() TypedMessage{\n")
+	for _, message := range s.Messages {
+		fmt.Fprintf(&out, "%s: func() TypedMessage { return &%sMessage{} },\n", message.Message, message.Message)
+	}
+	fmt.Fprintf(&out, "}\n")
+
+	return out.Bytes()
+}
+
+func writeFormatted(fileName string, source []byte) {
+	formatted, err := format.Source(source)
+	if err != nil {
+		log.Fatalf("formatting generated %s: %s", fileName, err)
+	}
+
+	if err := ioutil.WriteFile(fileName, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func main() {
+	source, err := ioutil.ReadFile("schemas.yaml")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var s schemas
+	if err := yaml.UnmarshalStrict(source, &s); err != nil {
+		log.Fatal(err)
+	}
+
+	seenMessages := make(map[string]bool)
+	for _, message := range s.Messages {
+		if seenMessages[message.Message] {
+			log.Fatalf("message (%s) appears more than once", message.Message)
+		}
+		seenMessages[message.Message] = true
+		validateIEs(message.Message, message.IEs)
+	}
+
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "// Code generated by gen_schemas.go from schemas.yaml; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package gtpv2\n\n")
+
+	fmt.Fprintf(&out, "var messageSchemas = map[MessageType]*MessageSchema{\n")
+	for _, message := range s.Messages {
+		fmt.Fprintf(&out, "%s: {\nType: %s,\nIEs: ", message.Message, message.Message)
+		writeIEs(&out, message.IEs)
+		fmt.Fprintf(&out, ",\n},\n")
+	}
+	fmt.Fprintf(&out, "}\n")
+
+	writeFormatted("schemas.go", out.Bytes())
+	writeFormatted("messages.go", writeMessages(&s))
+}
@@ -0,0 +1,144 @@
+package gtpv2
+
+import "fmt"
+
+// HeaderViolation identifies a way in which a GTPv2 PDU header does not conform
+// to TS 29.274 section 5.5
+type HeaderViolation int
+
+// Possible HeaderViolation values
+const (
+	// HeaderViolationTEIDNotAllowed means the T flag is set for a message type
+	// that must not carry a TEID (e.g., Echo Request)
+	HeaderViolationTEIDNotAllowed HeaderViolation = iota + 1
+
+	// HeaderViolationTEIDRequired means the T flag is clear for a message type
+	// that must carry a TEID
+	HeaderViolationTEIDRequired
+
+	// HeaderViolationPriorityWithoutTEID means the MP flag is set but the T flag
+	// is clear, so there is no octet in which to carry the message priority
+	HeaderViolationPriorityWithoutTEID
+
+	// HeaderViolationSpareBitsSet means one or more spare bits in the header are
+	// not zero
+	HeaderViolationSpareBitsSet
+
+	// HeaderViolationPriorityOutOfRange means the message priority does not fit
+	// in its 4-bit field
+	HeaderViolationPriorityOutOfRange
+
+	// HeaderViolationSequenceNumberOutOfRange means the sequence number does not
+	// fit in its 24-bit field
+	HeaderViolationSequenceNumberOutOfRange
+)
+
+var headerViolationDescriptions = map[HeaderViolation]string{
+	HeaderViolationTEIDNotAllowed:           "message type must not carry a TEID",
+	HeaderViolationTEIDRequired:             "message type must carry a TEID",
+	HeaderViolationPriorityWithoutTEID:      "message priority is present without a TEID",
+	HeaderViolationSpareBitsSet:             "spare bits are not zero",
+	HeaderViolationPriorityOutOfRange:       "message priority exceeds 4 bits",
+	HeaderViolationSequenceNumberOutOfRange: "sequence number exceeds 24 bits",
+}
+
+func (violation HeaderViolation) String() string {
+	if description, isKnown := headerViolationDescriptions[violation]; isKnown {
+		return description
+	}
+
+	return fmt.Sprintf("unknown header violation (%d)", int(violation))
+}
+
+// HeaderError is returned when a PDU header does not conform to TS 29.274
+// section 5.5.  Use errors.As() to retrieve it and examine the Violation.
+type HeaderError struct {
+	Violation   HeaderViolation
+	MessageType MessageType
+}
+
+func (headerError *HeaderError) Error() string {
+	return fmt.Sprintf("invalid GTPv2 header for %s: %s", NameOfMessageForType(headerError.MessageType), headerError.Violation)
+}
+
+// messageTypesWithoutTEID are the message types whose header must not contain
+// the TEID field (TS 29.274 section 5.5.2).  Every other message type must.
+var messageTypesWithoutTEID = map[MessageType]bool{
+	EchoRequest:                   true,
+	EchoResponse:                  true,
+	VersionNotSupportedIndication: true,
+}
+
+func messageTypeCarriesTEID(messageType MessageType) bool {
+	return !messageTypesWithoutTEID[messageType]
+}
+
+// ValidateHeader returns a *HeaderError if the PDU header fields do not conform
+// to TS 29.274 section 5.5, and nil otherwise.  Encode() does not validate the
+// header, so that non-conforming PDUs can be produced deliberately; use
+// EncodeStrict() to validate and encode in one step.
+func (pdu *PDU) ValidateHeader() error {
+	if pdu.TEIDFieldIsPresent != messageTypeCarriesTEID(pdu.Type) {
+		if pdu.TEIDFieldIsPresent {
+			return &HeaderError{Violation: HeaderViolationTEIDNotAllowed, MessageType: pdu.Type}
+		}
+		return &HeaderError{Violation: HeaderViolationTEIDRequired, MessageType: pdu.Type}
+	}
+
+	if pdu.PriorityFieldIsPresent && !pdu.TEIDFieldIsPresent {
+		return &HeaderError{Violation: HeaderViolationPriorityWithoutTEID, MessageType: pdu.Type}
+	}
+
+	if pdu.Priority > 0x0f {
+		return &HeaderError{Violation: HeaderViolationPriorityOutOfRange, MessageType: pdu.Type}
+	}
+
+	if pdu.SequenceNumber > 0x00ffffff {
+		return &HeaderError{Violation: HeaderViolationSequenceNumberOutOfRange, MessageType: pdu.Type}
+	}
+
+	return nil
+}
+
+// EncodeStrict is the same as Encode(), but first validates the header with
+// ValidateHeader() and returns the error if validation fails
+func (pdu *PDU) EncodeStrict() ([]byte, error) {
+	return pdu.EncodeWithOptions(EncodeOptions{StrictHeader: true})
+}
+
+// validateEncodedHeader checks an encoded header, which must have at least 8
+// octets (or 12 if the T flag is set), for conformance to TS 29.274 section 5.5
+func validateEncodedHeader(stream []byte) error {
+	messageType := MessageType(stream[1])
+	hasTeidField := stream[0]&0x08 != 0
+	hasPriorityField := stream[0]&0x04 != 0
+
+	if hasTeidField != messageTypeCarriesTEID(messageType) {
+		if hasTeidField {
+			return &HeaderError{Violation: HeaderViolationTEIDNotAllowed, MessageType: messageType}
+		}
+		return &HeaderError{Violation: HeaderViolationTEIDRequired, MessageType: messageType}
+	}
+
+	if hasPriorityField && !hasTeidField {
+		return &HeaderError{Violation: HeaderViolationPriorityWithoutTEID, MessageType: messageType}
+	}
+
+	spareBitsAreSet := stream[0]&0x03 != 0
+
+	if hasTeidField {
+		spareMask := byte(0xff)
+		if hasPriorityField {
+			spareMask = 0x0f
+		}
+		spareBitsAreSet = spareBitsAreSet || stream[11]&spareMask != 0
+	} else {
+		spareBitsAreSet = spareBitsAreSet || stream[7] != 0
+	}
+
+	if spareBitsAreSet {
+		return &HeaderError{Violation: HeaderViolationSpareBitsSet, MessageType: messageType}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package gtpv2
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateHeader(t *testing.T) {
+	testCases := []struct {
+		name              string
+		pdu               *PDU
+		expectedViolation HeaderViolation
+	}{
+		{"Echo Request without TEID", NewPDU(EchoRequest, 1, nil), 0},
+		{"Echo Request with TEID", NewPDU(EchoRequest, 1, nil).SetTEID(0), HeaderViolationTEIDNotAllowed},
+		{"Create Session Request without TEID", NewPDU(CreateSessionRequest, 1, nil), HeaderViolationTEIDRequired},
+		{"Create Session Request with TEID 0", NewPDU(CreateSessionRequest, 1, nil).SetTEID(0), 0},
+		{"Version Not Supported with priority", NewPDU(VersionNotSupportedIndication, 1, nil).AddPriority(3), HeaderViolationPriorityWithoutTEID},
+		{"Modify Bearer Request with priority", NewPDU(ModifyBearerRequest, 1, nil).SetTEID(1).AddPriority(3), 0},
+		{"Sequence number too large", &PDU{Type: ModifyBearerRequest, TEIDFieldIsPresent: true, SequenceNumber: 0x01000000, TotalLength: 12}, HeaderViolationSequenceNumberOutOfRange},
+		{"Priority too large", &PDU{Type: ModifyBearerRequest, TEIDFieldIsPresent: true, PriorityFieldIsPresent: true, Priority: 0x10, TotalLength: 12}, HeaderViolationPriorityOutOfRange},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.pdu.ValidateHeader()
+
+		if testCase.expectedViolation == 0 {
+			if err != nil {
+				t.Errorf("(%s) expected no error on ValidateHeader(), got = (%s)", testCase.name, err)
+			}
+			continue
+		}
+
+		var headerError *HeaderError
+		if !errors.As(err, &headerError) {
+			t.Errorf("(%s) expected *HeaderError on ValidateHeader(), got = (%v)", testCase.name, err)
+		} else if headerError.Violation != testCase.expectedViolation {
+			t.Errorf("(%s) expected violation (%s), got = (%s)", testCase.name, testCase.expectedViolation, headerError.Violation)
+		}
+
+		if _, err := testCase.pdu.EncodeStrict(); err == nil {
+			t.Errorf("(%s) expected error on EncodeStrict(), got none", testCase.name)
+		}
+	}
+}
+
+func TestDecodeHeaderStrictAndLenient(t *testing.T) {
+	testCases := []struct {
+		name              string
+		stream            []byte
+		expectedViolation HeaderViolation
+	}{
+		{"Echo Request with TEID", []byte{0x48, 0x01, 0x00, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00}, HeaderViolationTEIDNotAllowed},
+		{"Echo Request with MP flag", []byte{0x44, 0x01, 0x00, 0x04, 0x00, 0x00, 0x01, 0x00}, HeaderViolationPriorityWithoutTEID},
+		{"Echo Request with spare octet set", []byte{0x40, 0x01, 0x00, 0x04, 0x00, 0x00, 0x01, 0x30}, HeaderViolationSpareBitsSet},
+		{"Modify Bearer Request with spare flag bits set", []byte{0x49, 0x22, 0x00, 0x08, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x01, 0x00}, HeaderViolationSpareBitsSet},
+		{"Modify Bearer Request with spare priority bits set", []byte{0x4c, 0x22, 0x00, 0x08, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x01, 0x31}, HeaderViolationSpareBitsSet},
+		{"Modify Bearer Request without TEID", []byte{0x40, 0x22, 0x00, 0x04, 0x00, 0x00, 0x01, 0x00}, HeaderViolationTEIDRequired},
+	}
+
+	for _, testCase := range testCases {
+		if _, _, err := DecodePDU(testCase.stream); err != nil {
+			t.Errorf("(%s) expected no error on lenient DecodePDU(), got = (%s)", testCase.name, err)
+		}
+
+		_, _, err := DecodePDUWithOptions(testCase.stream, DecodeOptions{StrictHeader: true})
+
+		var headerError *HeaderError
+		if !errors.As(err, &headerError) {
+			t.Errorf("(%s) expected *HeaderError on strict decode, got = (%v)", testCase.name, err)
+		} else if headerError.Violation != testCase.expectedViolation {
+			t.Errorf("(%s) expected violation (%s), got = (%s)", testCase.name, testCase.expectedViolation, headerError.Violation)
+		}
+	}
+
+	pdu, _, err := DecodePDU([]byte{0x44, 0x01, 0x00, 0x04, 0x00, 0x00, 0x01, 0x00})
+	if err != nil {
+		t.Fatalf("On lenient decode of Echo Request with MP flag, expected no error, got = (%s)", err)
+	}
+
+	if pdu.PriorityFieldIsPresent || pdu.Priority != 0 || pdu.SequenceNumber != 1 {
+		t.Errorf("On lenient decode of Echo Request with MP flag, expected no priority and sequence number (1), got = (%t), (%d), (%d)", pdu.PriorityFieldIsPresent, pdu.Priority, pdu.SequenceNumber)
+	}
+
+	pdu, _, err = DecodePDUWithOptions([]byte{0x4c, 0x22, 0x00, 0x08, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x01, 0x70}, DecodeOptions{StrictHeader: true})
+	if err != nil {
+		t.Fatalf("On strict decode of valid Modify Bearer Request with priority, expected no error, got = (%s)", err)
+	}
+
+	if !pdu.PriorityFieldIsPresent || pdu.Priority != 7 {
+		t.Errorf("On strict decode of Modify Bearer Request with priority, expected priority (7), got = (%t), (%d)", pdu.PriorityFieldIsPresent, pdu.Priority)
+	}
+
+	if _, _, err := DecodePDU([]byte{0x48, 0x22, 0x00, 0x04, 0x00, 0x00, 0x00, 0x01}); err == nil {
+		t.Errorf("On decode with TEID flag and length too short for TEID header, expected error, got none")
+	}
+}
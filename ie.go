@@ -1,239 +1,39 @@
 package gtpv2
 
 import (
-	"bytes"
 	"encoding/binary"
 	"fmt"
+	"io"
+	"math"
 	"net"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
 )
 
-// IEType represents the various IE types for GTPv2
-type IEType uint8
+// IEType represents the various IE types for GTPv2.  Values from 0 through 255
+// are encoded directly in the IE type octet.  Values above 255 are extended
+// types (TS 29.274 section 8.2.1A), which are encoded with the type octet set
+// to ExtensionType (254) followed by a 16-bit IE Type Extension field.
+type IEType uint16
 
-// These represent possible GTPv2 IE types.  In some cases, includes the
-// full name and its abbreviation (e.g., for IMSI)
-const (
-	InternationalMobileSubscriberIdentity                = 1
-	IMSI                                                 = 1
-	Cause                                                = 2
-	RecoveryRestartCounter                               = 3
-	STNSR                                                = 51
-	AccessPointName                                      = 71
-	APN                                                  = 71
-	AggregateMaximumBitRate                              = 72
-	AMBR                                                 = 72
-	EPSBearerID                                          = 73
-	EBI                                                  = 73
-	IPAddress                                            = 74
-	MobileEquipmentIdentity                              = 75
-	MEI                                                  = 75
-	MSISDN                                               = 76
-	Indication                                           = 77
-	ProtocolConfigurationOptions                         = 78
-	PCI                                                  = 78
-	PDNAddressAllocation                                 = 79
-	PAA                                                  = 79
-	BearerLevelQualityofService                          = 80
-	BearerQoS                                            = 80
-	FlowQualityofService                                 = 81
-	FlowQoS                                              = 81
-	RATType                                              = 82
-	ServingNetwork                                       = 83
-	EPSBearerLevelTrafficFlowTemplate                    = 84
-	BearerTFT                                            = 84
-	TrafficAggregationDescription                        = 85
-	TAD                                                  = 85
-	UserLocationInformation                              = 86
-	ULI                                                  = 86
-	FullyQualifiedTunnelEndpointIdentifier               = 87
-	FTEID                                                = 87
-	TMSI                                                 = 88
-	GlobalCNId                                           = 89
-	S103PDNDataForwardingInfo                            = 90
-	S103PDF                                              = 90
-	S1UDataForwardingInfo                                = 91
-	S1UDF                                                = 91
-	DelayValue                                           = 92
-	BearerContext                                        = 93
-	ChargingID                                           = 94
-	ChargingCharacteristics                              = 95
-	TraceInformation                                     = 96
-	BearerFlags                                          = 97
-	PDNType                                              = 99
-	ProcedureTransactionID                               = 100
-	MMContextGSMKeyandTriplets                           = 103
-	MMContextUMTSKeyUsedCipherandQuintuplets             = 104
-	MMContextGSMKeyUsedCipherandQuintuplets              = 105
-	MMContextUMTSKeyandQuintuplets                       = 106
-	MMContextEPSSecurityContextQuadrupletsandQuintuplets = 107
-	MMContextUMTSKeyQuadrupletsandQuintuplets            = 108
-	PDNConnection                                        = 109
-	PDUNumbers                                           = 110
-	PTMSI                                                = 111
-	PTMSISignature                                       = 112
-	HopCounter                                           = 113
-	UETimeZone                                           = 114
-	TraceReference                                       = 115
-	CompleteRequestMessage                               = 116
-	GUTI                                                 = 117
-	FContainer                                           = 118
-	FCause                                               = 119
-	PLMNID                                               = 120
-	TargetIdentification                                 = 121
-	PacketFlowID                                         = 123
-	RABContext                                           = 124
-	SourceRNCPDCPContextInfo                             = 125
-	PortNumber                                           = 126
-	APNRestriction                                       = 127
-	SelectionMode                                        = 128
-	SourceIdentification                                 = 129
-	ChangeReportingAction                                = 131
-	FullyQualifiedPDNConnectionSetIdentifier             = 132
-	FQCSID                                               = 132
-	Channelneeded                                        = 133
-	eMLPPPriority                                        = 134
-	NodeType                                             = 135
-	FullyQualifiedDomainName                             = 136
-	FQDN                                                 = 136
-	TransactionIdentifier                                = 137
-	TI                                                   = 137
-	MBMSSessionDuration                                  = 138
-	MBMSServiceArea                                      = 139
-	MBMSSessionIdentifier                                = 140
-	MBMSFlowIdentifier                                   = 141
-	MBMSIPMulticastDistribution                          = 142
-	MBMSDistributionAcknowledge                          = 143
-	RFSPIndex                                            = 144
-	UserCSGInformation                                   = 145
-	UCI                                                  = 145
-	CSGInformationReportingAction                        = 146
-	CSGID                                                = 147
-	CSGMembershipIndication                              = 148
-	CMI                                                  = 148
-	Serviceindicator                                     = 149
-	DetachType                                           = 150
-	LocalDistiguishedName                                = 151
-	LDN                                                  = 151
-	NodeFeatures                                         = 152
-	MBMSTimetoDataTransfer                               = 153
-	Throttling                                           = 154
-	AllocationRetentionPriority                          = 155
-	ARP                                                  = 155
-	EPCTimer                                             = 156
-	SignallingPriorityIndication                         = 157
-	TemporaryMobileGroupIdentity                         = 158
-	TMGI                                                 = 158
-	AdditionalMMcontextforSRVCC                          = 159
-	AdditionalflagsforSRVCC                              = 160
-	MDTConfiguration                                     = 162
-	AdditionalProtocolConfigurationOptions               = 163
-	APCO                                                 = 163
-	AbsoluteTimeofMBMSDataTransfer                       = 164
-	HeNBInformationReporting                             = 165
-	IPv4ConfigurationParameters                          = 166
-	IP4CP                                                = 166
-	ChangetoReportFlags                                  = 167
-	ActionIndication                                     = 168
-	TWANIdentifier                                       = 169
-	ULITimestamp                                         = 170
-	MBMSFlags                                            = 171
-	RANNASCause                                          = 172
-	CNOperatorSelectionEntity                            = 173
-	TrustedWLANModeIndication                            = 174
-	NodeNumber                                           = 175
-	NodeIdentifier                                       = 176
-	PresenceReportingAreaAction                          = 177
-	PresenceReportingAreaInformation                     = 178
-	TWANIdentifierTimestamp                              = 179
-	OverloadControlInformation                           = 180
-	LoadControlInformation                               = 181
-	Metric                                               = 182
-	SequenceNumber                                       = 183
-	APNandRelativeCapacity                               = 184
-	WLANOffloadabilityIndication                         = 185
-	PagingandServiceInformation                          = 186
-	IntegerNumber                                        = 187
-	MillisecondTimeStamp                                 = 188
-	MonitoringEventInformation                           = 189
-	ECGIList                                             = 190
-	RemoteUEContext                                      = 191
-	RemoteUserID                                         = 192
-	RemoteUEIPinformation                                = 193
-	CIoTOptimizationsSupportIndication                   = 194
-	SCEFPDNConnection                                    = 195
-	HeaderCompressionConfiguration                       = 196
-	ExtendedProtocolConfigurationOptions                 = 197
-	ePCO                                                 = 197
-	ServingPLMNRateControl                               = 198
-	Counter                                              = 199
-	MappedUEUsageType                                    = 200
-	SecondaryRATUsageDataReport                          = 201
-	UPFunctionSelectionIndicationFlags                   = 202
-	ExtensionType                                        = 254
-	PrivateExtension                                     = 255
-)
-
-var ieNames = []string{
-	"Reserved", "International Mobile Subscriber Identity (IMSI)", "Cause", "Recovery (Restart Counter)", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 19
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 39
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"STN-SR", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 59
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Access Point Name (APN)", "Aggregate Maximum Bit Rate (AMBR)", "EPS Bearer ID (EBI)", "IP Address",
-	"Mobile Equipment Identity (MEI)", "MSISDN", "Indication", "Protocol Configuration Options (PCO)", "PDN Address Allocation (PAA)", // 79
-	"Bearer Level Quality of Service (Bearer QoS)", "Flow Quality of Service (Flow QoS)", "RAT Type", "Serving Network", "EPS Bearer Level Traffic Flow Template (Bearer TFT)",
-	"Traffic Aggregation Description (TAD)", "User Location Information (ULI)", "Fully Qualified Tunnel Endpoint Identifier (F-TEID)", "TMSI", "Global CN-Id",
-	"S103 PDN Data Forwarding Info (S103PDF)", "S1-U Data Forwarding Info (S1UDF)", "Delay Value", "Bearer Context", "Charging ID",
-	"Charging Characteristics", "Trace Information", "Bearer Flags", "Reserved", "PDN Type", // 99
-	"Procedure Transaction ID", "Reserved", "Reserved", "MM Context (GSM Key and Triplets)", "MM Context (UMTS Key, Used Cipher and Quintuplets)",
-	"MM Context (GSM Key, Used Cipher and Quintuplets)", "MM Context (UMTS Key and Quintuplets)", "MM Context (EPS Security Context, Quadruplets and Quintuplets)", "MM Context (UMTS Key, Quadruplets and Quintuplets)", "PDN Connection",
-	"PDU Numbers", "P-TMSI", "P-TMSI Signature", "Hop Counter", "UE Time Zone",
-	"Trace Reference", "Complete Request Message", "GUTI", "F-Container", "F-Cause", // 119
-	"PLMN ID", "Target Identification", "Reserved", "Packet Flow ID", "RAB Context",
-	"Source RNC PDCP Context Info", "Port Number", "APN Restriction", "Selection Mode", "Source Identification",
-	"Reserved", "Change Reporting Action", "Fully Qualified PDN Connection Set Identifier (FQ-CSID)", "Channel needed", "eMLPP Priority",
-	"Node Type", "Fully Qualified Domain Name (FQDN)", "Transaction Identifier (TI)", "MBMS Session Duration", "MBMS Service Area", // 139
-	"MBMS Session Identifier", "MBMS Flow Identifier", "MBMS IP Multicast Distribution", "MBMS Distribution Acknowledge", "RFSP Index",
-	"User CSG Information (UCI)", "CSG Information Reporting Action", "CSG ID", "CSG Membership Indication (CMI)", "Service indicator",
-	"Detach Type", "Local Distiguished Name (LDN)", "Node Features", "MBMS Time to Data Transfer", "Throttling",
-	"Allocation/Retention Priority (ARP)", "EPC Timer", "Signalling Priority Indication", "Temporary Mobile Group Identity (TMGI)", "Additional MM context for SRVCC", // 159
-	"Additional flags for SRVCC", "Reserved", "MDT Configuration", "Additional Protocol Configuration Options (APCO)", "Absolute Time of MBMS Data Transfer",
-	"H(e)NB Information Reporting ", "IPv4 Configuration Parameters (IP4CP)", "Change to Report Flags", "Action Indication", "TWAN Identifier",
-	"ULI Timestamp", "MBMS Flags", "RAN/NAS Cause", "CN Operator Selection Entity", "Trusted WLAN Mode Indication",
-	"Node Number", "Node Identifier", "Presence Reporting Area Action", "Presence Reporting Area Information", "TWAN Identifier Timestamp", // 179
-	"Overload Control Information", "Load Control Information", "Metric", "Sequence Number", "APN and Relative Capacity",
-	"WLAN Offloadability Indication", "Paging and Service Information", "Integer Number", "Millisecond Time Stamp", "Monitoring Event Information",
-	"ECGI List", "Remote UE Context", "Remote User ID", "Remote UE IP information", "CIoT Optimizations Support Indication",
-	"SCEF PDN Connection", "Header Compression Configuration", "Extended Protocol Configuration Options (ePCO)", "Serving PLMN Rate Control", "Counter", // 199
-	"Mapped UE Usage Type", "Secondary RAT Usage Data Report", "UP Function Selection Indication Flags", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 219
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 239
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "IE Extension",
-	"Private Extension", // 255
-}
+// MinimumExtendedIEType is the smallest IEType that is encoded using the
+// extended type header format
+const MinimumExtendedIEType IEType = 256
 
 // NameOfIEForType returns a string identifier (from TS 29.274 section 8.1) for
-// a GTPv2 IE based on the type integer
+// a GTPv2 IE based on the type integer.  This includes extended IE types.
 func NameOfIEForType(ieType IEType) string {
-	return ieNames[int(ieType)]
+	if int(ieType) < len(ieNames) {
+		return ieNames[int(ieType)]
+	}
+
+	if name, isKnown := extendedIENames[ieType]; isKnown {
+		return name
+	}
+
+	return "Reserved"
 }
 
 // TypedIE represents any IE that has its encoded value converted
@@ -246,49 +46,117 @@ type TypedIE interface {
 // IE is a GTPv2 Information Element.  DataLength is the length of just
 // the contained data, in bytes.  TotalLength is the DataLength plus the
 // header length.  InstanceNumber is actually uint4.  Data is the BigEndian
-// data bytes.
+// data bytes.  If Type is an extended type, the header is 6 bytes long
+// (rather than 4) and Data does not include the IE Type Extension field.
+// Unparsed is true only for an IE produced by a partial decode (see
+// DecodePDUPartially()) from bytes that could not be decoded as an IE.  In that
+// case, Data holds all of those bytes, including any header, Type and
+// InstanceNumber are taken from the header if it is present, and the IE encodes
+// as Data unchanged.
 type IE struct {
 	Type           IEType
 	TotalLength    uint16
 	InstanceNumber uint8
 	Data           []byte
+	Unparsed       bool
 }
 
 // DecodeIE consumes bytes from the start of stream to produce a GTPv2 IE.
 // The TotalLength field of the resulting IE provides the count of bytes
 // from stream that are consumed to produce this IE.  Return an error if
-// decoding fails.
+// decoding fails.  The error is a *DecodeError.
 func DecodeIE(stream []byte) (*IE, error) {
+	ie := &IE{}
+	if err := decodeIEInto(stream, ie, false); err != nil {
+		return nil, err
+	}
+
+	return ie, nil
+}
+
+// decodeIEInto is the same as DecodeIE(), but sets the fields of the provided IE.
+// If aliasData is true, the IE Data references stream rather than a copy of it.
+func decodeIEInto(stream []byte, ie *IE, aliasData bool) *DecodeError {
 	if len(stream) < 4 {
-		return nil, fmt.Errorf("insufficient octets in stream for a complete GTPv2 IE")
+		decodeError := &DecodeError{Kind: DecodeErrorTruncated, ExpectedLength: 4, ActualLength: len(stream), Message: "insufficient octets in stream for a complete GTPv2 IE header"}
+		if len(stream) > 0 {
+			decodeError.Path = pathNameOfIEType(IEType(stream[0]))
+		}
+		return decodeError
+	}
+
+	ieType := IEType(stream[0])
+	lengthFieldValue := binary.BigEndian.Uint16(stream[1:3])
+	totalLength := int(lengthFieldValue) + 4
+
+	if len(stream) < totalLength {
+		return &DecodeError{
+			Kind:           DecodeErrorTruncated,
+			Path:           pathNameOfIEType(ieType),
+			ExpectedLength: totalLength,
+			ActualLength:   len(stream),
+			Message:        fmt.Sprintf("next IE length field is (%d), which requires (%d) bytes in stream, but there are only (%d) bytes", lengthFieldValue, totalLength, len(stream)),
+		}
 	}
 
-	ie := &IE{
-		Type:           IEType(stream[0]),
-		InstanceNumber: uint8(stream[3]) & 0x0f,
+	if totalLength > math.MaxUint16 {
+		return &DecodeError{
+			Kind:           DecodeErrorLengthMismatch,
+			Path:           pathNameOfIEType(ieType),
+			ExpectedLength: math.MaxUint16,
+			ActualLength:   totalLength,
+			Message:        fmt.Sprintf("next IE length field is (%d), so its total length (%d) exceeds the largest IE total length (%d)", lengthFieldValue, totalLength, math.MaxUint16),
+		}
 	}
 
-	lengthOfIeData := binary.BigEndian.Uint16(stream[1:3])
+	headerLength := 4
+
+	if ieType == ExtensionType {
+		if lengthFieldValue < 2 {
+			return &DecodeError{
+				Kind:           DecodeErrorInvalidExtendedType,
+				Path:           pathNameOfIEType(ieType),
+				ExpectedLength: 2,
+				ActualLength:   int(lengthFieldValue),
+				Message:        fmt.Sprintf("IE has extended type but length field (%d) is too short for the IE Type Extension field", lengthFieldValue),
+			}
+		}
 
-	ie.TotalLength = lengthOfIeData + 4
+		ieType = IEType(binary.BigEndian.Uint16(stream[4:6]))
+		if ieType < MinimumExtendedIEType {
+			return &DecodeError{
+				Kind:    DecodeErrorInvalidExtendedType,
+				Path:    pathNameOfIEType(ieType),
+				Message: fmt.Sprintf("IE Type Extension value (%d) is not in the extended type range", ieType),
+			}
+		}
 
-	if len(stream) < int(ie.TotalLength) {
-		return nil, fmt.Errorf("next IE length field is (%d), which requires (%d) bytes in stream, but there are only (%d) bytes", lengthOfIeData, ie.TotalLength, len(stream))
+		headerLength = 6
 	}
 
-	ie.Data = make([]byte, lengthOfIeData)
-	copy(ie.Data, stream[4:lengthOfIeData+4])
+	ie.Type = ieType
+	ie.TotalLength = uint16(totalLength)
+	ie.InstanceNumber = uint8(stream[3]) & 0x0f
+	ie.Unparsed = false
 
-	return ie, nil
+	if aliasData {
+		ie.Data = stream[headerLength:totalLength:totalLength]
+	} else {
+		ie.Data = make([]byte, totalLength-headerLength)
+		copy(ie.Data, stream[headerLength:totalLength])
+	}
+
+	return nil
 }
 
 // NewIEWithRawData creates a new GTPv2 IE, providing it with the data as
 // a raw byte array.  The data are not validated for length or value.
-// The instance number is set to 0, but may be changed directly or as the
-// result of encoding order.  The data are not copied, so if you require
-// that, you must manually copy() the data first.  The data must be in
-// network byte order (i.e., big endian order).  This method panics on
-// an error.  Use NewV2IEWithRawDataErrorable() to make the error catchable.
+// The instance number is set to 0, but may be changed directly.  To set
+// it from the message schema instead, use NewIEForRole().  The data are
+// not copied, so if you require that, you must manually copy() the data
+// first.  The data must be in network byte order (i.e., big endian
+// order).  This method panics on an error.  Use
+// NewV2IEWithRawDataErrorable() to make the error catchable.
 func NewIEWithRawData(ieType IEType, data []byte) *IE {
 	ie, err := NewIEWithRawDataErrorable(ieType, data)
 
@@ -302,7 +170,9 @@ func NewIEWithRawData(ieType IEType, data []byte) *IE {
 // NewIEWithRawDataErrorable does the same as NewV2IEWithRawData() but
 // returns an error if it occurs, rather than panicing.
 func NewIEWithRawDataErrorable(ieType IEType, data []byte) (*IE, error) {
-	if len(data) > 65535 {
+	headerLength := headerLengthForIEType(ieType)
+
+	if len(data)+headerLength-4 > 65535 {
 		return nil, fmt.Errorf("data length %d exceeds maximum for an Information Element", len(data))
 	}
 
@@ -310,10 +180,24 @@ func NewIEWithRawDataErrorable(ieType IEType, data []byte) (*IE, error) {
 		Type:           ieType,
 		InstanceNumber: 0,
 		Data:           data,
-		TotalLength:    uint16(len(data) + 4),
+		TotalLength:    uint16(len(data) + headerLength),
 	}, nil
 }
 
+func headerLengthForIEType(ieType IEType) int {
+	if ieType >= MinimumExtendedIEType {
+		return 6
+	}
+
+	return 4
+}
+
+// IsExtendedType returns true if the IE type is encoded using the extended
+// type header format
+func (ie *IE) IsExtendedType() bool {
+	return ie.Type >= MinimumExtendedIEType
+}
+
 // NewGroupedIE is a convenience method to generate a grouped IE (e.g., BearerContext) from
 // IE sub-elements inside the group.  Panics if an error occurs.
 func NewGroupedIE(ieType IEType, groupedIEs []*IE) *IE {
@@ -329,22 +213,51 @@ func NewGroupedIE(ieType IEType, groupedIEs []*IE) *IE {
 // NewGroupedIEErrorable is the same as NewGroupedIE(), but returns an error if
 // one occurs, rather than panicing.
 func NewGroupedIEErrorable(ieType IEType, groupedIEs []*IE) (*IE, error) {
-	var buffer bytes.Buffer
-	subLength := 0
+	dataLength, err := encodedLengthOfGroupedIEs(groupedIEs)
+	if err != nil {
+		return nil, err
+	}
 
+	data := make([]byte, 0, dataLength)
 	for _, ie := range groupedIEs {
-		subLength += int(ie.TotalLength)
+		data = ie.AppendEncode(data)
+	}
 
-		if subLength > 65535 {
-			return nil, fmt.Errorf("data length of Information Element exceeds maximum allowed (65535)")
-		}
+	return NewIEWithRawDataErrorable(ieType, data)
+}
+
+func encodedLengthOfGroupedIEs(groupedIEs []*IE) (int, error) {
+	dataLength := 0
 
-		if _, err := buffer.Write(ie.Encode()); err != nil {
-			return nil, fmt.Errorf("binary encoding error: %s", err.Error())
+	for _, ie := range groupedIEs {
+		dataLength += encodedLengthOfIE(ie)
+
+		if dataLength > 65535 {
+			return 0, fmt.Errorf("data length of Information Element exceeds maximum allowed (65535)")
 		}
 	}
 
-	return NewIEWithRawDataErrorable(ieType, buffer.Bytes())
+	return dataLength, nil
+}
+
+// AppendEncodeGroupedIE appends to dst the encoding of a grouped IE of the
+// provided type and instance number that contains groupedIEs, and returns the
+// extended slice.  The result is the same as appending the encoding of the IE
+// returned by NewGroupedIEErrorable(), but the grouped IEs are encoded directly
+// into dst, rather than into the Data of an intermediate IE.  Returns an error,
+// and dst unchanged, if the grouped IEs are too long for an IE.
+func AppendEncodeGroupedIE(dst []byte, ieType IEType, instanceNumber uint8, groupedIEs []*IE) ([]byte, error) {
+	dataLength, err := encodedLengthOfGroupedIEs(groupedIEs)
+	if err != nil {
+		return dst, err
+	}
+
+	encoded := appendIEHeader(dst, ieType, instanceNumber, dataLength)
+	for _, ie := range groupedIEs {
+		encoded = ie.AppendEncode(encoded)
+	}
+
+	return encoded, nil
 }
 
 // Encode encodes the Information Element as a series of
@@ -354,26 +267,85 @@ func NewGroupedIEErrorable(ieType IEType, groupedIEs []*IE) (*IE, error) {
 // The IE TotalLength field is ignored for encoding and the actual
 // length is recalculated.
 func (ie *IE) Encode() []byte {
-	encodedBytes := make([]byte, len(ie.Data)+4)
+	return ie.AppendEncode(make([]byte, 0, encodedLengthOfIE(ie)))
+}
+
+// AppendEncode is the same as Encode(), but appends the encoded IE to dst and
+// returns the extended slice.  If dst has sufficient capacity, nothing is
+// allocated, so dst may be a buffer that is reused (e.g., from a pool).
+func (ie *IE) AppendEncode(dst []byte) []byte {
+	if ie.Unparsed {
+		return append(dst, ie.Data...)
+	}
+
+	return append(appendIEHeader(dst, ie.Type, ie.InstanceNumber, len(ie.Data)), ie.Data...)
+}
 
-	encodedBytes[0] = byte(ie.Type)
-	binary.BigEndian.PutUint16(encodedBytes[1:3], uint16(len(ie.Data)))
-	encodedBytes[3] = ie.InstanceNumber & 0x0f
-	copy(encodedBytes[4:], ie.Data)
+// WriteTo writes the encoded IE (see Encode()) to w, using a single call to
+// w.Write().  The IE is encoded into a pooled buffer, so nothing is allocated
+// for the encoding.  It satisfies io.WriterTo.
+func (ie *IE) WriteTo(w io.Writer) (int64, error) {
+	bufferPointer := encodeBufferPool.Get().(*[]byte)
+	encoded := ie.AppendEncode((*bufferPointer)[:0])
 
-	return encodedBytes
+	bytesWritten, err := w.Write(encoded)
+
+	*bufferPointer = encoded[:0]
+	encodeBufferPool.Put(bufferPointer)
+
+	return int64(bytesWritten), err
 }
 
+// encodeBufferPool holds buffers used by the WriteTo() methods for encoding
+var encodeBufferPool = sync.Pool{
+	New: func() interface{} {
+		buffer := make([]byte, 0, 1500)
+		return &buffer
+	},
+}
+
+// appendIEHeader appends to dst the header of an IE with the provided type,
+// instance number and data length
+func appendIEHeader(dst []byte, ieType IEType, instanceNumber uint8, dataLength int) []byte {
+	lengthFieldValue := dataLength
+
+	if ieType >= MinimumExtendedIEType {
+		lengthFieldValue += 2
+		return append(dst, ExtensionType, byte(lengthFieldValue>>8), byte(lengthFieldValue), instanceNumber&0x0f, byte(ieType>>8), byte(ieType))
+	}
+
+	return append(dst, byte(ieType), byte(lengthFieldValue>>8), byte(lengthFieldValue), instanceNumber&0x0f)
+}
+
+// TypedDataErrorable converts the IE into the typed value produced by the
+// decoder registered for the IE type (see RegisterTypedIE()).  Returns an error
+// if there is no registered decoder or the decoder fails.
 func (ie *IE) TypedDataErrorable() (TypedIE, error) {
-	switch ie.Type {
-	case IMSI:
-		return makeTypedIMSI(ie)
-	case FTEID:
-		return makeTypedFTEID(ie)
+	return ie.typedDataUsingDecoder(typedIEDecoderFor(0, false, ie.Type))
+}
 
-	default:
-		return nil, fmt.Errorf("no type conversion for IE")
+// TypedDataForMessageErrorable is the same as TypedDataErrorable(), but a
+// decoder registered for the IE type in the context of the provided message
+// type (see RegisterTypedIEForMessage()) is preferred over the general decoder.
+func (ie *IE) TypedDataForMessageErrorable(messageType MessageType) (TypedIE, error) {
+	return ie.typedDataUsingDecoder(typedIEDecoderFor(messageType, true, ie.Type))
+}
+
+func (ie *IE) typedDataUsingDecoder(decoder TypedIEDecoder) (TypedIE, error) {
+	if decoder == nil {
+		return nil, &DecodeError{Kind: DecodeErrorNoTypedDecoder, Path: pathNameOfIEType(ie.Type), Message: "no type conversion for IE"}
 	}
+
+	typedValue, err := decoder(ie)
+	if err != nil {
+		return nil, typedDecodeError(ie, err)
+	}
+
+	if typedValue == nil {
+		return nil, &DecodeError{Kind: DecodeErrorInvalidIEData, Path: pathNameOfIEType(ie.Type), ActualLength: len(ie.Data), Message: "typed decoder for IE returned no value"}
+	}
+
+	return typedValue, nil
 }
 
 func ipAddressIsIPv4(ip net.IP) bool {
@@ -388,7 +360,7 @@ func ipAddressIsIPv6(ip net.IP) bool {
 type TypedFTEID struct {
 	IPv4Addr      net.IP
 	IPv6Addr      net.IP
-	InterfaceType uint8
+	InterfaceType FTEIDInterfaceType
 	Key           uint32
 }
 
@@ -407,7 +379,11 @@ func (fteid *TypedFTEID) ToIE() *IE {
 // ToIEErrorable is the same as ToIE, but returns an error if one
 // occurs, rather than panicing
 func (fteid *TypedFTEID) ToIEErrorable() (*IE, error) {
-	ieFirstRow := byte(0x2f & fteid.InterfaceType)
+	if fteid.InterfaceType > MaximumFTEIDInterfaceType {
+		return nil, fmt.Errorf("F-TEID interface type (%d) exceeds 6 bits", uint8(fteid.InterfaceType))
+	}
+
+	ieFirstRow := byte(fteid.InterfaceType)
 
 	ieDataLength := 5
 
@@ -460,6 +436,10 @@ func makeTypedFTEID(fromIE *IE) (*TypedFTEID, error) {
 
 	data := fromIE.Data
 
+	if len(data) < 5 {
+		return nil, fmt.Errorf("length of IE data is too short for an F-TEID")
+	}
+
 	requiredDataLength := 5
 	if fteidHasIPv4Address(data[0]) {
 		requiredDataLength += 4
@@ -473,7 +453,7 @@ func makeTypedFTEID(fromIE *IE) (*TypedFTEID, error) {
 	}
 
 	fteid := &TypedFTEID{
-		InterfaceType: data[0] & 0x3f,
+		InterfaceType: FTEIDInterfaceType(data[0] & 0x3f),
 		Key:           binary.BigEndian.Uint32(data[1:5]),
 	}
 
@@ -609,19 +589,188 @@ func makeTypedIMSI(fromIE *IE) (*TypedIMSI, error) {
 	return imsi, nil
 }
 
-func ExtractGroupedIEsFrom(groupedIE *IE) ([]*IE, error) {
-	remainingGroupedData := groupedIE.Data
+// SecondaryRATType is the Secondary RAT Type value carried in a Secondary RAT
+// Usage Data Report IE (TS 29.274 section 8.132)
+type SecondaryRATType uint8
 
-	extractedIEs := make([]*IE, 0, 10)
+// Secondary RAT Type values
+const (
+	SecondaryRATTypeNR                 SecondaryRATType = 0
+	SecondaryRATTypeUnlicensedSpectrum SecondaryRATType = 1
+)
 
-	for len(remainingGroupedData) > 0 {
-		nextIE, err := DecodeIE(remainingGroupedData)
-		if err != nil {
-			return nil, err
-		}
+const secondaryRATUsageDataReportDataSize = 27
+
+// ntpEpochOffset is the number of seconds between the NTP epoch (1900-01-01)
+// and the Unix epoch (1970-01-01)
+const ntpEpochOffset = 2208988800
+
+func ntpSecondsToTime(ntpSeconds uint32) time.Time {
+	return time.Unix(int64(ntpSeconds)-ntpEpochOffset, 0).UTC()
+}
+
+func timeToNTPSeconds(t time.Time) (uint32, error) {
+	ntpSeconds := t.Unix() + ntpEpochOffset
+
+	if ntpSeconds < 0 || ntpSeconds > 0xffffffff {
+		return 0, fmt.Errorf("time (%s) cannot be represented as an NTP timestamp", t)
+	}
+
+	return uint32(ntpSeconds), nil
+}
+
+// TypedSecondaryRATUsageDataReport is a structured version of a Secondary RAT
+// Usage Data Report IE.  IRPGW and IRSGW are the Intended Receiver flags.
+// StartTime and EndTime have one second resolution, since they are encoded as
+// the seconds part of an NTP timestamp.  The volumes are in octets.
+type TypedSecondaryRATUsageDataReport struct {
+	IRPGW          bool
+	IRSGW          bool
+	RATType        SecondaryRATType
+	EBI            uint8
+	StartTime      time.Time
+	EndTime        time.Time
+	DownlinkVolume uint64
+	UplinkVolume   uint64
+}
+
+// ToIE creates an IE from the structured version of a Secondary RAT Usage
+// Data Report, and panics if there is an error
+func (report *TypedSecondaryRATUsageDataReport) ToIE() *IE {
+	ie, err := report.ToIEErrorable()
+
+	if err != nil {
+		panic(err)
+	}
+
+	return ie
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one
+// occurs, rather than panicing
+func (report *TypedSecondaryRATUsageDataReport) ToIEErrorable() (*IE, error) {
+	if report.EBI > 0x0f {
+		return nil, fmt.Errorf("EBI value (%d) exceeds maximum (15)", report.EBI)
+	}
+
+	startTimestamp, err := timeToNTPSeconds(report.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	endTimestamp, err := timeToNTPSeconds(report.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, secondaryRATUsageDataReportDataSize)
+
+	if report.IRPGW {
+		data[0] |= 0x01
+	}
+	if report.IRSGW {
+		data[0] |= 0x02
+	}
+
+	data[1] = byte(report.RATType)
+	data[2] = report.EBI
+	binary.BigEndian.PutUint32(data[3:7], startTimestamp)
+	binary.BigEndian.PutUint32(data[7:11], endTimestamp)
+	binary.BigEndian.PutUint64(data[11:19], report.DownlinkVolume)
+	binary.BigEndian.PutUint64(data[19:27], report.UplinkVolume)
+
+	return NewIEWithRawDataErrorable(SecondaryRATUsageDataReport, data)
+}
+
+func makeTypedSecondaryRATUsageDataReport(fromIE *IE) (*TypedSecondaryRATUsageDataReport, error) {
+	if fromIE.Type != SecondaryRATUsageDataReport {
+		return nil, fmt.Errorf("supplied IE is not of type Secondary RAT Usage Data Report")
+	}
 
-		extractedIEs = append(extractedIEs, nextIE)
-		remainingGroupedData = remainingGroupedData[nextIE.TotalLength:]
+	data := fromIE.Data
+
+	// later releases may append octets, which are ignored here
+	if len(data) < secondaryRATUsageDataReportDataSize {
+		return nil, fmt.Errorf("length of IE data is not correct for Secondary RAT Usage Data Report type")
+	}
+
+	return &TypedSecondaryRATUsageDataReport{
+		IRPGW:          data[0]&0x01 != 0,
+		IRSGW:          data[0]&0x02 != 0,
+		RATType:        SecondaryRATType(data[1]),
+		EBI:            data[2] & 0x0f,
+		StartTime:      ntpSecondsToTime(binary.BigEndian.Uint32(data[3:7])),
+		EndTime:        ntpSecondsToTime(binary.BigEndian.Uint32(data[7:11])),
+		DownlinkVolume: binary.BigEndian.Uint64(data[11:19]),
+		UplinkVolume:   binary.BigEndian.Uint64(data[19:27]),
+	}, nil
+}
+
+// TypedUPFunctionSelectionIndicationFlags is a structured version of a UP
+// Function Selection Indication Flags IE.  DCNR indicates that the UE
+// requests Dual Connectivity with NR.
+type TypedUPFunctionSelectionIndicationFlags struct {
+	DCNR bool
+}
+
+// ToIE creates an IE from the structured version of UP Function Selection
+// Indication Flags, and panics if there is an error
+func (flags *TypedUPFunctionSelectionIndicationFlags) ToIE() *IE {
+	ie, err := flags.ToIEErrorable()
+
+	if err != nil {
+		panic(err)
+	}
+
+	return ie
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one
+// occurs, rather than panicing
+func (flags *TypedUPFunctionSelectionIndicationFlags) ToIEErrorable() (*IE, error) {
+	data := []byte{0x00}
+
+	if flags.DCNR {
+		data[0] |= 0x01
+	}
+
+	return NewIEWithRawDataErrorable(UPFunctionSelectionIndicationFlags, data)
+}
+
+func makeTypedUPFunctionSelectionIndicationFlags(fromIE *IE) (*TypedUPFunctionSelectionIndicationFlags, error) {
+	if fromIE.Type != UPFunctionSelectionIndicationFlags {
+		return nil, fmt.Errorf("supplied IE is not of type UP Function Selection Indication Flags")
+	}
+
+	if len(fromIE.Data) < 1 {
+		return nil, fmt.Errorf("length of IE data is not correct for UP Function Selection Indication Flags type")
+	}
+
+	return &TypedUPFunctionSelectionIndicationFlags{
+		DCNR: fromIE.Data[0]&0x01 != 0,
+	}, nil
+}
+
+// ExtractGroupedIEsFrom decodes the data of a grouped IE into the IEs it contains.
+// Returns a *DecodeError if decoding fails, with an Offset relative to the start of
+// the grouped IE data and a Path relative to the grouped IE.
+func ExtractGroupedIEsFrom(groupedIE *IE) ([]*IE, error) {
+	extractedIEs, err := decodeIEsInto(groupedIE.Data, 0, make([]*IE, 0, 10), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractedIEs, nil
+}
+
+// ExtractGroupedIEsInto is the same as ExtractGroupedIEsFrom(), but reuses ies in
+// the same way that DecodePDUInto() reuses the IEs of the PDU, and returns ies
+// (possibly reallocated) holding the extracted IEs.  As with DecodePDUInto(), the
+// Data of each extracted IE references (aliases) the Data of the grouped IE.
+func ExtractGroupedIEsInto(groupedIE *IE, ies []*IE) ([]*IE, error) {
+	extractedIEs, err := decodeIEsInto(groupedIE.Data, 0, ies[:0], true)
+	if err != nil {
+		return nil, err
 	}
 
 	return extractedIEs, nil
@@ -0,0 +1,596 @@
+package gtpv2
+
+// The IEs in this file are declared as structs for the struct codec (see MarshalIEData()).
+// Their typed decoders are registered with RegisterStructIE().
+
+// TypedCause is a Cause IE (TS 29.274 section 8.4).  PCE, BCE and CS are the PDN Connection
+// IE Error, Bearer Context IE Error and Cause Source flags.  OffendingIE holds the
+// optional type, length and instance of the offending IE.
+type TypedCause struct {
+	Value       uint8
+	_           uint8  `gtp:"bits=5"`
+	PCE         bool   `gtp:"bits=1"`
+	BCE         bool   `gtp:"bits=1"`
+	CS          bool   `gtp:"bits=1"`
+	OffendingIE []byte `gtp:"rest"`
+}
+
+// ToIE creates an IE from the TypedCause, and panics if there is an error
+func (cause *TypedCause) ToIE() *IE {
+	return ieOrPanic(cause.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (cause *TypedCause) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(Cause, cause)
+}
+
+// TypedRecovery is a Recovery (Restart Counter) IE (TS 29.274 section 8.5)
+type TypedRecovery struct {
+	RestartCounter uint8
+}
+
+// ToIE creates an IE from the TypedRecovery, and panics if there is an error
+func (recovery *TypedRecovery) ToIE() *IE {
+	return ieOrPanic(recovery.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (recovery *TypedRecovery) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(RecoveryRestartCounter, recovery)
+}
+
+// TypedAMBR is an Aggregate Maximum Bit Rate IE (TS 29.274 section 8.7).  Values are in kbps.
+type TypedAMBR struct {
+	Uplink   uint32
+	Downlink uint32
+}
+
+// ToIE creates an IE from the TypedAMBR, and panics if there is an error
+func (ambr *TypedAMBR) ToIE() *IE {
+	return ieOrPanic(ambr.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (ambr *TypedAMBR) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(AMBR, ambr)
+}
+
+// TypedEBI is an EPS Bearer ID IE (TS 29.274 section 8.8)
+type TypedEBI struct {
+	_     uint8 `gtp:"bits=4"`
+	Value uint8 `gtp:"bits=4"`
+}
+
+// ToIE creates an IE from the TypedEBI, and panics if there is an error
+func (ebi *TypedEBI) ToIE() *IE {
+	return ieOrPanic(ebi.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (ebi *TypedEBI) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(EBI, ebi)
+}
+
+// TypedMEI is a Mobile Equipment Identity IE (TS 29.274 section 8.10).  Digits is the IMEI
+// or IMEISV.
+type TypedMEI struct {
+	Digits string `gtp:"bcd"`
+}
+
+// ToIE creates an IE from the TypedMEI, and panics if there is an error
+func (mei *TypedMEI) ToIE() *IE {
+	return ieOrPanic(mei.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (mei *TypedMEI) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(MEI, mei)
+}
+
+// TypedMSISDN is an MSISDN IE (TS 29.274 section 8.11)
+type TypedMSISDN struct {
+	Digits string `gtp:"bcd"`
+}
+
+// ToIE creates an IE from the TypedMSISDN, and panics if there is an error
+func (msisdn *TypedMSISDN) ToIE() *IE {
+	return ieOrPanic(msisdn.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (msisdn *TypedMSISDN) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(MSISDN, msisdn)
+}
+
+// TypedBearerQoS is a Bearer Level Quality of Service IE (TS 29.274 section 8.15).  PCI
+// and PVI are the Pre-emption Capability and Vulnerability flags, PL is the
+// Priority Level, and bit rates are in kbps.
+type TypedBearerQoS struct {
+	_                         uint8 `gtp:"bits=1"`
+	PCI                       bool  `gtp:"bits=1"`
+	PL                        uint8 `gtp:"bits=4"`
+	_                         uint8 `gtp:"bits=1"`
+	PVI                       bool  `gtp:"bits=1"`
+	QCI                       uint8
+	MaximumBitRateUplink      uint64 `gtp:"bits=40"`
+	MaximumBitRateDownlink    uint64 `gtp:"bits=40"`
+	GuaranteedBitRateUplink   uint64 `gtp:"bits=40"`
+	GuaranteedBitRateDownlink uint64 `gtp:"bits=40"`
+}
+
+// ToIE creates an IE from the TypedBearerQoS, and panics if there is an error
+func (qos *TypedBearerQoS) ToIE() *IE {
+	return ieOrPanic(qos.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (qos *TypedBearerQoS) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(BearerQoS, qos)
+}
+
+// TypedFlowQoS is a Flow Quality of Service IE (TS 29.274 section 8.16).  Bit rates are in
+// kbps.
+type TypedFlowQoS struct {
+	QCI                       uint8
+	MaximumBitRateUplink      uint64 `gtp:"bits=40"`
+	MaximumBitRateDownlink    uint64 `gtp:"bits=40"`
+	GuaranteedBitRateUplink   uint64 `gtp:"bits=40"`
+	GuaranteedBitRateDownlink uint64 `gtp:"bits=40"`
+}
+
+// ToIE creates an IE from the TypedFlowQoS, and panics if there is an error
+func (qos *TypedFlowQoS) ToIE() *IE {
+	return ieOrPanic(qos.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (qos *TypedFlowQoS) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(FlowQoS, qos)
+}
+
+// TypedRATType is a RAT Type IE (TS 29.274 section 8.17)
+type TypedRATType struct {
+	Value uint8
+}
+
+// ToIE creates an IE from the TypedRATType, and panics if there is an error
+func (ratType *TypedRATType) ToIE() *IE {
+	return ieOrPanic(ratType.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (ratType *TypedRATType) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(RATType, ratType)
+}
+
+// TypedServingNetwork is a Serving Network IE (TS 29.274 section 8.18).  Each field is a single
+// decimal digit, and MNCDigit3 is 0xf for a two digit MNC.
+type TypedServingNetwork struct {
+	MCCDigit2 uint8 `gtp:"bits=4"`
+	MCCDigit1 uint8 `gtp:"bits=4"`
+	MNCDigit3 uint8 `gtp:"bits=4"`
+	MCCDigit3 uint8 `gtp:"bits=4"`
+	MNCDigit2 uint8 `gtp:"bits=4"`
+	MNCDigit1 uint8 `gtp:"bits=4"`
+}
+
+// ToIE creates an IE from the TypedServingNetwork, and panics if there is an error
+func (network *TypedServingNetwork) ToIE() *IE {
+	return ieOrPanic(network.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (network *TypedServingNetwork) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(ServingNetwork, network)
+}
+
+// TypedDelayValue is a Delay Value IE (TS 29.274 section 8.27).  Value is in multiples of
+// 50 milliseconds.
+type TypedDelayValue struct {
+	Value uint8
+}
+
+// ToIE creates an IE from the TypedDelayValue, and panics if there is an error
+func (delay *TypedDelayValue) ToIE() *IE {
+	return ieOrPanic(delay.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (delay *TypedDelayValue) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(DelayValue, delay)
+}
+
+// TypedChargingID is a Charging ID IE (TS 29.274 section 8.29)
+type TypedChargingID struct {
+	Value uint32
+}
+
+// ToIE creates an IE from the TypedChargingID, and panics if there is an error
+func (chargingID *TypedChargingID) ToIE() *IE {
+	return ieOrPanic(chargingID.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (chargingID *TypedChargingID) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(ChargingID, chargingID)
+}
+
+// TypedChargingCharacteristics is a Charging Characteristics IE (TS 29.274 section 8.30)
+type TypedChargingCharacteristics struct {
+	Value uint16
+}
+
+// ToIE creates an IE from the TypedChargingCharacteristics, and panics if there is an error
+func (characteristics *TypedChargingCharacteristics) ToIE() *IE {
+	return ieOrPanic(characteristics.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (characteristics *TypedChargingCharacteristics) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(ChargingCharacteristics, characteristics)
+}
+
+// TypedPDNType is a PDN Type IE (TS 29.274 section 8.34)
+type TypedPDNType struct {
+	_     uint8 `gtp:"bits=5"`
+	Value uint8 `gtp:"bits=3"`
+}
+
+// ToIE creates an IE from the TypedPDNType, and panics if there is an error
+func (pdnType *TypedPDNType) ToIE() *IE {
+	return ieOrPanic(pdnType.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (pdnType *TypedPDNType) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(PDNType, pdnType)
+}
+
+// TypedProcedureTransactionID is a Procedure Transaction ID IE (TS 29.274 section 8.35)
+type TypedProcedureTransactionID struct {
+	Value uint8
+}
+
+// ToIE creates an IE from the TypedProcedureTransactionID, and panics if there is an error
+func (pti *TypedProcedureTransactionID) ToIE() *IE {
+	return ieOrPanic(pti.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (pti *TypedProcedureTransactionID) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(ProcedureTransactionID, pti)
+}
+
+// TypedHopCounter is a Hop Counter IE (TS 29.274 section 8.41)
+type TypedHopCounter struct {
+	Value uint8
+}
+
+// ToIE creates an IE from the TypedHopCounter, and panics if there is an error
+func (counter *TypedHopCounter) ToIE() *IE {
+	return ieOrPanic(counter.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (counter *TypedHopCounter) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(HopCounter, counter)
+}
+
+// TypedUETimeZone is a UE Time Zone IE (TS 29.274 section 8.44).  TimeZone is encoded as in
+// TS 24.008 and DaylightSavingTime is the adjustment in hours.
+type TypedUETimeZone struct {
+	TimeZone           uint8
+	_                  uint8 `gtp:"bits=6"`
+	DaylightSavingTime uint8 `gtp:"bits=2"`
+}
+
+// ToIE creates an IE from the TypedUETimeZone, and panics if there is an error
+func (timeZone *TypedUETimeZone) ToIE() *IE {
+	return ieOrPanic(timeZone.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (timeZone *TypedUETimeZone) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(UETimeZone, timeZone)
+}
+
+// TypedPortNumber is a Port Number IE (TS 29.274 section 8.51)
+type TypedPortNumber struct {
+	Value uint16
+}
+
+// ToIE creates an IE from the TypedPortNumber, and panics if there is an error
+func (port *TypedPortNumber) ToIE() *IE {
+	return ieOrPanic(port.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (port *TypedPortNumber) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(PortNumber, port)
+}
+
+// TypedAPNRestriction is an APN Restriction IE (TS 29.274 section 8.57)
+type TypedAPNRestriction struct {
+	Value uint8
+}
+
+// ToIE creates an IE from the TypedAPNRestriction, and panics if there is an error
+func (restriction *TypedAPNRestriction) ToIE() *IE {
+	return ieOrPanic(restriction.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (restriction *TypedAPNRestriction) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(APNRestriction, restriction)
+}
+
+// TypedSelectionMode is a Selection Mode IE (TS 29.274 section 8.58)
+type TypedSelectionMode struct {
+	_     uint8 `gtp:"bits=6"`
+	Value uint8 `gtp:"bits=2"`
+}
+
+// ToIE creates an IE from the TypedSelectionMode, and panics if there is an error
+func (mode *TypedSelectionMode) ToIE() *IE {
+	return ieOrPanic(mode.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (mode *TypedSelectionMode) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(SelectionMode, mode)
+}
+
+// TypedCSGID is a CSG ID IE (TS 29.274 section 8.62)
+type TypedCSGID struct {
+	_     uint8  `gtp:"bits=5"`
+	Value uint32 `gtp:"bits=27"`
+}
+
+// ToIE creates an IE from the TypedCSGID, and panics if there is an error
+func (csgID *TypedCSGID) ToIE() *IE {
+	return ieOrPanic(csgID.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (csgID *TypedCSGID) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(CSGID, csgID)
+}
+
+// TypedNodeType is a Node Type IE (TS 29.274 section 8.65)
+type TypedNodeType struct {
+	Value uint8
+}
+
+// ToIE creates an IE from the TypedNodeType, and panics if there is an error
+func (nodeType *TypedNodeType) ToIE() *IE {
+	return ieOrPanic(nodeType.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (nodeType *TypedNodeType) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(NodeType, nodeType)
+}
+
+// TypedRFSPIndex is an RFSP Index IE (TS 29.274 section 8.77)
+type TypedRFSPIndex struct {
+	Value uint16
+}
+
+// ToIE creates an IE from the TypedRFSPIndex, and panics if there is an error
+func (index *TypedRFSPIndex) ToIE() *IE {
+	return ieOrPanic(index.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (index *TypedRFSPIndex) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(RFSPIndex, index)
+}
+
+// TypedDetachType is a Detach Type IE (TS 29.274 section 8.83)
+type TypedDetachType struct {
+	Value uint8
+}
+
+// ToIE creates an IE from the TypedDetachType, and panics if there is an error
+func (detachType *TypedDetachType) ToIE() *IE {
+	return ieOrPanic(detachType.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (detachType *TypedDetachType) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(DetachType, detachType)
+}
+
+// TypedThrottling is a Throttling IE (TS 29.274 section 8.84).  Factor is a percentage.
+type TypedThrottling struct {
+	DelayUnit  uint8 `gtp:"bits=3"`
+	DelayValue uint8 `gtp:"bits=5"`
+	Factor     uint8
+}
+
+// ToIE creates an IE from the TypedThrottling, and panics if there is an error
+func (throttling *TypedThrottling) ToIE() *IE {
+	return ieOrPanic(throttling.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (throttling *TypedThrottling) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(Throttling, throttling)
+}
+
+// TypedARP is an Allocation/Retention Priority IE (TS 29.274 section 8.86)
+type TypedARP struct {
+	_   uint8 `gtp:"bits=1"`
+	PCI bool  `gtp:"bits=1"`
+	PL  uint8 `gtp:"bits=4"`
+	_   uint8 `gtp:"bits=1"`
+	PVI bool  `gtp:"bits=1"`
+}
+
+// ToIE creates an IE from the TypedARP, and panics if there is an error
+func (arp *TypedARP) ToIE() *IE {
+	return ieOrPanic(arp.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (arp *TypedARP) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(ARP, arp)
+}
+
+// TypedEPCTimer is an EPC Timer IE (TS 29.274 section 8.87)
+type TypedEPCTimer struct {
+	Unit  uint8 `gtp:"bits=3"`
+	Value uint8 `gtp:"bits=5"`
+}
+
+// ToIE creates an IE from the TypedEPCTimer, and panics if there is an error
+func (timer *TypedEPCTimer) ToIE() *IE {
+	return ieOrPanic(timer.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (timer *TypedEPCTimer) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(EPCTimer, timer)
+}
+
+// TypedMetric is a Metric IE (TS 29.274 section 8.114)
+type TypedMetric struct {
+	Value uint8
+}
+
+// ToIE creates an IE from the TypedMetric, and panics if there is an error
+func (metric *TypedMetric) ToIE() *IE {
+	return ieOrPanic(metric.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (metric *TypedMetric) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(Metric, metric)
+}
+
+// TypedSequenceNumber is a Sequence Number IE (TS 29.274 section 8.115)
+type TypedSequenceNumber struct {
+	Value uint32
+}
+
+// ToIE creates an IE from the TypedSequenceNumber, and panics if there is an error
+func (sequenceNumber *TypedSequenceNumber) ToIE() *IE {
+	return ieOrPanic(sequenceNumber.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (sequenceNumber *TypedSequenceNumber) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(SequenceNumber, sequenceNumber)
+}
+
+// TypedServingPLMNRateControl is a Serving PLMN Rate Control IE (TS 29.274 section 8.128)
+type TypedServingPLMNRateControl struct {
+	UplinkRateLimit   uint16
+	DownlinkRateLimit uint16
+}
+
+// ToIE creates an IE from the TypedServingPLMNRateControl, and panics if there is an error
+func (rateControl *TypedServingPLMNRateControl) ToIE() *IE {
+	return ieOrPanic(rateControl.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (rateControl *TypedServingPLMNRateControl) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(ServingPLMNRateControl, rateControl)
+}
+
+// TypedCounter is a Counter IE (TS 29.274 section 8.129)
+type TypedCounter struct {
+	Timestamp uint32
+	Value     uint8
+}
+
+// ToIE creates an IE from the TypedCounter, and panics if there is an error
+func (counter *TypedCounter) ToIE() *IE {
+	return ieOrPanic(counter.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (counter *TypedCounter) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(Counter, counter)
+}
+
+// TypedMappedUEUsageType is a Mapped UE Usage Type IE (TS 29.274 section 8.131)
+type TypedMappedUEUsageType struct {
+	Value uint16
+}
+
+// ToIE creates an IE from the TypedMappedUEUsageType, and panics if there is an error
+func (usageType *TypedMappedUEUsageType) ToIE() *IE {
+	return ieOrPanic(usageType.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (usageType *TypedMappedUEUsageType) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(MappedUEUsageType, usageType)
+}
+
+// TypedMaximumPacketLossRate is a Maximum Packet Loss Rate IE (TS 29.274 section 8.134).  Each rate is
+// present only if its flag is set.
+type TypedMaximumPacketLossRate struct {
+	_            uint8  `gtp:"bits=6"`
+	DL           bool   `gtp:"bits=1"`
+	UL           bool   `gtp:"bits=1"`
+	UplinkRate   uint16 `gtp:"if=UL"`
+	DownlinkRate uint16 `gtp:"if=DL"`
+}
+
+// ToIE creates an IE from the TypedMaximumPacketLossRate, and panics if there is an error
+func (lossRate *TypedMaximumPacketLossRate) ToIE() *IE {
+	return ieOrPanic(lossRate.ToIEErrorable())
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one occurs
+func (lossRate *TypedMaximumPacketLossRate) ToIEErrorable() (*IE, error) {
+	return NewIEFromStruct(MaximumPacketLossRate, lossRate)
+}
+
+var builtInStructIEs = map[IEType]TypedIE{
+	Cause:                   &TypedCause{},
+	RecoveryRestartCounter:  &TypedRecovery{},
+	AMBR:                    &TypedAMBR{},
+	EBI:                     &TypedEBI{},
+	MEI:                     &TypedMEI{},
+	MSISDN:                  &TypedMSISDN{},
+	BearerQoS:               &TypedBearerQoS{},
+	FlowQoS:                 &TypedFlowQoS{},
+	RATType:                 &TypedRATType{},
+	ServingNetwork:          &TypedServingNetwork{},
+	DelayValue:              &TypedDelayValue{},
+	ChargingID:              &TypedChargingID{},
+	ChargingCharacteristics: &TypedChargingCharacteristics{},
+	PDNType:                 &TypedPDNType{},
+	ProcedureTransactionID:  &TypedProcedureTransactionID{},
+	HopCounter:              &TypedHopCounter{},
+	UETimeZone:              &TypedUETimeZone{},
+	PortNumber:              &TypedPortNumber{},
+	APNRestriction:          &TypedAPNRestriction{},
+	SelectionMode:           &TypedSelectionMode{},
+	CSGID:                   &TypedCSGID{},
+	NodeType:                &TypedNodeType{},
+	RFSPIndex:               &TypedRFSPIndex{},
+	DetachType:              &TypedDetachType{},
+	Throttling:              &TypedThrottling{},
+	ARP:                     &TypedARP{},
+	EPCTimer:                &TypedEPCTimer{},
+	Metric:                  &TypedMetric{},
+	SequenceNumber:          &TypedSequenceNumber{},
+	ServingPLMNRateControl:  &TypedServingPLMNRateControl{},
+	Counter:                 &TypedCounter{},
+	MappedUEUsageType:       &TypedMappedUEUsageType{},
+	MaximumPacketLossRate:   &TypedMaximumPacketLossRate{},
+}
+
+func init() {
+	for ieType, prototype := range builtInStructIEs {
+		if err := RegisterStructIE(ieType, prototype); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -1,9 +1,11 @@
 package gtpv2
 
 import (
+	"bytes"
 	"fmt"
 	"net"
 	"testing"
+	"time"
 )
 
 type v2IEComparable struct {
@@ -29,8 +31,14 @@ func TestIENames(t *testing.T) {
 		{"Cause", 2},
 		{"TMSI", 88},
 		{"P-TMSI", 111},
+		{"STN-SR", 51},
 		{"Throttling", 154},
 		{"UP Function Selection Indication Flags", 202},
+		{"Alternative IMSI", 219},
+		{"Reserved", 220},
+		{"IE Extension", 254},
+		{"Private Extension", 255},
+		{"Reserved", 300},
 	}
 
 	for _, testCase := range testCases {
@@ -58,6 +66,26 @@ func TestV2IEDecodeInvalidCases(t *testing.T) {
 			name:        "Insufficient byte stream length",
 			inputStream: []byte{0x01, 0x00, 0x06, 0x00, 0x12, 0x34, 0x56, 0x78},
 		},
+		{
+			name:        "Length field 0xfffc",
+			inputStream: []byte{0x01, 0xff, 0xfc, 0x00, 0x01, 0x02},
+		},
+		{
+			name:        "Length field 0xfffd",
+			inputStream: []byte{0x01, 0xff, 0xfd, 0x00, 0x01, 0x02},
+		},
+		{
+			name:        "Length field 0xfffe",
+			inputStream: []byte{0x01, 0xff, 0xfe, 0x00},
+		},
+		{
+			name:        "Length field 0xffff",
+			inputStream: []byte{0x01, 0xff, 0xff, 0x00, 0x01, 0x02, 0x03},
+		},
+		{
+			name:        "Length field 0xffff with complete data",
+			inputStream: append([]byte{0x01, 0xff, 0xff, 0x00}, make([]byte, 0xffff)...),
+		},
 	}
 
 	for _, testCase := range cases {
@@ -137,6 +165,54 @@ func TestIEEncodeValidCases(t *testing.T) {
 	}
 }
 
+func TestExtendedTypeIE(t *testing.T) {
+	encodedIE := []byte{0xfe, 0x00, 0x05, 0x02, 0x01, 0x2c, 0xaa, 0xbb, 0xcc}
+
+	ie, err := DecodeIE(encodedIE)
+	if err != nil {
+		t.Fatalf("[TestExtendedTypeIE] expected no error on DecodeIE, got error = (%s)", err.Error())
+	}
+
+	if err := compareTwoIEObjects(&IE{Type: 300, TotalLength: 9, InstanceNumber: 2, Data: []byte{0xaa, 0xbb, 0xcc}}, ie); err != nil {
+		t.Errorf("[TestExtendedTypeIE] on DecodeIE: %s", err.Error())
+	}
+
+	if !ie.IsExtendedType() {
+		t.Errorf("[TestExtendedTypeIE] expected IsExtendedType() to be true, but it is false")
+	}
+
+	if err := compareByteArrays(encodedIE, ie.Encode()); err != nil {
+		t.Errorf("[TestExtendedTypeIE] on Encode: %s", err.Error())
+	}
+
+	newIE := NewIEWithRawData(300, []byte{0xaa, 0xbb, 0xcc})
+	if newIE.TotalLength != 9 {
+		t.Errorf("[TestExtendedTypeIE] expected TotalLength from NewIEWithRawData = (9), got = (%d)", newIE.TotalLength)
+	}
+
+	pdu := NewPDU(EchoRequest, 1, []*IE{newIE})
+	if pdu.TotalLength != 17 {
+		t.Errorf("[TestExtendedTypeIE] expected TotalLength of PDU with extended type IE = (17), got = (%d)", pdu.TotalLength)
+	}
+
+	invalidCases := []v2IEFailCase{
+		{
+			name:        "Length too short for type extension",
+			inputStream: []byte{0xfe, 0x00, 0x01, 0x00, 0x01},
+		},
+		{
+			name:        "Type extension below extended range",
+			inputStream: []byte{0xfe, 0x00, 0x02, 0x00, 0x00, 0x57},
+		},
+	}
+
+	for _, testCase := range invalidCases {
+		if _, err := DecodeIE(testCase.inputStream); err == nil {
+			t.Errorf("[TestExtendedTypeIE] (%s) expected error on DecodeIE, but received none", testCase.name)
+		}
+	}
+}
+
 type TypedFTEIDComparable struct {
 	fteid             *TypedFTEID
 	expectedDataBytes []byte
@@ -253,6 +329,91 @@ func TestTypedIMSI(t *testing.T) {
 	}
 }
 
+func TestTypedSecondaryRATUsageDataReport(t *testing.T) {
+	report := &TypedSecondaryRATUsageDataReport{
+		IRPGW:          true,
+		IRSGW:          false,
+		RATType:        SecondaryRATTypeNR,
+		EBI:            5,
+		StartTime:      time.Date(2020, time.March, 1, 10, 0, 0, 0, time.UTC),
+		EndTime:        time.Date(2020, time.March, 1, 10, 5, 0, 0, time.UTC),
+		DownlinkVolume: 0x0000000123456789,
+		UplinkVolume:   0x00000000000abcde,
+	}
+
+	expectedDataBytes := []byte{
+		0x01, 0x00, 0x05,
+		0xe2, 0x06, 0x06, 0x20,
+		0xe2, 0x06, 0x07, 0x4c,
+		0x00, 0x00, 0x00, 0x01, 0x23, 0x45, 0x67, 0x89,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0xbc, 0xde,
+	}
+
+	ie, err := report.ToIEErrorable()
+	if err != nil {
+		t.Fatalf("[TestTypedSecondaryRATUsageDataReport] expected no error on ToIEErrorable, got error = (%s)", err.Error())
+	}
+
+	if err := compareByteArrays(expectedDataBytes, ie.Data); err != nil {
+		t.Errorf("[TestTypedSecondaryRATUsageDataReport] data in IE from ToIEErrorable does not match expected: %s", err.Error())
+	}
+
+	typedIE, err := ie.TypedDataErrorable()
+	if err != nil {
+		t.Fatalf("[TestTypedSecondaryRATUsageDataReport] expected no error on TypedData, got error = (%s)", err.Error())
+	}
+
+	decodedReport := typedIE.(*TypedSecondaryRATUsageDataReport)
+
+	if decodedReport.IRPGW != report.IRPGW || decodedReport.IRSGW != report.IRSGW {
+		t.Errorf("[TestTypedSecondaryRATUsageDataReport] expected IRPGW = (%t), IRSGW = (%t), got IRPGW = (%t), IRSGW = (%t)", report.IRPGW, report.IRSGW, decodedReport.IRPGW, decodedReport.IRSGW)
+	}
+
+	if decodedReport.RATType != report.RATType {
+		t.Errorf("[TestTypedSecondaryRATUsageDataReport] expected RATType = (%d), got = (%d)", report.RATType, decodedReport.RATType)
+	}
+
+	if decodedReport.EBI != report.EBI {
+		t.Errorf("[TestTypedSecondaryRATUsageDataReport] expected EBI = (%d), got = (%d)", report.EBI, decodedReport.EBI)
+	}
+
+	if !decodedReport.StartTime.Equal(report.StartTime) {
+		t.Errorf("[TestTypedSecondaryRATUsageDataReport] expected StartTime = (%s), got = (%s)", report.StartTime, decodedReport.StartTime)
+	}
+
+	if !decodedReport.EndTime.Equal(report.EndTime) {
+		t.Errorf("[TestTypedSecondaryRATUsageDataReport] expected EndTime = (%s), got = (%s)", report.EndTime, decodedReport.EndTime)
+	}
+
+	if decodedReport.DownlinkVolume != report.DownlinkVolume {
+		t.Errorf("[TestTypedSecondaryRATUsageDataReport] expected DownlinkVolume = (%d), got = (%d)", report.DownlinkVolume, decodedReport.DownlinkVolume)
+	}
+
+	if decodedReport.UplinkVolume != report.UplinkVolume {
+		t.Errorf("[TestTypedSecondaryRATUsageDataReport] expected UplinkVolume = (%d), got = (%d)", report.UplinkVolume, decodedReport.UplinkVolume)
+	}
+
+	if _, err := NewIEWithRawData(SecondaryRATUsageDataReport, expectedDataBytes[:20]).TypedDataErrorable(); err == nil {
+		t.Errorf("[TestTypedSecondaryRATUsageDataReport] expected error on TypedData for truncated IE, but got none")
+	}
+}
+
+func TestTypedUPFunctionSelectionIndicationFlags(t *testing.T) {
+	for _, dcnr := range []bool{true, false} {
+		ie := (&TypedUPFunctionSelectionIndicationFlags{DCNR: dcnr}).ToIE()
+
+		typedIE, err := ie.TypedDataErrorable()
+		if err != nil {
+			t.Errorf("[TestTypedUPFunctionSelectionIndicationFlags] for DCNR = (%t), expected no error on TypedData, got error = (%s)", dcnr, err.Error())
+			continue
+		}
+
+		if got := typedIE.(*TypedUPFunctionSelectionIndicationFlags).DCNR; got != dcnr {
+			t.Errorf("[TestTypedUPFunctionSelectionIndicationFlags] expected DCNR = (%t), got = (%t)", dcnr, got)
+		}
+	}
+}
+
 func TestGroupIECreation(t *testing.T) {
 	ie, err := NewGroupedIEErrorable(BearerContext, []*IE{
 		NewIEWithRawData(EBI, []byte{0x01}),
@@ -290,6 +451,61 @@ func TestGroupIECreation(t *testing.T) {
 	}
 }
 
+func TestIEAppendEncode(t *testing.T) {
+	prefix := []byte{0xde, 0xad}
+
+	for _, ie := range []*IE{
+		NewIEWithRawData(RATType, []byte{0x06}),
+		{Type: EBI, InstanceNumber: 2, Data: []byte{0x05}},
+		NewIEWithRawData(IEType(300), []byte{0x01, 0x02, 0x03}),
+		NewIEWithRawData(RecoveryRestartCounter, []byte{}),
+		{Type: Cause, Data: []byte{0x02, 0x00, 0x02}, Unparsed: true},
+	} {
+		expected := append(append([]byte(nil), prefix...), ie.Encode()...)
+
+		if err := compareByteArrays(expected, ie.AppendEncode(append([]byte(nil), prefix...))); err != nil {
+			t.Errorf("[TestIEAppendEncode] on AppendEncode() for (%s): %s", NameOfIEForType(ie.Type), err)
+		}
+
+		var buffer bytes.Buffer
+		bytesWritten, err := ie.WriteTo(&buffer)
+		if err != nil {
+			t.Errorf("[TestIEAppendEncode] on WriteTo() for (%s) expected no error, got = (%s)", NameOfIEForType(ie.Type), err)
+		} else if bytesWritten != int64(len(expected)-len(prefix)) {
+			t.Errorf("[TestIEAppendEncode] on WriteTo() for (%s) expected (%d) bytes written, got (%d)", NameOfIEForType(ie.Type), len(expected)-len(prefix), bytesWritten)
+		} else if err := compareByteArrays(expected[len(prefix):], buffer.Bytes()); err != nil {
+			t.Errorf("[TestIEAppendEncode] on WriteTo() for (%s): %s", NameOfIEForType(ie.Type), err)
+		}
+	}
+}
+
+func TestAppendEncodeGroupedIE(t *testing.T) {
+	groupedIEs := []*IE{
+		NewIEWithRawData(EBI, []byte{0x01}),
+		(&TypedFTEID{IPv4Addr: net.IPv4(10, 11, 12, 13), InterfaceType: 1, Key: 0xaabbccdd}).ToIE(),
+		NewIEWithRawData(IEType(300), []byte{0x01}),
+	}
+
+	groupedIE := NewGroupedIE(BearerContext, groupedIEs)
+	groupedIE.InstanceNumber = 1
+
+	encoded, err := AppendEncodeGroupedIE([]byte{0xff}, BearerContext, 1, groupedIEs)
+	if err != nil {
+		t.Fatalf("[TestAppendEncodeGroupedIE] expected no error, got = (%s)", err)
+	}
+
+	if err := compareByteArrays(append([]byte{0xff}, groupedIE.Encode()...), encoded); err != nil {
+		t.Errorf("[TestAppendEncodeGroupedIE] %s", err)
+	}
+
+	tooLong := []*IE{NewIEWithRawData(ProtocolConfigurationOptions, make([]byte, 40000)), NewIEWithRawData(ProtocolConfigurationOptions, make([]byte, 40000))}
+	if encoded, err := AppendEncodeGroupedIE([]byte{0xff}, BearerContext, 0, tooLong); err == nil {
+		t.Errorf("[TestAppendEncodeGroupedIE] on grouped IEs exceeding maximum length expected error, got none")
+	} else if len(encoded) != 1 {
+		t.Errorf("[TestAppendEncodeGroupedIE] on error expected dst unchanged, got length (%d)", len(encoded))
+	}
+}
+
 func compareByteArrays(expected []byte, got []byte) error {
 	if len(expected) != len(got) {
 		return fmt.Errorf("Byte array lengths differ; expected %d bytes, got = %d", len(expected), len(got))
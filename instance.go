@@ -0,0 +1,172 @@
+package gtpv2
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IESchemaForRole returns the schema of the IE with the provided role in a message
+// of the provided type.  rolePath is a role name (e.g., "SenderFTEIDForControlPlane")
+// or, for an IE inside a grouped IE, the roles separated by '/' (e.g.,
+// "BearerContextsToBeCreated/S1UeNodeBFTEID").  Returns an error if there is no
+// schema for the message type or no IE with that role.
+func IESchemaForRole(messageType MessageType, rolePath string) (*IESchema, error) {
+	schemaPath, err := ieSchemaPathForRole(messageType, rolePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return schemaPath[len(schemaPath)-1], nil
+}
+
+// ieSchemaPathForRole is the same as IESchemaForRole(), but returns the schema for
+// each role in the role path, ending with the schema for the last role
+func ieSchemaPathForRole(messageType MessageType, rolePath string) ([]*IESchema, error) {
+	messageSchema, isKnown := SchemaForMessageType(messageType)
+	if !isKnown {
+		return nil, fmt.Errorf("no schema for message type %s", NameOfMessageForType(messageType))
+	}
+
+	levelSchemas := messageSchema.IEs
+	schemaPath := make([]*IESchema, 0, 2)
+
+	for _, role := range strings.Split(rolePath, "/") {
+		if len(schemaPath) > 0 {
+			parentSchema := schemaPath[len(schemaPath)-1]
+			if parentSchema.GroupedIEs == nil {
+				return nil, fmt.Errorf("role (%s) in %s is not a grouped IE, so role path (%s) is invalid", parentSchema.Role, NameOfMessageForType(messageType), rolePath)
+			}
+			levelSchemas = parentSchema.GroupedIEs
+		}
+
+		var roleSchema *IESchema
+		for _, schema := range levelSchemas {
+			if schema.Role == role {
+				roleSchema = schema
+				break
+			}
+		}
+
+		if roleSchema == nil {
+			return nil, fmt.Errorf("no role (%s) in role path (%s) for %s", role, rolePath, NameOfMessageForType(messageType))
+		}
+
+		schemaPath = append(schemaPath, roleSchema)
+	}
+
+	return schemaPath, nil
+}
+
+// NewIEForRole creates an IE with the provided data, using the type and instance
+// number of the IE with the provided role in a message of the provided type (see
+// IESchemaForRole()).  For example, the role "PGWS5S8AddressForControlPlane" in a
+// Create Session Request produces an F-TEID with instance 1.  The data are not
+// copied.
+func NewIEForRole(messageType MessageType, rolePath string, data []byte) (*IE, error) {
+	schema, err := IESchemaForRole(messageType, rolePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIEForSchema(schema, data)
+}
+
+// NewTypedIEForRole is the same as NewIEForRole(), but the IE is produced from a
+// typed value.  Returns an error if the typed value produces an IE of a type other
+// than the one for the role.
+func NewTypedIEForRole(messageType MessageType, rolePath string, typedValue TypedIE) (*IE, error) {
+	schema, err := IESchemaForRole(messageType, rolePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTypedIEForSchema(schema, rolePath, typedValue)
+}
+
+// NewGroupedIEForRole is the same as NewIEForRole(), but the IE is a grouped IE
+// containing the provided IEs
+func NewGroupedIEForRole(messageType MessageType, rolePath string, groupedIEs []*IE) (*IE, error) {
+	schema, err := IESchemaForRole(messageType, rolePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGroupedIEForSchema(schema, messageType, rolePath, groupedIEs)
+}
+
+func newIEForSchema(schema *IESchema, data []byte) (*IE, error) {
+	ie, err := NewIEWithRawDataErrorable(schema.Type, data)
+	if err != nil {
+		return nil, err
+	}
+
+	ie.InstanceNumber = schema.Instance
+
+	return ie, nil
+}
+
+func newTypedIEForSchema(schema *IESchema, rolePath string, typedValue TypedIE) (*IE, error) {
+	ie, err := typedValue.ToIEErrorable()
+	if err != nil {
+		return nil, err
+	}
+
+	if ie.Type != schema.Type {
+		return nil, fmt.Errorf("role (%s) requires IE type %s, but typed value produces %s", rolePath, NameOfIEForType(schema.Type), NameOfIEForType(ie.Type))
+	}
+
+	ie.InstanceNumber = schema.Instance
+
+	return ie, nil
+}
+
+func newGroupedIEForSchema(schema *IESchema, messageType MessageType, rolePath string, groupedIEs []*IE) (*IE, error) {
+	if schema.GroupedIEs == nil {
+		return nil, fmt.Errorf("role (%s) in %s is not a grouped IE", rolePath, NameOfMessageForType(messageType))
+	}
+
+	ie, err := NewGroupedIEErrorable(schema.Type, groupedIEs)
+	if err != nil {
+		return nil, err
+	}
+
+	ie.InstanceNumber = schema.Instance
+
+	return ie, nil
+}
+
+// EncodeOptions changes the behavior of PDU.EncodeWithOptions()
+type EncodeOptions struct {
+	// StrictHeader causes encoding to fail with a *HeaderError if the PDU header
+	// does not conform to TS 29.274 section 5.5 (see PDU.ValidateHeader())
+	StrictHeader bool
+
+	// RejectAmbiguousInstances causes encoding to fail with a *SchemaViolation if
+	// a receiver could not tell which role an IE has.  That is the case when an IE
+	// has a type in the message schema, but an instance number that the schema does
+	// not have for that type, or when an IE that may appear only once appears more
+	// than once with the same type and instance number.  Grouped IEs are checked in
+	// the same way.  IE types that are not in the schema are not checked, nor are
+	// message types without a schema (see SchemaForMessageType()).
+	RejectAmbiguousInstances bool
+}
+
+// EncodeWithOptions is the same as Encode(), but with behavior changed by the
+// provided options
+func (pdu *PDU) EncodeWithOptions(options EncodeOptions) ([]byte, error) {
+	if options.StrictHeader {
+		if err := pdu.ValidateHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	if options.RejectAmbiguousInstances {
+		for _, violation := range Validate(pdu) {
+			if violation.Kind == ViolationUnexpectedInstance || violation.Kind == ViolationTooManyOccurrences {
+				return nil, violation
+			}
+		}
+	}
+
+	return pdu.Encode(), nil
+}
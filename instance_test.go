@@ -0,0 +1,104 @@
+package gtpv2
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestIEsForRoles(t *testing.T) {
+	fteid := &TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1), InterfaceType: 7, Key: 0x01}
+
+	testCases := []struct {
+		rolePath         string
+		expectedType     IEType
+		expectedInstance uint8
+	}{
+		{"SenderFTEIDForControlPlane", FTEID, 0},
+		{"PGWS5S8AddressForControlPlane", FTEID, 1},
+		{"BearerContextsToBeCreated/S5S8UPGWFTEID", FTEID, 3},
+		{"BearerContextsToBeRemoved/S4USGSNFTEID", FTEID, 1},
+	}
+
+	for _, testCase := range testCases {
+		ie, err := NewTypedIEForRole(CreateSessionRequest, testCase.rolePath, fteid)
+		if err != nil {
+			t.Errorf("[TestIEsForRoles] on NewTypedIEForRole(%s), expected no error, got = (%s)", testCase.rolePath, err)
+			continue
+		}
+
+		if ie.Type != testCase.expectedType || ie.InstanceNumber != testCase.expectedInstance {
+			t.Errorf("[TestIEsForRoles] on NewTypedIEForRole(%s), expected type (%d) and instance (%d), got = (%d), (%d)", testCase.rolePath, testCase.expectedType, testCase.expectedInstance, ie.Type, ie.InstanceNumber)
+		}
+	}
+
+	if ie, err := NewIEForRole(CreateSessionRequest, "BearerContextsToBeCreated/EPSBearerID", []byte{0x05}); err != nil || ie.Type != EBI || ie.InstanceNumber != 0 {
+		t.Errorf("[TestIEsForRoles] on NewIEForRole(BearerContextsToBeCreated/EPSBearerID), expected EBI with instance 0, got = (%v), error = (%v)", ie, err)
+	}
+
+	if ie, err := NewGroupedIEForRole(CreateSessionRequest, "BearerContextsToBeRemoved", nil); err != nil || ie.Type != BearerContext || ie.InstanceNumber != 1 {
+		t.Errorf("[TestIEsForRoles] on NewGroupedIEForRole(BearerContextsToBeRemoved), expected Bearer Context with instance 1, got = (%v), error = (%v)", ie, err)
+	}
+
+	invalidRoles := []struct {
+		messageType MessageType
+		rolePath    string
+	}{
+		{CreateSessionRequest, "NotARole"},
+		{CreateSessionRequest, "RATType/EPSBearerID"},
+		{CreateSessionRequest, "BearerContextsToBeCreated/NotARole"},
+		{MBMSSessionStartRequest, "Cause"},
+	}
+
+	for _, invalidRole := range invalidRoles {
+		if _, err := NewIEForRole(invalidRole.messageType, invalidRole.rolePath, []byte{0x01}); err == nil {
+			t.Errorf("[TestIEsForRoles] on NewIEForRole(%s), expected error, got none", invalidRole.rolePath)
+		}
+	}
+
+	if _, err := NewTypedIEForRole(CreateSessionRequest, "IMSI", fteid); err == nil {
+		t.Errorf("[TestIEsForRoles] on NewTypedIEForRole(IMSI) with F-TEID value, expected error, got none")
+	}
+
+	if _, err := NewGroupedIEForRole(CreateSessionRequest, "RATType", nil); err == nil {
+		t.Errorf("[TestIEsForRoles] on NewGroupedIEForRole(RATType), expected error, got none")
+	}
+}
+
+func TestEncodeWithOptionsRejectsAmbiguousInstances(t *testing.T) {
+	fteidIE := (&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1), InterfaceType: 10, Key: 0x01}).ToIE()
+
+	ambiguousPDU := NewPDU(CreateSessionRequest, 1, []*IE{fteidIE, fteidIE}).SetTEID(0)
+
+	if _, err := ambiguousPDU.EncodeWithOptions(EncodeOptions{}); err != nil {
+		t.Errorf("[TestEncodeWithOptionsRejectsAmbiguousInstances] without options, expected no error, got = (%s)", err)
+	}
+
+	_, err := ambiguousPDU.EncodeWithOptions(EncodeOptions{RejectAmbiguousInstances: true})
+
+	var violation *SchemaViolation
+	if !errors.As(err, &violation) || violation.Kind != ViolationTooManyOccurrences {
+		t.Errorf("[TestEncodeWithOptionsRejectsAmbiguousInstances] with two F-TEIDs at instance 0, expected too many occurrences violation, got = (%v)", err)
+	}
+
+	pgwFTEID, _ := NewIEForRole(CreateSessionRequest, "PGWS5S8AddressForControlPlane", fteidIE.Data)
+	unambiguousPDU := NewPDU(CreateSessionRequest, 1, []*IE{fteidIE, pgwFTEID}).SetTEID(0)
+
+	encoded, err := unambiguousPDU.EncodeWithOptions(EncodeOptions{RejectAmbiguousInstances: true, StrictHeader: true})
+	if err != nil {
+		t.Fatalf("[TestEncodeWithOptionsRejectsAmbiguousInstances] with F-TEIDs at instances 0 and 1, expected no error, got = (%s)", err)
+	}
+
+	if err := compareByteArrays(unambiguousPDU.Encode(), encoded); err != nil {
+		t.Errorf("[TestEncodeWithOptionsRejectsAmbiguousInstances] on EncodeWithOptions(): %s", err)
+	}
+
+	unexpectedInstance := &IE{Type: FTEID, InstanceNumber: 9, Data: fteidIE.Data}
+	if _, err := NewPDU(CreateSessionRequest, 1, []*IE{unexpectedInstance}).SetTEID(0).EncodeWithOptions(EncodeOptions{RejectAmbiguousInstances: true}); err == nil {
+		t.Errorf("[TestEncodeWithOptionsRejectsAmbiguousInstances] with F-TEID at instance 9, expected error, got none")
+	}
+
+	if _, err := NewPDU(CreateSessionRequest, 1, nil).EncodeWithOptions(EncodeOptions{StrictHeader: true}); err == nil {
+		t.Errorf("[TestEncodeWithOptionsRejectsAmbiguousInstances] with strict header and no TEID, expected error, got none")
+	}
+}
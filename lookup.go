@@ -0,0 +1,244 @@
+package gtpv2
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const anyInstance = -1
+
+func ieMatches(ie *IE, ieType IEType, instance int) bool {
+	return ie.Type == ieType && (instance == anyInstance || int(ie.InstanceNumber) == instance)
+}
+
+func nthMatchingIE(ies []*IE, ieType IEType, instance int, n int) *IE {
+	for _, ie := range ies {
+		if ieMatches(ie, ieType, instance) {
+			if n == 0 {
+				return ie
+			}
+			n--
+		}
+	}
+
+	return nil
+}
+
+func allMatchingIEs(ies []*IE, ieType IEType, instance int) []*IE {
+	matchingIEs := make([]*IE, 0, 2)
+
+	for _, ie := range ies {
+		if ieMatches(ie, ieType, instance) {
+			matchingIEs = append(matchingIEs, ie)
+		}
+	}
+
+	return matchingIEs
+}
+
+// FirstIEOfType returns the first IE in the PDU with the provided type, regardless
+// of instance number, or nil if there is none
+func (pdu *PDU) FirstIEOfType(ieType IEType) *IE {
+	return nthMatchingIE(pdu.InformationElements, ieType, anyInstance, 0)
+}
+
+// IEsOfType returns every IE in the PDU with the provided type, in PDU order
+func (pdu *PDU) IEsOfType(ieType IEType) []*IE {
+	return allMatchingIEs(pdu.InformationElements, ieType, anyInstance)
+}
+
+// IEWithInstance returns the first IE in the PDU with the provided type and
+// instance number, or nil if there is none
+func (pdu *PDU) IEWithInstance(ieType IEType, instance uint8) *IE {
+	return nthMatchingIE(pdu.InformationElements, ieType, int(instance), 0)
+}
+
+// IEsWithInstance returns every IE in the PDU with the provided type and instance
+// number, in PDU order
+func (pdu *PDU) IEsWithInstance(ieType IEType, instance uint8) []*IE {
+	return allMatchingIEs(pdu.InformationElements, ieType, int(instance))
+}
+
+// HasIEOfType returns true if the PDU contains at least one IE with the provided type
+func (pdu *PDU) HasIEOfType(ieType IEType) bool {
+	return pdu.FirstIEOfType(ieType) != nil
+}
+
+// HasIEWithInstance returns true if the PDU contains at least one IE with the provided
+// type and instance number
+func (pdu *PDU) HasIEWithInstance(ieType IEType, instance uint8) bool {
+	return pdu.IEWithInstance(ieType, instance) != nil
+}
+
+// LookupIE returns the IE identified by path, which may descend into grouped IEs.
+// See IE.LookupIE() for the path syntax.  Returns an error if no IE matches the path.
+func (pdu *PDU) LookupIE(path string) (*IE, error) {
+	return lookupIEByPath(pdu.InformationElements, path)
+}
+
+// LookupTypedIE is the same as LookupIE(), but returns the typed value of the IE,
+// using the decoders registered for the PDU message type (see
+// IE.TypedDataForMessageErrorable())
+func (pdu *PDU) LookupTypedIE(path string) (TypedIE, error) {
+	ie, err := pdu.LookupIE(path)
+	if err != nil {
+		return nil, err
+	}
+
+	typedValue, err := ie.TypedDataForMessageErrorable(pdu.Type)
+	if err != nil {
+		var decodeError *DecodeError
+		if errors.As(err, &decodeError) {
+			return nil, decodeError.atIE(path, 0)
+		}
+		return nil, err
+	}
+
+	return typedValue, nil
+}
+
+// GroupedIEs decodes the data of a grouped IE (e.g., BearerContext) into the IEs it
+// contains.  This is the same as ExtractGroupedIEsFrom().
+func (ie *IE) GroupedIEs() ([]*IE, error) {
+	return ExtractGroupedIEsFrom(ie)
+}
+
+// FirstGroupedIEOfType returns the first IE inside this grouped IE with the provided
+// type, regardless of instance number.  Returns nil (and no error) if there is none,
+// and an error if the grouped IE data cannot be decoded.
+func (ie *IE) FirstGroupedIEOfType(ieType IEType) (*IE, error) {
+	groupedIEs, err := ExtractGroupedIEsFrom(ie)
+	if err != nil {
+		return nil, err
+	}
+
+	return nthMatchingIE(groupedIEs, ieType, anyInstance, 0), nil
+}
+
+// GroupedIEWithInstance returns the first IE inside this grouped IE with the provided
+// type and instance number.  Returns nil (and no error) if there is none, and an error
+// if the grouped IE data cannot be decoded.
+func (ie *IE) GroupedIEWithInstance(ieType IEType, instance uint8) (*IE, error) {
+	groupedIEs, err := ExtractGroupedIEsFrom(ie)
+	if err != nil {
+		return nil, err
+	}
+
+	return nthMatchingIE(groupedIEs, ieType, int(instance), 0), nil
+}
+
+// LookupIE returns the IE inside this grouped IE identified by path.  A path is a
+// sequence of segments separated by '/', each of which selects an IE from the IEs
+// at that level and descends into it if another segment follows.  A segment has the
+// form Type#Instance[Index], where Type is an IE type name accepted by
+// IETypeForYamlName() (e.g., "FTEID" or "F-TEID") or a decimal type number, the
+// optional #Instance restricts matches to that instance number, and the optional
+// [Index] selects the Nth (from 0) match rather than the first.  For example,
+// "BearerContext[1]/FTEID#2" is the F-TEID with instance 2 in the second Bearer
+// Context.  Returns an error if no IE matches the path, and a *DecodeError if a
+// grouped IE on the path cannot be decoded.
+func (ie *IE) LookupIE(path string) (*IE, error) {
+	groupedIEs, err := ExtractGroupedIEsFrom(ie)
+	if err != nil {
+		return nil, err
+	}
+
+	return lookupIEByPath(groupedIEs, path)
+}
+
+// LookupTypedIE is the same as LookupIE(), but returns the typed value of the IE
+func (ie *IE) LookupTypedIE(path string) (TypedIE, error) {
+	matchingIE, err := ie.LookupIE(path)
+	if err != nil {
+		return nil, err
+	}
+
+	typedValue, err := matchingIE.TypedDataErrorable()
+	if err != nil {
+		var decodeError *DecodeError
+		if errors.As(err, &decodeError) {
+			return nil, decodeError.atIE(path, 0)
+		}
+		return nil, err
+	}
+
+	return typedValue, nil
+}
+
+type ieLookupPathSegment struct {
+	ieType   IEType
+	instance int
+	index    int
+}
+
+func parseIELookupPathSegment(segment string) (*ieLookupPathSegment, error) {
+	parsedSegment := &ieLookupPathSegment{instance: anyInstance}
+	typeName := segment
+
+	if openBracketAt := strings.Index(typeName, "["); openBracketAt >= 0 {
+		if !strings.HasSuffix(typeName, "]") {
+			return nil, fmt.Errorf("path segment (%s) has unterminated index", segment)
+		}
+
+		index, err := strconv.Atoi(typeName[openBracketAt+1 : len(typeName)-1])
+		if err != nil || index < 0 {
+			return nil, fmt.Errorf("path segment (%s) has invalid index", segment)
+		}
+
+		parsedSegment.index = index
+		typeName = typeName[:openBracketAt]
+	}
+
+	if hashAt := strings.Index(typeName, "#"); hashAt >= 0 {
+		instance, err := strconv.Atoi(typeName[hashAt+1:])
+		if err != nil || instance < 0 || instance > 0x0f {
+			return nil, fmt.Errorf("path segment (%s) has invalid instance number", segment)
+		}
+
+		parsedSegment.instance = instance
+		typeName = typeName[:hashAt]
+	}
+
+	if ieType, isKnown := IETypeForYamlName(typeName); isKnown {
+		parsedSegment.ieType = ieType
+	} else if typeNumber, err := strconv.ParseUint(typeName, 10, 16); err == nil {
+		parsedSegment.ieType = IEType(typeNumber)
+	} else {
+		return nil, fmt.Errorf("path segment (%s) has unknown IE type (%s)", segment, typeName)
+	}
+
+	return parsedSegment, nil
+}
+
+func lookupIEByPath(ies []*IE, path string) (*IE, error) {
+	segments := strings.Split(path, "/")
+	levelIEs := ies
+
+	for segmentIndex, segment := range segments {
+		parsedSegment, err := parseIELookupPathSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+
+		matchingIE := nthMatchingIE(levelIEs, parsedSegment.ieType, parsedSegment.instance, parsedSegment.index)
+		if matchingIE == nil {
+			return nil, fmt.Errorf("no IE matches path segment (%s) in path (%s)", segment, path)
+		}
+
+		if segmentIndex == len(segments)-1 {
+			return matchingIE, nil
+		}
+
+		if levelIEs, err = ExtractGroupedIEsFrom(matchingIE); err != nil {
+			var decodeError *DecodeError
+			if errors.As(err, &decodeError) {
+				return nil, decodeError.withinIE(strings.Join(segments[:segmentIndex+1], "/"))
+			}
+			return nil, err
+		}
+	}
+
+	return nil, fmt.Errorf("empty IE lookup path")
+}
@@ -0,0 +1,109 @@
+package gtpv2
+
+import (
+	"net"
+	"testing"
+)
+
+func pduForLookupTests() *PDU {
+	return NewPDU(CreateSessionRequest, 0x01, []*IE{
+		(&TypedIMSI{AsString: "001002789012345"}).ToIE(),
+		NewIEWithRawData(RATType, []byte{0x06}),
+		(&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1), InterfaceType: 10, Key: 0x01}).ToIE(),
+		&IE{Type: FTEID, InstanceNumber: 1, Data: (&TypedFTEID{IPv4Addr: net.IPv4(10, 2, 2, 2), InterfaceType: 7, Key: 0x02}).ToIE().Data},
+		NewGroupedIE(BearerContext, []*IE{
+			NewIEWithRawData(EBI, []byte{0x05}),
+		}),
+		NewGroupedIE(BearerContext, []*IE{
+			NewIEWithRawData(EBI, []byte{0x06}),
+			(&TypedFTEID{IPv4Addr: net.IPv4(10, 3, 3, 3), InterfaceType: 1, Key: 0x03}).ToIE(),
+			&IE{Type: FTEID, InstanceNumber: 2, Data: (&TypedFTEID{IPv4Addr: net.IPv4(10, 4, 4, 4), InterfaceType: 5, Key: 0x04}).ToIE().Data},
+		}),
+	})
+}
+
+func TestPDUIELookups(t *testing.T) {
+	pdu := pduForLookupTests()
+
+	if ie := pdu.FirstIEOfType(FTEID); ie == nil || ie.InstanceNumber != 0 {
+		t.Errorf("[TestPDUIELookups] expected FirstIEOfType(FTEID) to return F-TEID with instance 0, got = (%v)", ie)
+	}
+
+	if ies := pdu.IEsOfType(BearerContext); len(ies) != 2 {
+		t.Errorf("[TestPDUIELookups] expected IEsOfType(BearerContext) to return 2 IEs, got = (%d)", len(ies))
+	}
+
+	if ie := pdu.IEWithInstance(FTEID, 1); ie == nil || ie.InstanceNumber != 1 {
+		t.Errorf("[TestPDUIELookups] expected IEWithInstance(FTEID, 1) to return F-TEID with instance 1, got = (%v)", ie)
+	}
+
+	if ies := pdu.IEsWithInstance(FTEID, 3); len(ies) != 0 {
+		t.Errorf("[TestPDUIELookups] expected IEsWithInstance(FTEID, 3) to return no IEs, got = (%d)", len(ies))
+	}
+
+	if !pdu.HasIEOfType(IMSI) || pdu.HasIEOfType(MEI) {
+		t.Errorf("[TestPDUIELookups] expected HasIEOfType() to be true for IMSI and false for MEI")
+	}
+
+	if !pdu.HasIEWithInstance(FTEID, 1) || pdu.HasIEWithInstance(RATType, 1) {
+		t.Errorf("[TestPDUIELookups] expected HasIEWithInstance() to be true for F-TEID instance 1 and false for RAT Type instance 1")
+	}
+}
+
+func TestIELookupPaths(t *testing.T) {
+	pdu := pduForLookupTests()
+
+	typedIE, err := pdu.LookupTypedIE("BearerContext[1]/FTEID#2")
+	if err != nil {
+		t.Fatalf("[TestIELookupPaths] expected no error on LookupTypedIE, got error = (%s)", err.Error())
+	}
+
+	if fteid := typedIE.(*TypedFTEID); fteid.Key != 0x04 {
+		t.Errorf("[TestIELookupPaths] expected F-TEID Key = (4) for BearerContext[1]/FTEID#2, got = (%d)", fteid.Key)
+	}
+
+	ie, err := pdu.LookupIE("F-TEID#1")
+	if err != nil {
+		t.Errorf("[TestIELookupPaths] expected no error on LookupIE(F-TEID#1), got error = (%s)", err.Error())
+	} else if ie.InstanceNumber != 1 {
+		t.Errorf("[TestIELookupPaths] expected instance (1) for F-TEID#1, got = (%d)", ie.InstanceNumber)
+	}
+
+	ie, err = pdu.LookupIE("93[1]/73")
+	if err != nil {
+		t.Errorf("[TestIELookupPaths] expected no error on LookupIE(93[1]/73), got error = (%s)", err.Error())
+	} else if err := compareByteArrays([]byte{0x06}, ie.Data); err != nil {
+		t.Errorf("[TestIELookupPaths] on LookupIE(93[1]/73): %s", err.Error())
+	}
+
+	bearerContext := pdu.IEsOfType(BearerContext)[1]
+	if ie, err := bearerContext.LookupIE("FTEID[1]"); err != nil {
+		t.Errorf("[TestIELookupPaths] expected no error on grouped IE LookupIE(FTEID[1]), got error = (%s)", err.Error())
+	} else if ie.InstanceNumber != 2 {
+		t.Errorf("[TestIELookupPaths] expected instance (2) for grouped IE FTEID[1], got = (%d)", ie.InstanceNumber)
+	}
+
+	if ie, err := bearerContext.GroupedIEWithInstance(FTEID, 2); err != nil || ie == nil {
+		t.Errorf("[TestIELookupPaths] expected GroupedIEWithInstance(FTEID, 2) to return an IE, got = (%v), error = (%v)", ie, err)
+	}
+
+	if ie, err := bearerContext.FirstGroupedIEOfType(MEI); err != nil || ie != nil {
+		t.Errorf("[TestIELookupPaths] expected FirstGroupedIEOfType(MEI) to return nil without error, got = (%v), error = (%v)", ie, err)
+	}
+
+	invalidPaths := []string{
+		"BearerContext[2]/EBI",
+		"BearerContext[0]/FTEID",
+		"NotAnIE",
+		"FTEID#16",
+		"FTEID[x]",
+		"RATType/EBI",
+		"",
+	}
+
+	for _, path := range invalidPaths {
+		if _, err := pdu.LookupIE(path); err == nil {
+			t.Errorf("[TestIELookupPaths] expected error on LookupIE(%s), but got none", path)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+package gtpv2
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+)
+
+// MessageHeader holds the header fields of a typed message (e.g.,
+// CreateSessionRequestMessage).  When the message is converted to a PDU, the TEID
+// field is present if the message type carries a TEID (see PDU.ValidateHeader()),
+// and the priority is present if PriorityFieldIsPresent is true.
+type MessageHeader struct {
+	TEID                   uint32
+	SequenceNumber         uint32
+	PriorityFieldIsPresent bool
+	Priority               uint8
+}
+
+// TypedMessage is a message with a struct field for each IE role in its schema (see
+// SchemaForMessageType()).  The field for a role is named for the role.  Its type is
+// the typed value for the IE type (e.g., *TypedFTEID) if the package has one, a
+// struct generated for the grouped IE content if the IE is grouped, and *IE
+// otherwise.  Roles that may appear more than once have slice fields.  IEs that do
+// not match a role, including Private Extensions and IEs beyond the first for a role
+// that may appear only once, are kept in the UnknownIEs field, so that converting a
+// PDU to a typed message and back loses no IEs.  A typed message converted from a PDU
+// remembers the IEs it was converted from.  When it is converted back, those IEs keep
+// the order in which they were received, and an IE whose typed value is unchanged is
+// emitted exactly as received, including any spare bits and additional octets that
+// the typed value does not represent.  Other IEs are placed after them, those for
+// roles in schema order, followed by UnknownIEs.
+type TypedMessage interface {
+	MessageType() MessageType
+	ToPDU() (*PDU, error)
+	FromPDU(pdu *PDU) error
+}
+
+// NewTypedMessageForType returns an empty typed message for the message type.
+// The boolean is false if there is no typed message for the message type.
+func NewTypedMessageForType(messageType MessageType) (TypedMessage, bool) {
+	newTypedMessage, isKnown := typedMessageConstructors[messageType]
+	if !isKnown {
+		return nil, false
+	}
+
+	return newTypedMessage(), true
+}
+
+// DecodeTypedMessage converts the PDU into the typed message for its message type.
+// Returns an error if there is no typed message for the message type, or if an IE
+// cannot be converted to its typed value.
+func DecodeTypedMessage(pdu *PDU) (TypedMessage, error) {
+	message, isKnown := NewTypedMessageForType(pdu.Type)
+	if !isKnown {
+		return nil, fmt.Errorf("no typed message for message type %s", NameOfMessageForType(pdu.Type))
+	}
+
+	if err := message.FromPDU(pdu); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+var ieReflectType = reflect.TypeOf((*IE)(nil))
+
+func typedMessageToPDU(messageType MessageType, header *MessageHeader, message interface{}) (*PDU, error) {
+	schema, isKnown := SchemaForMessageType(messageType)
+	if !isKnown {
+		return nil, fmt.Errorf("no schema for message type %s", NameOfMessageForType(messageType))
+	}
+
+	ies, err := iesFromTypedStruct(schema.IEs, reflect.ValueOf(message).Elem())
+	if err != nil {
+		return nil, err
+	}
+
+	teidFieldIsPresent := messageTypeCarriesTEID(messageType)
+
+	totalLength, err := computePDUTotalLength(teidFieldIsPresent, ies)
+	if err != nil {
+		return nil, err
+	}
+
+	pdu := &PDU{
+		Type:                   messageType,
+		TotalLength:            totalLength,
+		TEIDFieldIsPresent:     teidFieldIsPresent,
+		SequenceNumber:         header.SequenceNumber,
+		PriorityFieldIsPresent: header.PriorityFieldIsPresent,
+		Priority:               header.Priority,
+		InformationElements:    ies,
+	}
+
+	if teidFieldIsPresent {
+		pdu.TEID = header.TEID
+	}
+
+	return pdu, nil
+}
+
+// decodedIE is an IE from which a typed message, or the content of a grouped IE in a
+// typed message, was converted.  value is the field value converted from the IE, and
+// schema is the schema of its role, or nil if the IE was kept in UnknownIEs.
+type decodedIE struct {
+	ie     *IE
+	schema *IESchema
+	value  interface{}
+}
+
+// decodedIEs is embedded in each typed message struct, and in each struct for the
+// content of a grouped IE, to record the IEs it was converted from, in the order in
+// which they were received
+type decodedIEs struct {
+	messageType MessageType
+	ies         []decodedIE
+}
+
+func (decoded *decodedIEs) decodedIEsOfStruct() *decodedIEs {
+	return decoded
+}
+
+type decodedIEsHolder interface {
+	decodedIEsOfStruct() *decodedIEs
+}
+
+func decodedIEsOf(structValue reflect.Value) *decodedIEs {
+	return structValue.Addr().Interface().(decodedIEsHolder).decodedIEsOfStruct()
+}
+
+// typedFieldValue is a value held by a typed message struct, with the schema of its
+// role, or a nil schema for an IE in UnknownIEs
+type typedFieldValue struct {
+	schema *IESchema
+	value  reflect.Value
+}
+
+// typedFieldValuesOf returns the values held by the struct, in schema order,
+// followed by UnknownIEs
+func typedFieldValuesOf(schemas []*IESchema, structValue reflect.Value) []typedFieldValue {
+	values := make([]typedFieldValue, 0, len(schemas))
+
+	for _, schema := range schemas {
+		field := structValue.FieldByName(schema.Role)
+
+		if schema.Multiple {
+			for i := 0; i < field.Len(); i++ {
+				values = append(values, typedFieldValue{schema: schema, value: field.Index(i)})
+			}
+		} else if !field.IsNil() {
+			values = append(values, typedFieldValue{schema: schema, value: field})
+		}
+	}
+
+	unknownIEs := structValue.FieldByName("UnknownIEs")
+	for i := 0; i < unknownIEs.Len(); i++ {
+		values = append(values, typedFieldValue{value: unknownIEs.Index(i)})
+	}
+
+	return values
+}
+
+func iesFromTypedStruct(schemas []*IESchema, structValue reflect.Value) ([]*IE, error) {
+	decoded := decodedIEsOf(structValue)
+	values := typedFieldValuesOf(schemas, structValue)
+	ies := make([]*IE, 0, len(values))
+
+	isEmitted := make([]bool, len(values))
+	indexOfValue := make(map[interface{}]int, len(values))
+	for i := len(values) - 1; i >= 0; i-- {
+		indexOfValue[values[i].value.Interface()] = i
+	}
+
+	for i := range decoded.ies {
+		source := &decoded.ies[i]
+
+		valueIndex, isHeld := indexOfValue[source.value]
+		if !isHeld {
+			continue
+		}
+		delete(indexOfValue, source.value)
+		isEmitted[valueIndex] = true
+
+		ie, err := ieFromTypedField(decoded.messageType, values[valueIndex], source)
+		if err != nil {
+			return nil, err
+		}
+		ies = append(ies, ie)
+	}
+
+	for i, value := range values {
+		if isEmitted[i] {
+			continue
+		}
+
+		ie, err := ieFromTypedField(decoded.messageType, value, nil)
+		if err != nil {
+			return nil, err
+		}
+		ies = append(ies, ie)
+	}
+
+	return ies, nil
+}
+
+// ieFromTypedField converts the field value to an IE.  If source is not nil, it is
+// the IE from which the value was converted, and it is used instead if the value has
+// not changed since.
+func ieFromTypedField(messageType MessageType, field typedFieldValue, source *decodedIE) (*IE, error) {
+	schema, fieldValue := field.schema, field.value
+
+	if schema == nil {
+		return fieldValue.Interface().(*IE), nil
+	}
+
+	var ie *IE
+	var err error
+
+	switch {
+	case fieldValue.IsNil():
+		return nil, fmt.Errorf("value for role (%s) is nil", schema.Role)
+
+	case schema.GroupedIEs != nil:
+		groupedIEs, err := iesFromTypedStruct(schema.GroupedIEs, fieldValue.Elem())
+		if err != nil {
+			return nil, fmt.Errorf("in role (%s): %s", schema.Role, err)
+		}
+		if ie, err = NewGroupedIEErrorable(schema.Type, groupedIEs); err != nil {
+			return nil, fmt.Errorf("in role (%s): %s", schema.Role, err)
+		}
+
+	case fieldValue.Type() == ieReflectType:
+		ieCopy := *fieldValue.Interface().(*IE)
+		ie = &ieCopy
+
+	default:
+		if ie, err = fieldValue.Interface().(TypedIE).ToIEErrorable(); err != nil {
+			return nil, fmt.Errorf("on role (%s): %s", schema.Role, err)
+		}
+		if source != nil && source.schema == schema && typedValueIsUnchanged(messageType, source.ie, ie) {
+			ieCopy := *source.ie
+			ie = &ieCopy
+		}
+	}
+
+	if ie.Type != schema.Type {
+		return nil, fmt.Errorf("role (%s) requires IE type %s, but value has type %s", schema.Role, NameOfIEForType(schema.Type), NameOfIEForType(ie.Type))
+	}
+
+	ie.InstanceNumber = schema.Instance
+
+	return ie, nil
+}
+
+// typedValueIsUnchanged returns true if the IE encoded from a typed value is the same
+// as the IE encoded from the typed value converted from sourceIE, which means that
+// the typed value has not been changed since it was converted
+func typedValueIsUnchanged(messageType MessageType, sourceIE *IE, encodedIE *IE) bool {
+	if bytes.Equal(sourceIE.Data, encodedIE.Data) {
+		return true
+	}
+
+	sourceValue, err := sourceIE.TypedDataForMessageErrorable(messageType)
+	if err != nil {
+		return false
+	}
+
+	sourceValueIE, err := sourceValue.ToIEErrorable()
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(sourceValueIE.Data, encodedIE.Data)
+}
+
+func typedMessageFromPDU(pdu *PDU, messageType MessageType, header *MessageHeader, message interface{}) error {
+	if pdu.Type != messageType {
+		return fmt.Errorf("PDU message type is %s, not %s", NameOfMessageForType(pdu.Type), NameOfMessageForType(messageType))
+	}
+
+	schema, isKnown := SchemaForMessageType(messageType)
+	if !isKnown {
+		return fmt.Errorf("no schema for message type %s", NameOfMessageForType(messageType))
+	}
+
+	structValue := reflect.ValueOf(message).Elem()
+	structValue.Set(reflect.Zero(structValue.Type()))
+
+	*header = MessageHeader{
+		TEID:                   pdu.TEID,
+		SequenceNumber:         pdu.SequenceNumber,
+		PriorityFieldIsPresent: pdu.PriorityFieldIsPresent,
+		Priority:               pdu.Priority,
+	}
+
+	return typedStructFromIEs(messageType, schema.IEs, pdu.InformationElements, structValue)
+}
+
+func typedStructFromIEs(messageType MessageType, schemas []*IESchema, ies []*IE, structValue reflect.Value) error {
+	unknownIEs := make([]*IE, 0)
+	countOfIEsByType := make(map[IEType]int)
+
+	decoded := decodedIEsOf(structValue)
+	decoded.messageType = messageType
+	decoded.ies = make([]decodedIE, 0, len(ies))
+
+	for _, ie := range ies {
+		segment := pathSegmentForIE(ie.Type, countOfIEsByType[ie.Type])
+		countOfIEsByType[ie.Type]++
+
+		schema := ieSchemaFor(schemas, ie.Type, ie.InstanceNumber)
+		if schema == nil || ie.Unparsed {
+			unknownIEs = append(unknownIEs, ie)
+			decoded.ies = append(decoded.ies, decodedIE{ie: ie, value: ie})
+			continue
+		}
+
+		field := structValue.FieldByName(schema.Role)
+		if !schema.Multiple && !field.IsNil() {
+			unknownIEs = append(unknownIEs, ie)
+			decoded.ies = append(decoded.ies, decodedIE{ie: ie, value: ie})
+			continue
+		}
+
+		valueType := field.Type()
+		if schema.Multiple {
+			valueType = valueType.Elem()
+		}
+
+		value, err := typedFieldFromIE(messageType, schema, ie, valueType)
+		if err != nil {
+			if decodeError, isDecodeError := err.(*DecodeError); isDecodeError {
+				if schema.GroupedIEs != nil {
+					return decodeError.withinIE(segment)
+				}
+				return decodeError.atIE(segment, 0)
+			}
+			return err
+		}
+
+		if schema.Multiple {
+			field.Set(reflect.Append(field, value))
+		} else {
+			field.Set(value)
+		}
+
+		decoded.ies = append(decoded.ies, decodedIE{ie: ie, schema: schema, value: value.Interface()})
+	}
+
+	structValue.FieldByName("UnknownIEs").Set(reflect.ValueOf(unknownIEs))
+
+	return nil
+}
+
+func typedFieldFromIE(messageType MessageType, schema *IESchema, ie *IE, valueType reflect.Type) (reflect.Value, error) {
+	switch {
+	case schema.GroupedIEs != nil:
+		groupedIEs, err := ExtractGroupedIEsFrom(ie)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		value := reflect.New(valueType.Elem())
+		if err := typedStructFromIEs(messageType, schema.GroupedIEs, groupedIEs, value.Elem()); err != nil {
+			return reflect.Value{}, err
+		}
+
+		return value, nil
+
+	case valueType == ieReflectType:
+		return reflect.ValueOf(ie), nil
+	}
+
+	typedValue, err := ie.TypedDataForMessageErrorable(messageType)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	value := reflect.ValueOf(typedValue)
+	if !value.Type().AssignableTo(valueType) {
+		return reflect.Value{}, fmt.Errorf("typed value for role (%s) is (%s), which cannot be stored in a field of type (%s)", schema.Role, value.Type(), valueType)
+	}
+
+	return value, nil
+}
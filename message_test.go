@@ -0,0 +1,327 @@
+package gtpv2
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+func TestTypedMessageRoundTrip(t *testing.T) {
+	privateExtension := NewIEWithRawData(PrivateExtension, []byte{0x00, 0x0a, 0x01, 0x02})
+	duplicateRATType := &IE{Type: RATType, TotalLength: 5, Data: []byte{0x06}}
+
+	message := &CreateSessionRequestMessage{
+		Header:                     MessageHeader{TEID: 0x01020304, SequenceNumber: 0x000a0b},
+		IMSI:                       &TypedIMSI{AsString: "001010123456789"},
+		RATType:                    &TypedRATType{Value: 6},
+		SenderFTEIDForControlPlane: &TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1).To4(), InterfaceType: 10, Key: 0x0a},
+		AccessPointName:            NewIEWithRawData(APN, []byte{0x03, 'a', 'p', 'n'}),
+		BearerContextsToBeCreated: []*CreateSessionRequestBearerContextToBeCreated{
+			{
+				EPSBearerID:    &TypedEBI{Value: 5},
+				S5S8UPGWFTEID:  &TypedFTEID{IPv4Addr: net.IPv4(10, 2, 2, 2).To4(), InterfaceType: 5, Key: 0x0b},
+				BearerLevelQoS: &TypedBearerQoS{QCI: 9},
+			},
+		},
+		UnknownIEs: []*IE{duplicateRATType, privateExtension},
+	}
+
+	pdu, err := message.ToPDU()
+	if err != nil {
+		t.Fatalf("[TestTypedMessageRoundTrip] on ToPDU(), expected no error, got = (%s)", err)
+	}
+
+	if !pdu.TEIDFieldIsPresent || pdu.TEID != 0x01020304 || pdu.SequenceNumber != 0x000a0b {
+		t.Errorf("[TestTypedMessageRoundTrip] on ToPDU(), expected TEID (0x01020304) and sequence number (0x000a0b), got = (%v) (0x%08x), (0x%06x)", pdu.TEIDFieldIsPresent, pdu.TEID, pdu.SequenceNumber)
+	}
+
+	if violations := Validate(pdu); len(violations) != 1 || violations[0].Kind != ViolationTooManyOccurrences {
+		t.Errorf("[TestTypedMessageRoundTrip] on Validate(), expected only a too many occurrences violation for the duplicate RAT Type, got = (%v)", violations)
+	}
+
+	fteidIE, err := pdu.LookupIE("BearerContext/FTEID")
+	if err != nil || fteidIE.InstanceNumber != 3 {
+		t.Errorf("[TestTypedMessageRoundTrip] on LookupIE(BearerContext/FTEID), expected instance (3), got = (%v), error = (%v)", fteidIE, err)
+	}
+
+	decodedPDU, _, err := DecodePDU(pdu.Encode())
+	if err != nil {
+		t.Fatalf("[TestTypedMessageRoundTrip] on DecodePDU(), expected no error, got = (%s)", err)
+	}
+
+	typedMessage, err := DecodeTypedMessage(decodedPDU)
+	if err != nil {
+		t.Fatalf("[TestTypedMessageRoundTrip] on DecodeTypedMessage(), expected no error, got = (%s)", err)
+	}
+
+	decodedMessage, isCreateSessionRequest := typedMessage.(*CreateSessionRequestMessage)
+	if !isCreateSessionRequest {
+		t.Fatalf("[TestTypedMessageRoundTrip] on DecodeTypedMessage(), expected *CreateSessionRequestMessage, got = (%T)", typedMessage)
+	}
+
+	if decodedMessage.Header != message.Header {
+		t.Errorf("[TestTypedMessageRoundTrip] expected header (%v), got = (%v)", message.Header, decodedMessage.Header)
+	}
+
+	if decodedMessage.RATType == nil || decodedMessage.RATType.Value != 6 {
+		t.Errorf("[TestTypedMessageRoundTrip] expected RATType value (6), got = (%v)", decodedMessage.RATType)
+	}
+
+	if decodedMessage.IMSI == nil || decodedMessage.IMSI.AsString != "001010123456789" {
+		t.Errorf("[TestTypedMessageRoundTrip] expected IMSI (001010123456789), got = (%v)", decodedMessage.IMSI)
+	}
+
+	if len(decodedMessage.BearerContextsToBeCreated) != 1 {
+		t.Fatalf("[TestTypedMessageRoundTrip] expected (1) bearer context to be created, got = (%d)", len(decodedMessage.BearerContextsToBeCreated))
+	}
+
+	bearerContext := decodedMessage.BearerContextsToBeCreated[0]
+	if bearerContext.EPSBearerID == nil || bearerContext.EPSBearerID.Value != 5 {
+		t.Errorf("[TestTypedMessageRoundTrip] expected bearer context EBI (5), got = (%v)", bearerContext.EPSBearerID)
+	}
+	if bearerContext.S5S8UPGWFTEID == nil || bearerContext.S5S8UPGWFTEID.Key != 0x0b || bearerContext.S1UeNodeBFTEID != nil {
+		t.Errorf("[TestTypedMessageRoundTrip] expected only S5S8UPGWFTEID with key (0x0b), got = (%v), (%v)", bearerContext.S5S8UPGWFTEID, bearerContext.S1UeNodeBFTEID)
+	}
+
+	if len(decodedMessage.UnknownIEs) != 2 {
+		t.Fatalf("[TestTypedMessageRoundTrip] expected (2) unknown IEs, got = (%d)", len(decodedMessage.UnknownIEs))
+	}
+	if err := compareTwoIEObjects(duplicateRATType, decodedMessage.UnknownIEs[0]); err != nil {
+		t.Errorf("[TestTypedMessageRoundTrip] on duplicate RAT Type unknown IE: %s", err)
+	}
+	if err := compareTwoIEObjects(privateExtension, decodedMessage.UnknownIEs[1]); err != nil {
+		t.Errorf("[TestTypedMessageRoundTrip] on Private Extension unknown IE: %s", err)
+	}
+
+	reencodedPDU, err := decodedMessage.ToPDU()
+	if err != nil {
+		t.Fatalf("[TestTypedMessageRoundTrip] on second ToPDU(), expected no error, got = (%s)", err)
+	}
+
+	if err := compareByteArrays(pdu.Encode(), reencodedPDU.Encode()); err != nil {
+		t.Errorf("[TestTypedMessageRoundTrip] on re-encode: %s", err)
+	}
+}
+
+func TestTypedMessageHeaderTEID(t *testing.T) {
+	message := &EchoRequestMessage{
+		Header:   MessageHeader{TEID: 0x01020304, SequenceNumber: 1},
+		Recovery: &TypedRecovery{RestartCounter: 7},
+	}
+
+	pdu, err := message.ToPDU()
+	if err != nil {
+		t.Fatalf("[TestTypedMessageHeaderTEID] on ToPDU(), expected no error, got = (%s)", err)
+	}
+
+	if err := pdu.ValidateHeader(); err != nil {
+		t.Errorf("[TestTypedMessageHeaderTEID] on ValidateHeader(), expected no error, got = (%s)", err)
+	}
+
+	if pdu.TEIDFieldIsPresent || pdu.TEID != 0 {
+		t.Errorf("[TestTypedMessageHeaderTEID] expected no TEID for Echo Request, got = (%v), (%d)", pdu.TEIDFieldIsPresent, pdu.TEID)
+	}
+}
+
+func TestTypedMessageErrors(t *testing.T) {
+	if _, err := (&CreateSessionResponseMessage{Cause: &TypedCause{Value: 16}}).ToPDU(); err != nil {
+		t.Errorf("[TestTypedMessageErrors] on CreateSessionResponseMessage.ToPDU(), expected no error, got = (%s)", err)
+	}
+
+	message := &CreateSessionRequestMessage{AccessPointName: NewIEWithRawData(IMSI, []byte{0x00})}
+	if _, err := message.ToPDU(); err == nil {
+		t.Errorf("[TestTypedMessageErrors] on ToPDU() with IE of wrong type for role, expected error, got none")
+	}
+
+	echoPDU, _ := (&EchoRequestMessage{Recovery: &TypedRecovery{RestartCounter: 1}}).ToPDU()
+	if err := (&EchoResponseMessage{}).FromPDU(echoPDU); err == nil {
+		t.Errorf("[TestTypedMessageErrors] on EchoResponseMessage.FromPDU() with Echo Request, expected error, got none")
+	}
+
+	if _, err := DecodeTypedMessage(&PDU{Type: MBMSSessionStartRequest}); err == nil {
+		t.Errorf("[TestTypedMessageErrors] on DecodeTypedMessage() for message type without typed message, expected error, got none")
+	}
+
+	badBearerContext := NewGroupedIE(BearerContext, []*IE{NewIEWithRawData(EBI, []byte{})})
+	pdu := NewPDU(CreateSessionRequest, 1, []*IE{badBearerContext})
+
+	_, err := DecodeTypedMessage(pdu)
+
+	var decodeError *DecodeError
+	if !errors.As(err, &decodeError) {
+		t.Fatalf("[TestTypedMessageErrors] on DecodeTypedMessage() with empty EBI, expected *DecodeError, got = (%v)", err)
+	}
+
+	if decodeError.Path != "BearerContext/EBI" {
+		t.Errorf("[TestTypedMessageErrors] on DecodeTypedMessage() with empty EBI, expected path (BearerContext/EBI), got = (%s)", decodeError.Path)
+	}
+}
+
+func TestMobilityTypedMessageRoundTrip(t *testing.T) {
+	privateExtension := (&TypedPrivateExtension{EnterpriseID: 0x1234, Value: []byte{0xde, 0xad}}).ToIE()
+	unknownInGroup := NewIEWithRawData(ChargingID, []byte{0x00, 0x00, 0x00, 0x01})
+
+	message := &ForwardRelocationRequestMessage{
+		Header:                     MessageHeader{TEID: 0x0a0b0c0d, SequenceNumber: 0x123456},
+		IMSI:                       &TypedIMSI{AsString: "001010123456789"},
+		SenderFTEIDForControlPlane: &TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1).To4(), InterfaceType: 12, Key: 0x01},
+		MMESGSNUEEPSPDNConnections: []*ForwardRelocationRequestPDNConnection{
+			{
+				APN:                             NewIEWithRawData(APN, []byte{0x03, 'a', 'p', 'n'}),
+				LinkedEPSBearerID:               &TypedEBI{Value: 5},
+				PGWS5S8IPAddressForControlPlane: &TypedFTEID{IPv4Addr: net.IPv4(10, 3, 3, 3).To4(), InterfaceType: 7, Key: 0x02},
+				BearerContexts: []*ForwardRelocationRequestBearerContext{
+					{
+						EPSBearerID:                            &TypedEBI{Value: 5},
+						SGWS1S4S12IPAddressAndTEIDForUserPlane: &TypedFTEID{IPv4Addr: net.IPv4(10, 4, 4, 4).To4(), InterfaceType: 1, Key: 0x03},
+						BearerLevelQoS:                         &TypedBearerQoS{QCI: 9},
+					},
+				},
+				AggregateMaximumBitRate: &TypedAMBR{},
+				UnknownIEs:              []*IE{unknownInGroup},
+			},
+		},
+		SGWS11S4IPAddressAndTEIDForControlPlane:              &TypedFTEID{IPv4Addr: net.IPv4(10, 2, 2, 2).To4(), InterfaceType: 11, Key: 0x04},
+		MMContextEPSSecurityContextQuadrupletsAndQuintuplets: NewIEWithRawData(MMContextEPSSecurityContextQuadrupletsandQuintuplets, []byte{0x01, 0x02}),
+		UnknownIEs: []*IE{privateExtension},
+	}
+
+	pdu, err := message.ToPDU()
+	if err != nil {
+		t.Fatalf("[TestMobilityTypedMessageRoundTrip] on ToPDU(), expected no error, got = (%s)", err)
+	}
+
+	if violations := Validate(pdu); len(violations) != 0 {
+		t.Errorf("[TestMobilityTypedMessageRoundTrip] on Validate(), expected no violations, got = (%v)", violations)
+	}
+
+	if ie, err := pdu.LookupIE("FTEID[1]"); err != nil || ie.InstanceNumber != 1 {
+		t.Errorf("[TestMobilityTypedMessageRoundTrip] on LookupIE(FTEID[1]), expected S11/S4 SGW F-TEID with instance (1), got = (%v), error = (%v)", ie, err)
+	}
+
+	decodedPDU, _, err := DecodePDU(pdu.Encode())
+	if err != nil {
+		t.Fatalf("[TestMobilityTypedMessageRoundTrip] on DecodePDU(), expected no error, got = (%s)", err)
+	}
+
+	decodedMessage := &ForwardRelocationRequestMessage{}
+	if err := decodedMessage.FromPDU(decodedPDU); err != nil {
+		t.Fatalf("[TestMobilityTypedMessageRoundTrip] on FromPDU(), expected no error, got = (%s)", err)
+	}
+
+	if decodedMessage.SGWS11S4IPAddressAndTEIDForControlPlane == nil || decodedMessage.SGWS11S4IPAddressAndTEIDForControlPlane.Key != 0x04 {
+		t.Errorf("[TestMobilityTypedMessageRoundTrip] expected SGW control plane F-TEID with key (0x04), got = (%v)", decodedMessage.SGWS11S4IPAddressAndTEIDForControlPlane)
+	}
+
+	if len(decodedMessage.MMESGSNUEEPSPDNConnections) != 1 || len(decodedMessage.MMESGSNUEEPSPDNConnections[0].BearerContexts) != 1 {
+		t.Fatalf("[TestMobilityTypedMessageRoundTrip] expected (1) PDN connection with (1) bearer context, got = (%v)", decodedMessage.MMESGSNUEEPSPDNConnections)
+	}
+
+	pdnConnection := decodedMessage.MMESGSNUEEPSPDNConnections[0]
+	if pdnConnection.BearerContexts[0].SGWS1S4S12IPAddressAndTEIDForUserPlane == nil || pdnConnection.BearerContexts[0].SGWS1S4S12IPAddressAndTEIDForUserPlane.Key != 0x03 {
+		t.Errorf("[TestMobilityTypedMessageRoundTrip] expected bearer context user plane F-TEID with key (0x03), got = (%v)", pdnConnection.BearerContexts[0].SGWS1S4S12IPAddressAndTEIDForUserPlane)
+	}
+
+	if len(pdnConnection.UnknownIEs) != 1 {
+		t.Errorf("[TestMobilityTypedMessageRoundTrip] expected (1) unknown IE in PDN connection, got = (%d)", len(pdnConnection.UnknownIEs))
+	} else if err := compareTwoIEObjects(unknownInGroup, pdnConnection.UnknownIEs[0]); err != nil {
+		t.Errorf("[TestMobilityTypedMessageRoundTrip] on PDN connection unknown IE: %s", err)
+	}
+
+	if len(decodedMessage.UnknownIEs) != 1 {
+		t.Fatalf("[TestMobilityTypedMessageRoundTrip] expected (1) unknown IE, got = (%d)", len(decodedMessage.UnknownIEs))
+	}
+	if err := compareTwoIEObjects(privateExtension, decodedMessage.UnknownIEs[0]); err != nil {
+		t.Errorf("[TestMobilityTypedMessageRoundTrip] on Private Extension unknown IE: %s", err)
+	}
+
+	reencodedPDU, err := decodedMessage.ToPDU()
+	if err != nil {
+		t.Fatalf("[TestMobilityTypedMessageRoundTrip] on second ToPDU(), expected no error, got = (%s)", err)
+	}
+
+	if err := compareByteArrays(pdu.Encode(), reencodedPDU.Encode()); err != nil {
+		t.Errorf("[TestMobilityTypedMessageRoundTrip] on re-encode: %s", err)
+	}
+
+	mobilityMessageTypes := []MessageType{
+		ContextRequest, ContextResponse, ContextAcknowledge,
+		ForwardRelocationRequest, ForwardRelocationResponse, ForwardRelocationCompleteNotification, ForwardRelocationCompleteAcknowledge,
+		RelocationCancelRequest, RelocationCancelResponse,
+		IdentificationRequest, IdentificationResponse,
+		CreateIndirectDataForwardingTunnelRequest, CreateIndirectDataForwardingTunnelResponse,
+		DeleteIndirectDataForwardingTunnelRequest, DeleteIndirectDataForwardingTunnelResponse,
+	}
+
+	for _, messageType := range mobilityMessageTypes {
+		if typedMessage, isKnown := NewTypedMessageForType(messageType); !isKnown || typedMessage.MessageType() != messageType {
+			t.Errorf("[TestMobilityTypedMessageRoundTrip] on NewTypedMessageForType(%s), expected typed message, got = (%v), (%v)", NameOfMessageForType(messageType), typedMessage, isKnown)
+		}
+	}
+}
+
+func TestTypedMessageRoundTripFromWire(t *testing.T) {
+	fteidData := (&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1).To4(), InterfaceType: 10, Key: 0x0a}).ToIE().Data
+
+	// IEs out of schema order, with a spare bit set in the EBI and an additional octet
+	// in the Recovery
+	wire := NewPDU(CreateSessionRequest, 0x000102, []*IE{
+		NewIEWithRawData(PrivateExtension, []byte{0x00, 0x0a, 0x01}),
+		NewIEWithRawData(RecoveryRestartCounter, []byte{0x05, 0xaa}),
+		NewIEWithRawData(RATType, []byte{0x06}),
+		NewGroupedIE(BearerContext, []*IE{
+			NewIEWithRawData(BearerQoS, make([]byte, 22)),
+			NewIEWithRawData(EBI, []byte{0xf5}),
+		}),
+		NewIEWithRawData(FTEID, fteidData),
+		NewIEWithRawData(APN, []byte{0x03, 'a', 'p', 'n'}),
+	}).SetTEID(0x01020304).Encode()
+
+	decodedPDU, _, err := DecodePDU(wire)
+	if err != nil {
+		t.Fatalf("[TestTypedMessageRoundTripFromWire] on DecodePDU(), expected no error, got = (%s)", err)
+	}
+
+	typedMessage, err := DecodeTypedMessage(decodedPDU)
+	if err != nil {
+		t.Fatalf("[TestTypedMessageRoundTripFromWire] on DecodeTypedMessage(), expected no error, got = (%s)", err)
+	}
+	message := typedMessage.(*CreateSessionRequestMessage)
+
+	pdu, err := message.ToPDU()
+	if err != nil {
+		t.Fatalf("[TestTypedMessageRoundTripFromWire] on ToPDU(), expected no error, got = (%s)", err)
+	}
+
+	if err := compareByteArrays(wire, pdu.Encode()); err != nil {
+		t.Errorf("[TestTypedMessageRoundTripFromWire] on unchanged message re-encode: %s", err)
+	}
+
+	// a changed typed value is encoded from the value, but keeps its position, and an
+	// added value follows the IEs that were received
+	message.RATType.Value = 8
+	message.BearerContextsToBeCreated[0].EPSBearerID = &TypedEBI{Value: 6}
+	message.LinkedEPSBearerID = &TypedEBI{Value: 7}
+
+	pdu, err = message.ToPDU()
+	if err != nil {
+		t.Fatalf("[TestTypedMessageRoundTripFromWire] on ToPDU() after change, expected no error, got = (%s)", err)
+	}
+
+	expected := NewPDU(CreateSessionRequest, 0x000102, []*IE{
+		NewIEWithRawData(PrivateExtension, []byte{0x00, 0x0a, 0x01}),
+		NewIEWithRawData(RecoveryRestartCounter, []byte{0x05, 0xaa}),
+		NewIEWithRawData(RATType, []byte{0x08}),
+		NewGroupedIE(BearerContext, []*IE{
+			NewIEWithRawData(BearerQoS, make([]byte, 22)),
+			NewIEWithRawData(EBI, []byte{0x06}),
+		}),
+		NewIEWithRawData(FTEID, fteidData),
+		NewIEWithRawData(APN, []byte{0x03, 'a', 'p', 'n'}),
+		NewIEWithRawData(EBI, []byte{0x07}),
+	}).SetTEID(0x01020304).Encode()
+
+	if err := compareByteArrays(expected, pdu.Encode()); err != nil {
+		t.Errorf("[TestTypedMessageRoundTripFromWire] on changed message re-encode: %s", err)
+	}
+}
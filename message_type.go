@@ -0,0 +1,187 @@
+package gtpv2
+
+import "fmt"
+
+// MessageClass is the role of a message type in a GTPv2 procedure (TS 29.274
+// section 7.6)
+type MessageClass int
+
+// Possible MessageClass values
+const (
+	// MessageClassRequest is an initial or triggered message that is answered by a
+	// Response (e.g., Create Session Request)
+	MessageClassRequest MessageClass = iota + 1
+
+	// MessageClassResponse is a triggered message sent in reply to a Request
+	MessageClassResponse
+
+	// MessageClassCommand is an initial message that triggers a Request, or a
+	// Failure Indication if the Request cannot be sent (e.g., Modify Bearer Command)
+	MessageClassCommand
+
+	// MessageClassFailureIndication is a triggered message sent in reply to a
+	// Command that could not be carried out
+	MessageClassFailureIndication
+
+	// MessageClassNotification is an initial message that is answered by an
+	// Acknowledge (e.g., Downlink Data Notification)
+	MessageClassNotification
+
+	// MessageClassAcknowledge is a triggered message sent in reply to a
+	// Notification, or to a Context Response
+	MessageClassAcknowledge
+
+	// MessageClassIndication is a message that is not answered (e.g., Stop Paging
+	// Indication or Trace Session Activation)
+	MessageClassIndication
+)
+
+var messageClassNames = map[MessageClass]string{
+	MessageClassRequest:           "Request",
+	MessageClassResponse:          "Response",
+	MessageClassCommand:           "Command",
+	MessageClassFailureIndication: "Failure Indication",
+	MessageClassNotification:      "Notification",
+	MessageClassAcknowledge:       "Acknowledge",
+	MessageClassIndication:        "Indication",
+}
+
+func (class MessageClass) String() string {
+	if name, isKnown := messageClassNames[class]; isKnown {
+		return name
+	}
+
+	return fmt.Sprintf("unknown message class (%d)", int(class))
+}
+
+// Interface is a 3GPP reference point over which GTPv2-C messages are exchanged
+type Interface int
+
+// Possible Interface values
+const (
+	InterfaceS2a Interface = iota + 1
+	InterfaceS2b
+	InterfaceS3
+	InterfaceS4
+	InterfaceS5S8
+	InterfaceS10
+	InterfaceS11
+	InterfaceS16
+	InterfaceSm
+	InterfaceSn
+)
+
+var interfaceNames = map[Interface]string{
+	InterfaceS2a:  "S2a",
+	InterfaceS2b:  "S2b",
+	InterfaceS3:   "S3",
+	InterfaceS4:   "S4",
+	InterfaceS5S8: "S5/S8",
+	InterfaceS10:  "S10",
+	InterfaceS11:  "S11",
+	InterfaceS16:  "S16",
+	InterfaceSm:   "Sm",
+	InterfaceSn:   "Sn",
+}
+
+func (iface Interface) String() string {
+	if name, isKnown := interfaceNames[iface]; isKnown {
+		return name
+	}
+
+	return fmt.Sprintf("unknown interface (%d)", int(iface))
+}
+
+// messageTypeTriggeredBy maps each message type that is sent in reply to another
+// (see MessageType.ResponseType()) to the message type that it answers
+var messageTypeTriggeredBy = func() map[MessageType]MessageType {
+	triggeredBy := make(map[MessageType]MessageType)
+	for messageType, responseType := range messageTypeResponses {
+		triggeredBy[responseType] = messageType
+	}
+	return triggeredBy
+}()
+
+// Class returns the class of the message type, or 0 if the message type is not
+// known
+func (messageType MessageType) Class() MessageClass {
+	return messageTypeClasses[messageType]
+}
+
+// IsRequest returns true if the message type is a Request
+func (messageType MessageType) IsRequest() bool {
+	return messageTypeClasses[messageType] == MessageClassRequest
+}
+
+// IsResponse returns true if the message type is sent in reply to another message,
+// that is, if it is a Response, an Acknowledge or a Failure Indication.  For such
+// a message type, TriggeringType() returns the message type that it answers.
+func (messageType MessageType) IsResponse() bool {
+	_, isResponse := messageTypeTriggeredBy[messageType]
+	return isResponse
+}
+
+// IsCommand returns true if the message type is a Command
+func (messageType MessageType) IsCommand() bool {
+	return messageTypeClasses[messageType] == MessageClassCommand
+}
+
+// IsNotification returns true if the message type is a Notification
+func (messageType MessageType) IsNotification() bool {
+	return messageTypeClasses[messageType] == MessageClassNotification
+}
+
+// ResponseType returns the message type sent in reply to a message of this type,
+// with the same sequence number.  That is the Response for a Request, the
+// Acknowledge for a Notification (or for a Context Response), and the Failure
+// Indication for a Command.  The boolean is false if a message of this type is not
+// answered.
+func (messageType MessageType) ResponseType() (MessageType, bool) {
+	responseType, isAnswered := messageTypeResponses[messageType]
+	return responseType, isAnswered
+}
+
+// TriggeringType is the inverse of ResponseType().  It returns the message type
+// that a message of this type answers.  The boolean is false if the message type
+// is not sent in reply to another.
+func (messageType MessageType) TriggeringType() (MessageType, bool) {
+	triggeringType, isTriggered := messageTypeTriggeredBy[messageType]
+	return triggeringType, isTriggered
+}
+
+// TriggeredTypes returns every message type that a message of this type may
+// trigger (TS 29.274 section 7.6).  This is the ResponseType() and, for a Command,
+// the Requests that it may trigger (e.g., Update Bearer Request for a Modify Bearer
+// Command).  Returns nil if a message of this type triggers no message.
+func (messageType MessageType) TriggeredTypes() []MessageType {
+	responseType, isAnswered := messageTypeResponses[messageType]
+	if !isAnswered {
+		return nil
+	}
+
+	return append([]MessageType{responseType}, messageTypeTriggers[messageType]...)
+}
+
+// Interfaces returns the interfaces on which a message of this type may appear.
+// Returns nil if the message type is not known.
+func (messageType MessageType) Interfaces() []Interface {
+	return append([]Interface(nil), messageTypeInterfaces[messageType]...)
+}
+
+// IsAllowedOnInterface returns true if a message of this type may appear on the
+// provided interface
+func (messageType MessageType) IsAllowedOnInterface(iface Interface) bool {
+	for _, allowed := range messageTypeInterfaces[messageType] {
+		if allowed == iface {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CarriesTEID returns true if the header of a message of this type must contain
+// the TEID field, and false if it must not (see PDU.ValidateHeader())
+func (messageType MessageType) CarriesTEID() bool {
+	return messageTypeCarriesTEID(messageType)
+}
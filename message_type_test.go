@@ -0,0 +1,100 @@
+package gtpv2
+
+import "testing"
+
+func TestMessageTypeMetadata(t *testing.T) {
+	testCases := []struct {
+		messageType    MessageType
+		class          MessageClass
+		isRequest      bool
+		isResponse     bool
+		isCommand      bool
+		isNotification bool
+		responseType   MessageType
+		isAnswered     bool
+		carriesTEID    bool
+	}{
+		{CreateSessionRequest, MessageClassRequest, true, false, false, false, CreateSessionResponse, true, true},
+		{CreateSessionResponse, MessageClassResponse, false, true, false, false, 0, false, true},
+		{EchoRequest, MessageClassRequest, true, false, false, false, EchoResponse, true, false},
+		{ModifyBearerCommand, MessageClassCommand, false, false, true, false, ModifyBearerFailureIndication, true, true},
+		{ModifyBearerFailureIndication, MessageClassFailureIndication, false, true, false, false, 0, false, true},
+		{DownlinkDataNotification, MessageClassNotification, false, false, false, true, DownlinkDataNotificationAcknowledge, true, true},
+		{ContextResponse, MessageClassResponse, false, true, false, false, ContextAcknowledge, true, true},
+		{StopPagingIndication, MessageClassIndication, false, false, false, false, 0, false, true},
+		{VersionNotSupportedIndication, MessageClassIndication, false, false, false, false, 0, false, false},
+	}
+
+	for _, testCase := range testCases {
+		name := NameOfMessageForType(testCase.messageType)
+
+		if class := testCase.messageType.Class(); class != testCase.class {
+			t.Errorf("[TestMessageTypeMetadata] on Class() for (%s), expected (%s), got = (%s)", name, testCase.class, class)
+		}
+
+		if testCase.messageType.IsRequest() != testCase.isRequest || testCase.messageType.IsResponse() != testCase.isResponse ||
+			testCase.messageType.IsCommand() != testCase.isCommand || testCase.messageType.IsNotification() != testCase.isNotification {
+			t.Errorf("[TestMessageTypeMetadata] on Is*() for (%s), expected request (%v), response (%v), command (%v), notification (%v), got = (%v), (%v), (%v), (%v)",
+				name, testCase.isRequest, testCase.isResponse, testCase.isCommand, testCase.isNotification,
+				testCase.messageType.IsRequest(), testCase.messageType.IsResponse(), testCase.messageType.IsCommand(), testCase.messageType.IsNotification())
+		}
+
+		responseType, isAnswered := testCase.messageType.ResponseType()
+		if responseType != testCase.responseType || isAnswered != testCase.isAnswered {
+			t.Errorf("[TestMessageTypeMetadata] on ResponseType() for (%s), expected (%d), (%v), got = (%d), (%v)", name, testCase.responseType, testCase.isAnswered, responseType, isAnswered)
+		}
+
+		if isAnswered {
+			if triggeringType, isTriggered := responseType.TriggeringType(); !isTriggered || triggeringType != testCase.messageType {
+				t.Errorf("[TestMessageTypeMetadata] on TriggeringType() for response to (%s), expected (%d), got = (%d), (%v)", name, testCase.messageType, triggeringType, isTriggered)
+			}
+		}
+
+		if testCase.messageType.CarriesTEID() != testCase.carriesTEID {
+			t.Errorf("[TestMessageTypeMetadata] on CarriesTEID() for (%s), expected (%v), got = (%v)", name, testCase.carriesTEID, testCase.messageType.CarriesTEID())
+		}
+	}
+
+	triggeredTypes := BearerResourceCommand.TriggeredTypes()
+	expectedTriggeredTypes := []MessageType{BearerResourceFailureIndication, CreateBearerRequest, UpdateBearerRequest, DeleteBearerRequest}
+	if len(triggeredTypes) != len(expectedTriggeredTypes) {
+		t.Fatalf("[TestMessageTypeMetadata] on TriggeredTypes() for Bearer Resource Command, expected (%v), got = (%v)", expectedTriggeredTypes, triggeredTypes)
+	}
+	for i := range triggeredTypes {
+		if triggeredTypes[i] != expectedTriggeredTypes[i] {
+			t.Errorf("[TestMessageTypeMetadata] on TriggeredTypes() for Bearer Resource Command, expected (%v), got = (%v)", expectedTriggeredTypes, triggeredTypes)
+		}
+	}
+
+	if StopPagingIndication.TriggeredTypes() != nil {
+		t.Errorf("[TestMessageTypeMetadata] on TriggeredTypes() for Stop Paging Indication, expected nil, got = (%v)", StopPagingIndication.TriggeredTypes())
+	}
+
+	if !CreateSessionRequest.IsAllowedOnInterface(InterfaceS11) || CreateSessionRequest.IsAllowedOnInterface(InterfaceS10) {
+		t.Errorf("[TestMessageTypeMetadata] on IsAllowedOnInterface() for Create Session Request, expected S11 but not S10")
+	}
+
+	if !ForwardRelocationRequest.IsAllowedOnInterface(InterfaceS10) || ModifyAccessBearersRequest.IsAllowedOnInterface(InterfaceS5S8) {
+		t.Errorf("[TestMessageTypeMetadata] on IsAllowedOnInterface(), expected Forward Relocation Request on S10 and Modify Access Bearers Request not on S5/S8")
+	}
+
+	if interfaces := MessageType(0).Interfaces(); interfaces != nil {
+		t.Errorf("[TestMessageTypeMetadata] on Interfaces() for unknown message type, expected nil, got = (%v)", interfaces)
+	}
+
+	if InterfaceS5S8.String() != "S5/S8" || MessageClassFailureIndication.String() != "Failure Indication" {
+		t.Errorf("[TestMessageTypeMetadata] on String(), expected (S5/S8) and (Failure Indication), got = (%s), (%s)", InterfaceS5S8, MessageClassFailureIndication)
+	}
+}
+
+func TestEveryMessageTypeHasMetadata(t *testing.T) {
+	for messageType := range messageTypeReleases {
+		if messageType.Class() == 0 || len(messageType.Interfaces()) == 0 {
+			t.Errorf("[TestEveryMessageTypeHasMetadata] expected class and interfaces for (%s)", NameOfMessageForType(messageType))
+		}
+
+		if responseType, isAnswered := messageType.ResponseType(); isAnswered && !responseType.IsResponse() {
+			t.Errorf("[TestEveryMessageTypeHasMetadata] expected response to (%s) to be a response", NameOfMessageForType(messageType))
+		}
+	}
+}
@@ -0,0 +1,1644 @@
+// Code generated by gen_schemas.go from schemas.yaml; DO NOT EDIT.
+
+package gtpv2
+
+// EchoRequestMessage is the typed message (see TypedMessage) for EchoRequest
+type EchoRequestMessage struct {
+	Header              MessageHeader
+	Recovery            *TypedRecovery // RecoveryRestartCounter instance 0, M
+	SendingNodeFeatures *IE            // NodeFeatures instance 0, CO
+	UnknownIEs          []*IE
+	decodedIEs
+}
+
+// MessageType returns EchoRequest
+func (message *EchoRequestMessage) MessageType() MessageType {
+	return EchoRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *EchoRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(EchoRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type EchoRequest
+func (message *EchoRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, EchoRequest, &message.Header, message)
+}
+
+// EchoResponseMessage is the typed message (see TypedMessage) for EchoResponse
+type EchoResponseMessage struct {
+	Header              MessageHeader
+	Recovery            *TypedRecovery // RecoveryRestartCounter instance 0, M
+	SendingNodeFeatures *IE            // NodeFeatures instance 0, CO
+	UnknownIEs          []*IE
+	decodedIEs
+}
+
+// MessageType returns EchoResponse
+func (message *EchoResponseMessage) MessageType() MessageType {
+	return EchoResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *EchoResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(EchoResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type EchoResponse
+func (message *EchoResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, EchoResponse, &message.Header, message)
+}
+
+// CreateSessionRequestMessage is the typed message (see TypedMessage) for CreateSessionRequest
+type CreateSessionRequestMessage struct {
+	Header                                 MessageHeader
+	IMSI                                   *TypedIMSI                                      // IMSI instance 0, C
+	MSISDN                                 *TypedMSISDN                                    // MSISDN instance 0, C
+	MEI                                    *TypedMEI                                       // MEI instance 0, C
+	UserLocationInformation                *IE                                             // ULI instance 0, C
+	ServingNetwork                         *TypedServingNetwork                            // ServingNetwork instance 0, C
+	RATType                                *TypedRATType                                   // RATType instance 0, M
+	IndicationFlags                        *IE                                             // Indication instance 0, C
+	SenderFTEIDForControlPlane             *TypedFTEID                                     // FTEID instance 0, M
+	PGWS5S8AddressForControlPlane          *TypedFTEID                                     // FTEID instance 1, C
+	AccessPointName                        *IE                                             // APN instance 0, M
+	SelectionMode                          *TypedSelectionMode                             // SelectionMode instance 0, C
+	PDNType                                *TypedPDNType                                   // PDNType instance 0, C
+	PDNAddressAllocation                   *IE                                             // PAA instance 0, C
+	MaximumAPNRestriction                  *TypedAPNRestriction                            // APNRestriction instance 0, C
+	APNAMBR                                *TypedAMBR                                      // AMBR instance 0, C
+	LinkedEPSBearerID                      *TypedEBI                                       // EBI instance 0, C
+	TrustedWLANModeIndication              *IE                                             // TrustedWLANModeIndication instance 0, CO
+	ProtocolConfigurationOptions           *IE                                             // PCI instance 0, C
+	BearerContextsToBeCreated              []*CreateSessionRequestBearerContextToBeCreated // BearerContext instance 0, M, multiple
+	BearerContextsToBeRemoved              []*CreateSessionRequestBearerContextToBeRemoved // BearerContext instance 1, C, multiple
+	TraceInformation                       *IE                                             // TraceInformation instance 0, C
+	Recovery                               *TypedRecovery                                  // RecoveryRestartCounter instance 0, C
+	MMEFQCSID                              *IE                                             // FQCSID instance 0, C
+	SGWFQCSID                              *IE                                             // FQCSID instance 1, C
+	EPDGFQCSID                             *IE                                             // FQCSID instance 2, C
+	TWANFQCSID                             *IE                                             // FQCSID instance 3, C
+	UETimeZone                             *TypedUETimeZone                                // UETimeZone instance 0, CO
+	UserCSGInformation                     *IE                                             // UCI instance 0, CO
+	ChargingCharacteristics                *TypedChargingCharacteristics                   // ChargingCharacteristics instance 0, C
+	MMELDN                                 *IE                                             // LDN instance 0, O
+	SGWLDN                                 *IE                                             // LDN instance 1, O
+	EPDGLDN                                *IE                                             // LDN instance 2, O
+	TWANLDN                                *IE                                             // LDN instance 3, O
+	SignallingPriorityIndication           *IE                                             // SignallingPriorityIndication instance 0, CO
+	UELocalIPAddress                       *IE                                             // IPAddress instance 0, CO
+	UEUDPPort                              *TypedPortNumber                                // PortNumber instance 0, CO
+	AdditionalProtocolConfigurationOptions *IE                                             // APCO instance 0, CO
+	HeNBLocalIPAddress                     *IE                                             // IPAddress instance 1, CO
+	HeNBUDPPort                            *TypedPortNumber                                // PortNumber instance 1, CO
+	MMEIdentifier                          *IE                                             // IPAddress instance 2, CO
+	TWANIdentifier                         *IE                                             // TWANIdentifier instance 0, CO
+	EPDGIPAddress                          *IE                                             // IPAddress instance 3, O
+	CNOperatorSelectionEntity              *IE                                             // CNOperatorSelectionEntity instance 0, CO
+	PresenceReportingAreaInformation       *IE                                             // PresenceReportingAreaInformation instance 0, CO
+	MMEOverloadControlInformation          *IE                                             // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation          *IE                                             // OverloadControlInformation instance 1, O
+	TWANOverloadControlInformation         *IE                                             // OverloadControlInformation instance 2, O
+	OriginationTimeStamp                   *IE                                             // MillisecondTimeStamp instance 0, CO
+	MaximumWaitTime                        *IE                                             // IntegerNumber instance 0, CO
+	WLANLocationInformation                *IE                                             // TWANIdentifier instance 1, CO
+	WLANLocationTimestamp                  *IE                                             // TWANIdentifierTimestamp instance 0, CO
+	NBIFOMContainer                        *IE                                             // FContainer instance 0, CO
+	RemoteUEContextConnected               []*IE                                           // RemoteUEContext instance 0, CO, multiple
+	AAAServerIdentifier                    *IE                                             // NodeIdentifier instance 0, O
+	ExtendedProtocolConfigurationOptions   *IE                                             // ePCO instance 0, CO
+	ServingPLMNRateControl                 *TypedServingPLMNRateControl                    // ServingPLMNRateControl instance 0, CO
+	MOExceptionDataCounter                 *TypedCounter                                   // Counter instance 0, CO
+	UETCPPort                              *TypedPortNumber                                // PortNumber instance 2, CO
+	MappedUEUsageType                      *TypedMappedUEUsageType                         // MappedUEUsageType instance 0, CO
+	UserLocationInformationForSGW          *IE                                             // ULI instance 1, CO
+	SGWUNodeName                           *IE                                             // FQDN instance 0, CO
+	SecondaryRATUsageDataReports           []*TypedSecondaryRATUsageDataReport             // SecondaryRATUsageDataReport instance 0, CO, multiple
+	UPFunctionSelectionIndicationFlags     *TypedUPFunctionSelectionIndicationFlags        // UPFunctionSelectionIndicationFlags instance 0, CO
+	APNRateControlStatus                   *IE                                             // APNRateControlStatus instance 0, CO
+	UnknownIEs                             []*IE
+	decodedIEs
+}
+
+// MessageType returns CreateSessionRequest
+func (message *CreateSessionRequestMessage) MessageType() MessageType {
+	return CreateSessionRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *CreateSessionRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(CreateSessionRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type CreateSessionRequest
+func (message *CreateSessionRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, CreateSessionRequest, &message.Header, message)
+}
+
+// CreateSessionRequestBearerContextToBeCreated is the content of the BearerContextsToBeCreated grouped IE in CreateSessionRequest
+type CreateSessionRequestBearerContextToBeCreated struct {
+	EPSBearerID    *TypedEBI       // EBI instance 0, M
+	TFT            *IE             // BearerTFT instance 0, O
+	S1UeNodeBFTEID *TypedFTEID     // FTEID instance 0, C
+	S4USGSNFTEID   *TypedFTEID     // FTEID instance 1, C
+	S5S8USGWFTEID  *TypedFTEID     // FTEID instance 2, C
+	S5S8UPGWFTEID  *TypedFTEID     // FTEID instance 3, C
+	S12RNCFTEID    *TypedFTEID     // FTEID instance 4, CO
+	S2bUePDGFTEID  *TypedFTEID     // FTEID instance 5, C
+	S2aUTWANFTEID  *TypedFTEID     // FTEID instance 6, C
+	BearerLevelQoS *TypedBearerQoS // BearerQoS instance 0, M
+	S11UMMEFTEID   *TypedFTEID     // FTEID instance 7, CO
+	UnknownIEs     []*IE
+	decodedIEs
+}
+
+// CreateSessionRequestBearerContextToBeRemoved is the content of the BearerContextsToBeRemoved grouped IE in CreateSessionRequest
+type CreateSessionRequestBearerContextToBeRemoved struct {
+	EPSBearerID  *TypedEBI   // EBI instance 0, M
+	S4USGSNFTEID *TypedFTEID // FTEID instance 1, C
+	UnknownIEs   []*IE
+	decodedIEs
+}
+
+// CreateSessionResponseMessage is the typed message (see TypedMessage) for CreateSessionResponse
+type CreateSessionResponseMessage struct {
+	Header                                 MessageHeader
+	Cause                                  *TypedCause                                           // Cause instance 0, M
+	ChangeReportingAction                  *IE                                                   // ChangeReportingAction instance 0, C
+	CSGInformationReportingAction          *IE                                                   // CSGInformationReportingAction instance 0, CO
+	HeNBInformationReporting               *IE                                                   // HeNBInformationReporting instance 0, CO
+	SenderFTEIDForControlPlane             *TypedFTEID                                           // FTEID instance 0, C
+	PGWS5S8AddressForControlPlane          *TypedFTEID                                           // FTEID instance 1, C
+	PDNAddressAllocation                   *IE                                                   // PAA instance 0, C
+	APNRestriction                         *TypedAPNRestriction                                  // APNRestriction instance 0, C
+	APNAMBR                                *TypedAMBR                                            // AMBR instance 0, C
+	LinkedEPSBearerID                      *TypedEBI                                             // EBI instance 0, C
+	ProtocolConfigurationOptions           *IE                                                   // PCI instance 0, C
+	BearerContextsCreated                  []*CreateSessionResponseBearerContextCreated          // BearerContext instance 0, C, multiple
+	BearerContextsMarkedForRemoval         []*CreateSessionResponseBearerContextMarkedForRemoval // BearerContext instance 1, C, multiple
+	Recovery                               *TypedRecovery                                        // RecoveryRestartCounter instance 0, C
+	ChargingGatewayName                    *IE                                                   // FQDN instance 0, C
+	ChargingGatewayAddress                 *IE                                                   // IPAddress instance 0, C
+	PGWFQCSID                              *IE                                                   // FQCSID instance 0, C
+	SGWFQCSID                              *IE                                                   // FQCSID instance 1, C
+	SGWLDN                                 *IE                                                   // LDN instance 0, O
+	PGWLDN                                 *IE                                                   // LDN instance 1, O
+	PGWBackOffTime                         *TypedEPCTimer                                        // EPCTimer instance 0, O
+	AdditionalProtocolConfigurationOptions *IE                                                   // APCO instance 0, CO
+	TrustedWLANIPv4Parameters              *IE                                                   // IP4CP instance 0, CO
+	IndicationFlags                        *IE                                                   // Indication instance 0, CO
+	PresenceReportingAreaActions           []*IE                                                 // PresenceReportingAreaAction instance 0, CO, multiple
+	PGWNodeLevelLoadControlInformation     *IE                                                   // LoadControlInformation instance 0, O
+	PGWAPNLevelLoadControlInformation      *IE                                                   // LoadControlInformation instance 1, O
+	SGWNodeLevelLoadControlInformation     *IE                                                   // LoadControlInformation instance 2, O
+	PGWOverloadControlInformation          *IE                                                   // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation          *IE                                                   // OverloadControlInformation instance 1, O
+	NBIFOMContainer                        *IE                                                   // FContainer instance 0, CO
+	PDNConnectionChargingID                *TypedChargingID                                      // ChargingID instance 0, CO
+	ExtendedProtocolConfigurationOptions   *IE                                                   // ePCO instance 0, CO
+	UnknownIEs                             []*IE
+	decodedIEs
+}
+
+// MessageType returns CreateSessionResponse
+func (message *CreateSessionResponseMessage) MessageType() MessageType {
+	return CreateSessionResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *CreateSessionResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(CreateSessionResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type CreateSessionResponse
+func (message *CreateSessionResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, CreateSessionResponse, &message.Header, message)
+}
+
+// CreateSessionResponseBearerContextCreated is the content of the BearerContextsCreated grouped IE in CreateSessionResponse
+type CreateSessionResponseBearerContextCreated struct {
+	EPSBearerID    *TypedEBI        // EBI instance 0, M
+	Cause          *TypedCause      // Cause instance 0, M
+	S1USGWFTEID    *TypedFTEID      // FTEID instance 0, C
+	S4USGWFTEID    *TypedFTEID      // FTEID instance 1, C
+	S5S8UPGWFTEID  *TypedFTEID      // FTEID instance 2, C
+	S12SGWFTEID    *TypedFTEID      // FTEID instance 3, C
+	S2bUPGWFTEID   *TypedFTEID      // FTEID instance 4, C
+	S2aUPGWFTEID   *TypedFTEID      // FTEID instance 5, C
+	BearerLevelQoS *TypedBearerQoS  // BearerQoS instance 0, C
+	ChargingID     *TypedChargingID // ChargingID instance 0, C
+	BearerFlags    *IE              // BearerFlags instance 0, CO
+	S11USGWFTEID   *TypedFTEID      // FTEID instance 6, CO
+	UnknownIEs     []*IE
+	decodedIEs
+}
+
+// CreateSessionResponseBearerContextMarkedForRemoval is the content of the BearerContextsMarkedForRemoval grouped IE in CreateSessionResponse
+type CreateSessionResponseBearerContextMarkedForRemoval struct {
+	EPSBearerID *TypedEBI   // EBI instance 0, M
+	Cause       *TypedCause // Cause instance 0, M
+	UnknownIEs  []*IE
+	decodedIEs
+}
+
+// ModifyBearerRequestMessage is the typed message (see TypedMessage) for ModifyBearerRequest
+type ModifyBearerRequestMessage struct {
+	Header                                 MessageHeader
+	MEI                                    *TypedMEI                                       // MEI instance 0, C
+	UserLocationInformation                *IE                                             // ULI instance 0, C
+	ServingNetwork                         *TypedServingNetwork                            // ServingNetwork instance 0, CO
+	RATType                                *TypedRATType                                   // RATType instance 0, C
+	IndicationFlags                        *IE                                             // Indication instance 0, C
+	SenderFTEIDForControlPlane             *TypedFTEID                                     // FTEID instance 0, C
+	APNAMBR                                *TypedAMBR                                      // AMBR instance 0, C
+	DelayDownlinkPacketNotificationRequest *TypedDelayValue                                // DelayValue instance 0, C
+	BearerContextsToBeModified             []*ModifyBearerRequestBearerContextToBeModified // BearerContext instance 0, C, multiple
+	BearerContextsToBeRemoved              []*ModifyBearerRequestBearerContextToBeRemoved  // BearerContext instance 1, C, multiple
+	Recovery                               *TypedRecovery                                  // RecoveryRestartCounter instance 0, C
+	UETimeZone                             *TypedUETimeZone                                // UETimeZone instance 0, CO
+	MMEFQCSID                              *IE                                             // FQCSID instance 0, C
+	SGWFQCSID                              *IE                                             // FQCSID instance 1, C
+	UserCSGInformation                     *IE                                             // UCI instance 0, CO
+	UELocalIPAddress                       *IE                                             // IPAddress instance 0, CO
+	UEUDPPort                              *TypedPortNumber                                // PortNumber instance 0, CO
+	MMELDN                                 *IE                                             // LDN instance 0, O
+	SGWLDN                                 *IE                                             // LDN instance 1, O
+	HeNBLocalIPAddress                     *IE                                             // IPAddress instance 1, CO
+	HeNBUDPPort                            *TypedPortNumber                                // PortNumber instance 1, CO
+	MMEIdentifier                          *IE                                             // IPAddress instance 2, CO
+	CNOperatorSelectionEntity              *IE                                             // CNOperatorSelectionEntity instance 0, CO
+	PresenceReportingAreaInformation       *IE                                             // PresenceReportingAreaInformation instance 0, CO
+	MMEOverloadControlInformation          *IE                                             // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation          *IE                                             // OverloadControlInformation instance 1, O
+	EPDGOverloadControlInformation         *IE                                             // OverloadControlInformation instance 2, O
+	ServingPLMNRateControl                 *TypedServingPLMNRateControl                    // ServingPLMNRateControl instance 0, CO
+	MOExceptionDataCounter                 *TypedCounter                                   // Counter instance 0, CO
+	IMSI                                   *TypedIMSI                                      // IMSI instance 0, CO
+	UserLocationInformationForSGW          *IE                                             // ULI instance 1, CO
+	WLANLocationInformation                *IE                                             // TWANIdentifier instance 0, CO
+	WLANLocationTimestamp                  *IE                                             // TWANIdentifierTimestamp instance 0, CO
+	SecondaryRATUsageDataReports           []*TypedSecondaryRATUsageDataReport             // SecondaryRATUsageDataReport instance 0, CO, multiple
+	UnknownIEs                             []*IE
+	decodedIEs
+}
+
+// MessageType returns ModifyBearerRequest
+func (message *ModifyBearerRequestMessage) MessageType() MessageType {
+	return ModifyBearerRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ModifyBearerRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ModifyBearerRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ModifyBearerRequest
+func (message *ModifyBearerRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ModifyBearerRequest, &message.Header, message)
+}
+
+// ModifyBearerRequestBearerContextToBeModified is the content of the BearerContextsToBeModified grouped IE in ModifyBearerRequest
+type ModifyBearerRequestBearerContextToBeModified struct {
+	EPSBearerID    *TypedEBI   // EBI instance 0, M
+	S1UeNodeBFTEID *TypedFTEID // FTEID instance 0, C
+	S5S8USGWFTEID  *TypedFTEID // FTEID instance 1, C
+	S12RNCFTEID    *TypedFTEID // FTEID instance 2, C
+	S4USGSNFTEID   *TypedFTEID // FTEID instance 3, C
+	S11UMMEFTEID   *TypedFTEID // FTEID instance 4, CO
+	UnknownIEs     []*IE
+	decodedIEs
+}
+
+// ModifyBearerRequestBearerContextToBeRemoved is the content of the BearerContextsToBeRemoved grouped IE in ModifyBearerRequest
+type ModifyBearerRequestBearerContextToBeRemoved struct {
+	EPSBearerID *TypedEBI // EBI instance 0, M
+	UnknownIEs  []*IE
+	decodedIEs
+}
+
+// ModifyBearerResponseMessage is the typed message (see TypedMessage) for ModifyBearerResponse
+type ModifyBearerResponseMessage struct {
+	Header                             MessageHeader
+	Cause                              *TypedCause                                          // Cause instance 0, M
+	MSISDN                             *TypedMSISDN                                         // MSISDN instance 0, C
+	LinkedEPSBearerID                  *TypedEBI                                            // EBI instance 0, C
+	APNRestriction                     *TypedAPNRestriction                                 // APNRestriction instance 0, C
+	ProtocolConfigurationOptions       *IE                                                  // PCI instance 0, C
+	BearerContextsModified             []*ModifyBearerResponseBearerContextModified         // BearerContext instance 0, C, multiple
+	BearerContextsMarkedForRemoval     []*ModifyBearerResponseBearerContextMarkedForRemoval // BearerContext instance 1, C, multiple
+	ChangeReportingAction              *IE                                                  // ChangeReportingAction instance 0, C
+	CSGInformationReportingAction      *IE                                                  // CSGInformationReportingAction instance 0, CO
+	HeNBInformationReporting           *IE                                                  // HeNBInformationReporting instance 0, CO
+	ChargingGatewayName                *IE                                                  // FQDN instance 0, C
+	ChargingGatewayAddress             *IE                                                  // IPAddress instance 0, C
+	PGWFQCSID                          *IE                                                  // FQCSID instance 0, C
+	SGWFQCSID                          *IE                                                  // FQCSID instance 1, C
+	Recovery                           *TypedRecovery                                       // RecoveryRestartCounter instance 0, C
+	SGWLDN                             *IE                                                  // LDN instance 0, O
+	PGWLDN                             *IE                                                  // LDN instance 1, O
+	IndicationFlags                    *IE                                                  // Indication instance 0, CO
+	PresenceReportingAreaActions       []*IE                                                // PresenceReportingAreaAction instance 0, CO, multiple
+	PGWNodeLevelLoadControlInformation *IE                                                  // LoadControlInformation instance 0, O
+	PGWAPNLevelLoadControlInformation  *IE                                                  // LoadControlInformation instance 1, O
+	SGWNodeLevelLoadControlInformation *IE                                                  // LoadControlInformation instance 2, O
+	PGWOverloadControlInformation      *IE                                                  // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation      *IE                                                  // OverloadControlInformation instance 1, O
+	PDNConnectionChargingID            *TypedChargingID                                     // ChargingID instance 0, CO
+	UnknownIEs                         []*IE
+	decodedIEs
+}
+
+// MessageType returns ModifyBearerResponse
+func (message *ModifyBearerResponseMessage) MessageType() MessageType {
+	return ModifyBearerResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ModifyBearerResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ModifyBearerResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ModifyBearerResponse
+func (message *ModifyBearerResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ModifyBearerResponse, &message.Header, message)
+}
+
+// ModifyBearerResponseBearerContextModified is the content of the BearerContextsModified grouped IE in ModifyBearerResponse
+type ModifyBearerResponseBearerContextModified struct {
+	EPSBearerID  *TypedEBI        // EBI instance 0, M
+	Cause        *TypedCause      // Cause instance 0, M
+	S1USGWFTEID  *TypedFTEID      // FTEID instance 0, C
+	S12SGWFTEID  *TypedFTEID      // FTEID instance 1, C
+	S4USGWFTEID  *TypedFTEID      // FTEID instance 2, C
+	ChargingID   *TypedChargingID // ChargingID instance 0, CO
+	BearerFlags  *IE              // BearerFlags instance 0, CO
+	S11USGWFTEID *TypedFTEID      // FTEID instance 3, CO
+	UnknownIEs   []*IE
+	decodedIEs
+}
+
+// ModifyBearerResponseBearerContextMarkedForRemoval is the content of the BearerContextsMarkedForRemoval grouped IE in ModifyBearerResponse
+type ModifyBearerResponseBearerContextMarkedForRemoval struct {
+	EPSBearerID *TypedEBI   // EBI instance 0, M
+	Cause       *TypedCause // Cause instance 0, M
+	UnknownIEs  []*IE
+	decodedIEs
+}
+
+// DeleteSessionRequestMessage is the typed message (see TypedMessage) for DeleteSessionRequest
+type DeleteSessionRequestMessage struct {
+	Header                               MessageHeader
+	Cause                                *TypedCause                         // Cause instance 0, C
+	LinkedEPSBearerID                    *TypedEBI                           // EBI instance 0, C
+	UserLocationInformation              *IE                                 // ULI instance 0, C
+	IndicationFlags                      *IE                                 // Indication instance 0, C
+	ProtocolConfigurationOptions         *IE                                 // PCI instance 0, C
+	OriginatingNode                      *TypedNodeType                      // NodeType instance 0, C
+	SenderFTEIDForControlPlane           *TypedFTEID                         // FTEID instance 0, O
+	UETimeZone                           *TypedUETimeZone                    // UETimeZone instance 0, CO
+	ULITimestamp                         *IE                                 // ULITimestamp instance 0, O
+	RANNASReleaseCause                   *IE                                 // RANNASCause instance 0, CO
+	TWANIdentifier                       *IE                                 // TWANIdentifier instance 0, CO
+	TWANIdentifierTimestamp              *IE                                 // TWANIdentifierTimestamp instance 0, CO
+	MMEOverloadControlInformation        *IE                                 // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation        *IE                                 // OverloadControlInformation instance 1, O
+	TWANOverloadControlInformation       *IE                                 // OverloadControlInformation instance 2, O
+	WLANLocationInformation              *IE                                 // TWANIdentifier instance 1, CO
+	WLANLocationTimestamp                *IE                                 // TWANIdentifierTimestamp instance 1, CO
+	UELocalIPAddress                     *IE                                 // IPAddress instance 0, CO
+	UEUDPPort                            *TypedPortNumber                    // PortNumber instance 0, CO
+	ExtendedProtocolConfigurationOptions *IE                                 // ePCO instance 0, CO
+	UETCPPort                            *TypedPortNumber                    // PortNumber instance 1, CO
+	SecondaryRATUsageDataReports         []*TypedSecondaryRATUsageDataReport // SecondaryRATUsageDataReport instance 0, CO, multiple
+	UnknownIEs                           []*IE
+	decodedIEs
+}
+
+// MessageType returns DeleteSessionRequest
+func (message *DeleteSessionRequestMessage) MessageType() MessageType {
+	return DeleteSessionRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *DeleteSessionRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(DeleteSessionRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type DeleteSessionRequest
+func (message *DeleteSessionRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, DeleteSessionRequest, &message.Header, message)
+}
+
+// DeleteSessionResponseMessage is the typed message (see TypedMessage) for DeleteSessionResponse
+type DeleteSessionResponseMessage struct {
+	Header                               MessageHeader
+	Cause                                *TypedCause    // Cause instance 0, M
+	Recovery                             *TypedRecovery // RecoveryRestartCounter instance 0, C
+	ProtocolConfigurationOptions         *IE            // PCI instance 0, C
+	IndicationFlags                      *IE            // Indication instance 0, CO
+	PGWNodeLevelLoadControlInformation   *IE            // LoadControlInformation instance 0, O
+	PGWAPNLevelLoadControlInformation    *IE            // LoadControlInformation instance 1, O
+	SGWNodeLevelLoadControlInformation   *IE            // LoadControlInformation instance 2, O
+	PGWOverloadControlInformation        *IE            // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation        *IE            // OverloadControlInformation instance 1, O
+	ExtendedProtocolConfigurationOptions *IE            // ePCO instance 0, CO
+	UnknownIEs                           []*IE
+	decodedIEs
+}
+
+// MessageType returns DeleteSessionResponse
+func (message *DeleteSessionResponseMessage) MessageType() MessageType {
+	return DeleteSessionResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *DeleteSessionResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(DeleteSessionResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type DeleteSessionResponse
+func (message *DeleteSessionResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, DeleteSessionResponse, &message.Header, message)
+}
+
+// CreateBearerRequestMessage is the typed message (see TypedMessage) for CreateBearerRequest
+type CreateBearerRequestMessage struct {
+	Header                               MessageHeader
+	ProcedureTransactionID               *TypedProcedureTransactionID        // ProcedureTransactionID instance 0, C
+	LinkedEPSBearerID                    *TypedEBI                           // EBI instance 0, M
+	ProtocolConfigurationOptions         *IE                                 // PCI instance 0, O
+	BearerContexts                       []*CreateBearerRequestBearerContext // BearerContext instance 0, M, multiple
+	PGWFQCSID                            *IE                                 // FQCSID instance 0, C
+	SGWFQCSID                            *IE                                 // FQCSID instance 1, C
+	ChangeReportingAction                *IE                                 // ChangeReportingAction instance 0, C
+	CSGInformationReportingAction        *IE                                 // CSGInformationReportingAction instance 0, CO
+	HeNBInformationReporting             *IE                                 // HeNBInformationReporting instance 0, CO
+	PresenceReportingAreaActions         []*IE                               // PresenceReportingAreaAction instance 0, CO, multiple
+	IndicationFlags                      *IE                                 // Indication instance 0, CO
+	PGWNodeLevelLoadControlInformation   *IE                                 // LoadControlInformation instance 0, O
+	PGWAPNLevelLoadControlInformation    *IE                                 // LoadControlInformation instance 1, O
+	SGWNodeLevelLoadControlInformation   *IE                                 // LoadControlInformation instance 2, O
+	PGWOverloadControlInformation        *IE                                 // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation        *IE                                 // OverloadControlInformation instance 1, O
+	NBIFOMContainer                      *IE                                 // FContainer instance 0, CO
+	ExtendedProtocolConfigurationOptions *IE                                 // ePCO instance 0, O
+	UnknownIEs                           []*IE
+	decodedIEs
+}
+
+// MessageType returns CreateBearerRequest
+func (message *CreateBearerRequestMessage) MessageType() MessageType {
+	return CreateBearerRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *CreateBearerRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(CreateBearerRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type CreateBearerRequest
+func (message *CreateBearerRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, CreateBearerRequest, &message.Header, message)
+}
+
+// CreateBearerRequestBearerContext is the content of the BearerContexts grouped IE in CreateBearerRequest
+type CreateBearerRequestBearerContext struct {
+	EPSBearerID                          *TypedEBI                   // EBI instance 0, M
+	TFT                                  *IE                         // BearerTFT instance 0, M
+	S1USGWFTEID                          *TypedFTEID                 // FTEID instance 0, C
+	S5S8UPGWFTEID                        *TypedFTEID                 // FTEID instance 1, C
+	S12SGWFTEID                          *TypedFTEID                 // FTEID instance 2, C
+	S4USGWFTEID                          *TypedFTEID                 // FTEID instance 3, C
+	S2bUPGWFTEID                         *TypedFTEID                 // FTEID instance 4, C
+	S2aUPGWFTEID                         *TypedFTEID                 // FTEID instance 5, C
+	BearerLevelQoS                       *TypedBearerQoS             // BearerQoS instance 0, M
+	ChargingID                           *TypedChargingID            // ChargingID instance 0, O
+	BearerFlags                          *IE                         // BearerFlags instance 0, O
+	ProtocolConfigurationOptions         *IE                         // PCI instance 0, O
+	ExtendedProtocolConfigurationOptions *IE                         // ePCO instance 0, O
+	MaximumPacketLossRate                *TypedMaximumPacketLossRate // MaximumPacketLossRate instance 0, O
+	UnknownIEs                           []*IE
+	decodedIEs
+}
+
+// CreateBearerResponseMessage is the typed message (see TypedMessage) for CreateBearerResponse
+type CreateBearerResponseMessage struct {
+	Header                           MessageHeader
+	Cause                            *TypedCause                          // Cause instance 0, M
+	BearerContexts                   []*CreateBearerResponseBearerContext // BearerContext instance 0, M, multiple
+	Recovery                         *TypedRecovery                       // RecoveryRestartCounter instance 0, C
+	MMEFQCSID                        *IE                                  // FQCSID instance 0, C
+	SGWFQCSID                        *IE                                  // FQCSID instance 1, C
+	EPDGFQCSID                       *IE                                  // FQCSID instance 2, C
+	TWANFQCSID                       *IE                                  // FQCSID instance 3, C
+	ProtocolConfigurationOptions     *IE                                  // PCI instance 0, CO
+	UETimeZone                       *TypedUETimeZone                     // UETimeZone instance 0, CO
+	UserLocationInformation          *IE                                  // ULI instance 0, CO
+	TWANIdentifier                   *IE                                  // TWANIdentifier instance 0, CO
+	MMEOverloadControlInformation    *IE                                  // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation    *IE                                  // OverloadControlInformation instance 1, O
+	PresenceReportingAreaInformation *IE                                  // PresenceReportingAreaInformation instance 0, CO
+	MMEIdentifier                    *IE                                  // IPAddress instance 0, CO
+	TWANOverloadControlInformation   *IE                                  // OverloadControlInformation instance 2, O
+	WLANLocationInformation          *IE                                  // TWANIdentifier instance 1, CO
+	WLANLocationTimestamp            *IE                                  // TWANIdentifierTimestamp instance 0, CO
+	UELocalIPAddress                 *IE                                  // IPAddress instance 1, CO
+	UEUDPPort                        *TypedPortNumber                     // PortNumber instance 0, CO
+	NBIFOMContainer                  *IE                                  // FContainer instance 0, CO
+	UETCPPort                        *TypedPortNumber                     // PortNumber instance 1, CO
+	UnknownIEs                       []*IE
+	decodedIEs
+}
+
+// MessageType returns CreateBearerResponse
+func (message *CreateBearerResponseMessage) MessageType() MessageType {
+	return CreateBearerResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *CreateBearerResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(CreateBearerResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type CreateBearerResponse
+func (message *CreateBearerResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, CreateBearerResponse, &message.Header, message)
+}
+
+// CreateBearerResponseBearerContext is the content of the BearerContexts grouped IE in CreateBearerResponse
+type CreateBearerResponseBearerContext struct {
+	EPSBearerID                          *TypedEBI   // EBI instance 0, M
+	Cause                                *TypedCause // Cause instance 0, M
+	S1UeNodeBFTEID                       *TypedFTEID // FTEID instance 0, C
+	S1USGWFTEID                          *TypedFTEID // FTEID instance 1, C
+	S5S8USGWFTEID                        *TypedFTEID // FTEID instance 2, C
+	S5S8UPGWFTEID                        *TypedFTEID // FTEID instance 3, C
+	S12RNCFTEID                          *TypedFTEID // FTEID instance 4, C
+	S12SGWFTEID                          *TypedFTEID // FTEID instance 5, C
+	S4USGSNFTEID                         *TypedFTEID // FTEID instance 6, C
+	S4USGWFTEID                          *TypedFTEID // FTEID instance 7, C
+	S2bUePDGFTEID                        *TypedFTEID // FTEID instance 8, C
+	S2bUPGWFTEID                         *TypedFTEID // FTEID instance 9, C
+	S2aUTWANFTEID                        *TypedFTEID // FTEID instance 10, C
+	S2aUPGWFTEID                         *TypedFTEID // FTEID instance 11, C
+	ProtocolConfigurationOptions         *IE         // PCI instance 0, CO
+	RANNASCause                          *IE         // RANNASCause instance 0, CO
+	ExtendedProtocolConfigurationOptions *IE         // ePCO instance 0, CO
+	UnknownIEs                           []*IE
+	decodedIEs
+}
+
+// UpdateBearerRequestMessage is the typed message (see TypedMessage) for UpdateBearerRequest
+type UpdateBearerRequestMessage struct {
+	Header                               MessageHeader
+	BearerContexts                       []*UpdateBearerRequestBearerContext // BearerContext instance 0, M, multiple
+	ProcedureTransactionID               *TypedProcedureTransactionID        // ProcedureTransactionID instance 0, C
+	ProtocolConfigurationOptions         *IE                                 // PCI instance 0, O
+	APNAMBR                              *TypedAMBR                          // AMBR instance 0, M
+	ChangeReportingAction                *IE                                 // ChangeReportingAction instance 0, C
+	CSGInformationReportingAction        *IE                                 // CSGInformationReportingAction instance 0, CO
+	HeNBInformationReporting             *IE                                 // HeNBInformationReporting instance 0, CO
+	IndicationFlags                      *IE                                 // Indication instance 0, C
+	PGWFQCSID                            *IE                                 // FQCSID instance 0, C
+	SGWFQCSID                            *IE                                 // FQCSID instance 1, C
+	PresenceReportingAreaActions         []*IE                               // PresenceReportingAreaAction instance 0, CO, multiple
+	PGWNodeLevelLoadControlInformation   *IE                                 // LoadControlInformation instance 0, O
+	PGWAPNLevelLoadControlInformation    *IE                                 // LoadControlInformation instance 1, O
+	SGWNodeLevelLoadControlInformation   *IE                                 // LoadControlInformation instance 2, O
+	PGWOverloadControlInformation        *IE                                 // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation        *IE                                 // OverloadControlInformation instance 1, O
+	NBIFOMContainer                      *IE                                 // FContainer instance 0, CO
+	ExtendedProtocolConfigurationOptions *IE                                 // ePCO instance 0, O
+	UnknownIEs                           []*IE
+	decodedIEs
+}
+
+// MessageType returns UpdateBearerRequest
+func (message *UpdateBearerRequestMessage) MessageType() MessageType {
+	return UpdateBearerRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *UpdateBearerRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(UpdateBearerRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type UpdateBearerRequest
+func (message *UpdateBearerRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, UpdateBearerRequest, &message.Header, message)
+}
+
+// UpdateBearerRequestBearerContext is the content of the BearerContexts grouped IE in UpdateBearerRequest
+type UpdateBearerRequestBearerContext struct {
+	EPSBearerID                            *TypedEBI                   // EBI instance 0, M
+	TFT                                    *IE                         // BearerTFT instance 0, C
+	BearerLevelQoS                         *TypedBearerQoS             // BearerQoS instance 0, C
+	BearerFlags                            *IE                         // BearerFlags instance 0, O
+	ProtocolConfigurationOptions           *IE                         // PCI instance 0, O
+	AdditionalProtocolConfigurationOptions *IE                         // APCO instance 0, O
+	ExtendedProtocolConfigurationOptions   *IE                         // ePCO instance 0, O
+	MaximumPacketLossRate                  *TypedMaximumPacketLossRate // MaximumPacketLossRate instance 0, O
+	UnknownIEs                             []*IE
+	decodedIEs
+}
+
+// UpdateBearerResponseMessage is the typed message (see TypedMessage) for UpdateBearerResponse
+type UpdateBearerResponseMessage struct {
+	Header                           MessageHeader
+	Cause                            *TypedCause                          // Cause instance 0, M
+	BearerContexts                   []*UpdateBearerResponseBearerContext // BearerContext instance 0, M, multiple
+	ProtocolConfigurationOptions     *IE                                  // PCI instance 0, CO
+	Recovery                         *TypedRecovery                       // RecoveryRestartCounter instance 0, C
+	MMEFQCSID                        *IE                                  // FQCSID instance 0, C
+	SGWFQCSID                        *IE                                  // FQCSID instance 1, C
+	EPDGFQCSID                       *IE                                  // FQCSID instance 2, C
+	TWANFQCSID                       *IE                                  // FQCSID instance 3, C
+	IndicationFlags                  *IE                                  // Indication instance 0, C
+	UETimeZone                       *TypedUETimeZone                     // UETimeZone instance 0, CO
+	UserLocationInformation          *IE                                  // ULI instance 0, CO
+	TWANIdentifier                   *IE                                  // TWANIdentifier instance 0, CO
+	MMEOverloadControlInformation    *IE                                  // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation    *IE                                  // OverloadControlInformation instance 1, O
+	PresenceReportingAreaInformation *IE                                  // PresenceReportingAreaInformation instance 0, CO
+	MMEIdentifier                    *IE                                  // IPAddress instance 0, CO
+	TWANOverloadControlInformation   *IE                                  // OverloadControlInformation instance 2, O
+	WLANLocationInformation          *IE                                  // TWANIdentifier instance 1, CO
+	WLANLocationTimestamp            *IE                                  // TWANIdentifierTimestamp instance 0, CO
+	UELocalIPAddress                 *IE                                  // IPAddress instance 1, CO
+	UEUDPPort                        *TypedPortNumber                     // PortNumber instance 0, CO
+	NBIFOMContainer                  *IE                                  // FContainer instance 0, CO
+	UETCPPort                        *TypedPortNumber                     // PortNumber instance 1, CO
+	UnknownIEs                       []*IE
+	decodedIEs
+}
+
+// MessageType returns UpdateBearerResponse
+func (message *UpdateBearerResponseMessage) MessageType() MessageType {
+	return UpdateBearerResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *UpdateBearerResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(UpdateBearerResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type UpdateBearerResponse
+func (message *UpdateBearerResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, UpdateBearerResponse, &message.Header, message)
+}
+
+// UpdateBearerResponseBearerContext is the content of the BearerContexts grouped IE in UpdateBearerResponse
+type UpdateBearerResponseBearerContext struct {
+	EPSBearerID                          *TypedEBI   // EBI instance 0, M
+	Cause                                *TypedCause // Cause instance 0, M
+	S4USGSNFTEID                         *TypedFTEID // FTEID instance 0, C
+	S12RNCFTEID                          *TypedFTEID // FTEID instance 1, C
+	ProtocolConfigurationOptions         *IE         // PCI instance 0, CO
+	RANNASCause                          *IE         // RANNASCause instance 0, CO
+	ExtendedProtocolConfigurationOptions *IE         // ePCO instance 0, CO
+	UnknownIEs                           []*IE
+	decodedIEs
+}
+
+// DeleteBearerRequestMessage is the typed message (see TypedMessage) for DeleteBearerRequest
+type DeleteBearerRequestMessage struct {
+	Header                               MessageHeader
+	LinkedEPSBearerID                    *TypedEBI                                 // EBI instance 0, C
+	EPSBearerIDs                         []*TypedEBI                               // EBI instance 1, C, multiple
+	FailedBearerContexts                 []*DeleteBearerRequestFailedBearerContext // BearerContext instance 0, O, multiple
+	ProcedureTransactionID               *TypedProcedureTransactionID              // ProcedureTransactionID instance 0, C
+	ProtocolConfigurationOptions         *IE                                       // PCI instance 0, C
+	PGWFQCSID                            *IE                                       // FQCSID instance 0, C
+	SGWFQCSID                            *IE                                       // FQCSID instance 1, C
+	Cause                                *TypedCause                               // Cause instance 0, C
+	IndicationFlags                      *IE                                       // Indication instance 0, CO
+	PGWNodeLevelLoadControlInformation   *IE                                       // LoadControlInformation instance 0, O
+	PGWAPNLevelLoadControlInformation    *IE                                       // LoadControlInformation instance 1, O
+	SGWNodeLevelLoadControlInformation   *IE                                       // LoadControlInformation instance 2, O
+	PGWOverloadControlInformation        *IE                                       // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation        *IE                                       // OverloadControlInformation instance 1, O
+	NBIFOMContainer                      *IE                                       // FContainer instance 0, CO
+	APNRateControlStatus                 *IE                                       // APNRateControlStatus instance 0, CO
+	ExtendedProtocolConfigurationOptions *IE                                       // ePCO instance 0, CO
+	UnknownIEs                           []*IE
+	decodedIEs
+}
+
+// MessageType returns DeleteBearerRequest
+func (message *DeleteBearerRequestMessage) MessageType() MessageType {
+	return DeleteBearerRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *DeleteBearerRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(DeleteBearerRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type DeleteBearerRequest
+func (message *DeleteBearerRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, DeleteBearerRequest, &message.Header, message)
+}
+
+// DeleteBearerRequestFailedBearerContext is the content of the FailedBearerContexts grouped IE in DeleteBearerRequest
+type DeleteBearerRequestFailedBearerContext struct {
+	EPSBearerID *TypedEBI   // EBI instance 0, M
+	Cause       *TypedCause // Cause instance 0, M
+	UnknownIEs  []*IE
+	decodedIEs
+}
+
+// DeleteBearerResponseMessage is the typed message (see TypedMessage) for DeleteBearerResponse
+type DeleteBearerResponseMessage struct {
+	Header                         MessageHeader
+	Cause                          *TypedCause                          // Cause instance 0, M
+	LinkedEPSBearerID              *TypedEBI                            // EBI instance 0, C
+	BearerContexts                 []*DeleteBearerResponseBearerContext // BearerContext instance 0, C, multiple
+	Recovery                       *TypedRecovery                       // RecoveryRestartCounter instance 0, C
+	MMEFQCSID                      *IE                                  // FQCSID instance 0, C
+	SGWFQCSID                      *IE                                  // FQCSID instance 1, C
+	EPDGFQCSID                     *IE                                  // FQCSID instance 2, C
+	TWANFQCSID                     *IE                                  // FQCSID instance 3, C
+	ProtocolConfigurationOptions   *IE                                  // PCI instance 0, CO
+	UETimeZone                     *TypedUETimeZone                     // UETimeZone instance 0, CO
+	UserLocationInformation        *IE                                  // ULI instance 0, CO
+	ULITimestamp                   *IE                                  // ULITimestamp instance 0, CO
+	TWANIdentifier                 *IE                                  // TWANIdentifier instance 0, CO
+	TWANIdentifierTimestamp        *IE                                  // TWANIdentifierTimestamp instance 0, CO
+	MMEOverloadControlInformation  *IE                                  // OverloadControlInformation instance 0, O
+	SGWOverloadControlInformation  *IE                                  // OverloadControlInformation instance 1, O
+	MMEIdentifier                  *IE                                  // IPAddress instance 0, CO
+	TWANOverloadControlInformation *IE                                  // OverloadControlInformation instance 2, O
+	WLANLocationInformation        *IE                                  // TWANIdentifier instance 1, CO
+	WLANLocationTimestamp          *IE                                  // TWANIdentifierTimestamp instance 1, CO
+	UELocalIPAddress               *IE                                  // IPAddress instance 1, CO
+	UEUDPPort                      *TypedPortNumber                     // PortNumber instance 0, CO
+	NBIFOMContainer                *IE                                  // FContainer instance 0, CO
+	UETCPPort                      *TypedPortNumber                     // PortNumber instance 1, CO
+	SecondaryRATUsageDataReports   []*TypedSecondaryRATUsageDataReport  // SecondaryRATUsageDataReport instance 0, CO, multiple
+	UnknownIEs                     []*IE
+	decodedIEs
+}
+
+// MessageType returns DeleteBearerResponse
+func (message *DeleteBearerResponseMessage) MessageType() MessageType {
+	return DeleteBearerResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *DeleteBearerResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(DeleteBearerResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type DeleteBearerResponse
+func (message *DeleteBearerResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, DeleteBearerResponse, &message.Header, message)
+}
+
+// DeleteBearerResponseBearerContext is the content of the BearerContexts grouped IE in DeleteBearerResponse
+type DeleteBearerResponseBearerContext struct {
+	EPSBearerID                          *TypedEBI   // EBI instance 0, M
+	Cause                                *TypedCause // Cause instance 0, M
+	ProtocolConfigurationOptions         *IE         // PCI instance 0, CO
+	RANNASCause                          *IE         // RANNASCause instance 0, CO
+	ExtendedProtocolConfigurationOptions *IE         // ePCO instance 0, CO
+	UnknownIEs                           []*IE
+	decodedIEs
+}
+
+// ReleaseAccessBearersRequestMessage is the typed message (see TypedMessage) for ReleaseAccessBearersRequest
+type ReleaseAccessBearersRequestMessage struct {
+	Header                       MessageHeader
+	ListOfRABs                   []*TypedEBI                         // EBI instance 0, O, multiple
+	OriginatingNode              *TypedNodeType                      // NodeType instance 0, CO
+	IndicationFlags              *IE                                 // Indication instance 0, CO
+	SecondaryRATUsageDataReports []*TypedSecondaryRATUsageDataReport // SecondaryRATUsageDataReport instance 0, CO, multiple
+	UnknownIEs                   []*IE
+	decodedIEs
+}
+
+// MessageType returns ReleaseAccessBearersRequest
+func (message *ReleaseAccessBearersRequestMessage) MessageType() MessageType {
+	return ReleaseAccessBearersRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ReleaseAccessBearersRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ReleaseAccessBearersRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ReleaseAccessBearersRequest
+func (message *ReleaseAccessBearersRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ReleaseAccessBearersRequest, &message.Header, message)
+}
+
+// ReleaseAccessBearersResponseMessage is the typed message (see TypedMessage) for ReleaseAccessBearersResponse
+type ReleaseAccessBearersResponseMessage struct {
+	Header                             MessageHeader
+	Cause                              *TypedCause    // Cause instance 0, M
+	Recovery                           *TypedRecovery // RecoveryRestartCounter instance 0, O
+	IndicationFlags                    *IE            // Indication instance 0, CO
+	SGWNodeLevelLoadControlInformation *IE            // LoadControlInformation instance 0, O
+	SGWOverloadControlInformation      *IE            // OverloadControlInformation instance 0, O
+	UnknownIEs                         []*IE
+	decodedIEs
+}
+
+// MessageType returns ReleaseAccessBearersResponse
+func (message *ReleaseAccessBearersResponseMessage) MessageType() MessageType {
+	return ReleaseAccessBearersResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ReleaseAccessBearersResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ReleaseAccessBearersResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ReleaseAccessBearersResponse
+func (message *ReleaseAccessBearersResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ReleaseAccessBearersResponse, &message.Header, message)
+}
+
+// DownlinkDataNotificationMessage is the typed message (see TypedMessage) for DownlinkDataNotification
+type DownlinkDataNotificationMessage struct {
+	Header                             MessageHeader
+	Cause                              *TypedCause // Cause instance 0, CO
+	EPSBearerID                        *TypedEBI   // EBI instance 0, CO
+	AllocationRetentionPriority        *TypedARP   // ARP instance 0, CO
+	IMSI                               *TypedIMSI  // IMSI instance 0, CO
+	SenderFTEIDForControlPlane         *TypedFTEID // FTEID instance 0, CO
+	IndicationFlags                    *IE         // Indication instance 0, CO
+	SGWNodeLevelLoadControlInformation *IE         // LoadControlInformation instance 0, O
+	SGWOverloadControlInformation      *IE         // OverloadControlInformation instance 0, O
+	PagingAndServiceInformation        []*IE       // PagingandServiceInformation instance 0, CO, multiple
+	DLDataPacketsSize                  *IE         // IntegerNumber instance 0, CO
+	UnknownIEs                         []*IE
+	decodedIEs
+}
+
+// MessageType returns DownlinkDataNotification
+func (message *DownlinkDataNotificationMessage) MessageType() MessageType {
+	return DownlinkDataNotification
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *DownlinkDataNotificationMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(DownlinkDataNotification, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type DownlinkDataNotification
+func (message *DownlinkDataNotificationMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, DownlinkDataNotification, &message.Header, message)
+}
+
+// DownlinkDataNotificationAcknowledgeMessage is the typed message (see TypedMessage) for DownlinkDataNotificationAcknowledge
+type DownlinkDataNotificationAcknowledgeMessage struct {
+	Header                          MessageHeader
+	Cause                           *TypedCause      // Cause instance 0, M
+	DataNotificationDelay           *TypedDelayValue // DelayValue instance 0, C
+	Recovery                        *TypedRecovery   // RecoveryRestartCounter instance 0, O
+	DLLowPriorityTrafficThrottling  *TypedThrottling // Throttling instance 0, CO
+	IMSI                            *TypedIMSI       // IMSI instance 0, CO
+	DLBufferingDuration             *TypedEPCTimer   // EPCTimer instance 0, CO
+	DLBufferingSuggestedPacketCount *IE              // IntegerNumber instance 0, CO
+	UnknownIEs                      []*IE
+	decodedIEs
+}
+
+// MessageType returns DownlinkDataNotificationAcknowledge
+func (message *DownlinkDataNotificationAcknowledgeMessage) MessageType() MessageType {
+	return DownlinkDataNotificationAcknowledge
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *DownlinkDataNotificationAcknowledgeMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(DownlinkDataNotificationAcknowledge, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type DownlinkDataNotificationAcknowledge
+func (message *DownlinkDataNotificationAcknowledgeMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, DownlinkDataNotificationAcknowledge, &message.Header, message)
+}
+
+// IdentificationRequestMessage is the typed message (see TypedMessage) for IdentificationRequest
+type IdentificationRequestMessage struct {
+	Header                       MessageHeader
+	GUTI                         *IE                  // GUTI instance 0, C
+	RoutingAreaIdentity          *IE                  // ULI instance 0, C
+	PTMSI                        *IE                  // PTMSI instance 0, C
+	PTMSISignature               *IE                  // PTMSISignature instance 0, C
+	CompleteAttachRequestMessage *IE                  // CompleteRequestMessage instance 0, C
+	AddressForControlPlane       *IE                  // IPAddress instance 0, O
+	UDPSourcePortNumber          *TypedPortNumber     // PortNumber instance 0, CO
+	HopCounter                   *TypedHopCounter     // HopCounter instance 0, O
+	TargetPLMNID                 *TypedServingNetwork // ServingNetwork instance 0, CO
+	MMESGSNLDN                   *IE                  // LDN instance 0, O
+	UnknownIEs                   []*IE
+	decodedIEs
+}
+
+// MessageType returns IdentificationRequest
+func (message *IdentificationRequestMessage) MessageType() MessageType {
+	return IdentificationRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *IdentificationRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(IdentificationRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type IdentificationRequest
+func (message *IdentificationRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, IdentificationRequest, &message.Header, message)
+}
+
+// IdentificationResponseMessage is the typed message (see TypedMessage) for IdentificationResponse
+type IdentificationResponseMessage struct {
+	Header                                               MessageHeader
+	Cause                                                *TypedCause // Cause instance 0, M
+	IMSI                                                 *TypedIMSI  // IMSI instance 0, C
+	MMContextGSMKeyAndTriplets                           *IE         // MMContextGSMKeyandTriplets instance 0, C
+	MMContextUMTSKeyUsedCipherAndQuintuplets             *IE         // MMContextUMTSKeyUsedCipherandQuintuplets instance 0, C
+	MMContextGSMKeyUsedCipherAndQuintuplets              *IE         // MMContextGSMKeyUsedCipherandQuintuplets instance 0, C
+	MMContextUMTSKeyAndQuintuplets                       *IE         // MMContextUMTSKeyandQuintuplets instance 0, C
+	MMContextEPSSecurityContextQuadrupletsAndQuintuplets *IE         // MMContextEPSSecurityContextQuadrupletsandQuintuplets instance 0, C
+	MMContextUMTSKeyQuadrupletsAndQuintuplets            *IE         // MMContextUMTSKeyQuadrupletsandQuintuplets instance 0, C
+	TraceInformation                                     *IE         // TraceInformation instance 0, CO
+	UEUsageType                                          *IE         // IntegerNumber instance 0, CO
+	MonitoringEventInformation                           []*IE       // MonitoringEventInformation instance 0, CO, multiple
+	MMESGSNLDN                                           *IE         // LDN instance 0, O
+	ExtendedTraceInformation                             *IE         // ExtendedTraceInformation instance 0, CO
+	UnknownIEs                                           []*IE
+	decodedIEs
+}
+
+// MessageType returns IdentificationResponse
+func (message *IdentificationResponseMessage) MessageType() MessageType {
+	return IdentificationResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *IdentificationResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(IdentificationResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type IdentificationResponse
+func (message *IdentificationResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, IdentificationResponse, &message.Header, message)
+}
+
+// ContextRequestMessage is the typed message (see TypedMessage) for ContextRequest
+type ContextRequestMessage struct {
+	Header                             MessageHeader
+	IMSI                               *TypedIMSI           // IMSI instance 0, C
+	GUTI                               *IE                  // GUTI instance 0, C
+	RoutingAreaIdentity                *IE                  // ULI instance 0, C
+	PTMSI                              *IE                  // PTMSI instance 0, C
+	PTMSISignature                     *IE                  // PTMSISignature instance 0, C
+	CompleteTAURequestMessage          *IE                  // CompleteRequestMessage instance 0, C
+	AddressAndTEIDForControlPlane      *TypedFTEID          // FTEID instance 0, C
+	UDPSourcePortNumber                *TypedPortNumber     // PortNumber instance 0, C
+	RATType                            *TypedRATType        // RATType instance 0, C
+	IndicationFlags                    *IE                  // Indication instance 0, CO
+	HopCounter                         *TypedHopCounter     // HopCounter instance 0, O
+	TargetPLMNID                       *TypedServingNetwork // ServingNetwork instance 0, CO
+	MMESGSNLDN                         *IE                  // LDN instance 0, O
+	SGSNNodeName                       *IE                  // FQDN instance 0, O
+	MMENodeName                        *IE                  // FQDN instance 1, O
+	SGSNNumber                         *IE                  // NodeNumber instance 0, O
+	SGSNIdentifier                     *IE                  // NodeIdentifier instance 0, O
+	MMEIdentifier                      *IE                  // NodeIdentifier instance 1, O
+	CIoTOptimizationsSupportIndication *IE                  // CIoTOptimizationsSupportIndication instance 0, CO
+	UnknownIEs                         []*IE
+	decodedIEs
+}
+
+// MessageType returns ContextRequest
+func (message *ContextRequestMessage) MessageType() MessageType {
+	return ContextRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ContextRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ContextRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ContextRequest
+func (message *ContextRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ContextRequest, &message.Header, message)
+}
+
+// ContextResponseMessage is the typed message (see TypedMessage) for ContextResponse
+type ContextResponseMessage struct {
+	Header                                               MessageHeader
+	Cause                                                *TypedCause                     // Cause instance 0, M
+	IMSI                                                 *TypedIMSI                      // IMSI instance 0, C
+	MMContextGSMKeyAndTriplets                           *IE                             // MMContextGSMKeyandTriplets instance 0, C
+	MMContextUMTSKeyUsedCipherAndQuintuplets             *IE                             // MMContextUMTSKeyUsedCipherandQuintuplets instance 0, C
+	MMContextGSMKeyUsedCipherAndQuintuplets              *IE                             // MMContextGSMKeyUsedCipherandQuintuplets instance 0, C
+	MMContextUMTSKeyAndQuintuplets                       *IE                             // MMContextUMTSKeyandQuintuplets instance 0, C
+	MMContextEPSSecurityContextQuadrupletsAndQuintuplets *IE                             // MMContextEPSSecurityContextQuadrupletsandQuintuplets instance 0, C
+	MMContextUMTSKeyQuadrupletsAndQuintuplets            *IE                             // MMContextUMTSKeyQuadrupletsandQuintuplets instance 0, C
+	MMESGSNUEEPSPDNConnections                           []*ContextResponsePDNConnection // PDNConnection instance 0, C, multiple
+	SenderFTEIDForControlPlane                           *TypedFTEID                     // FTEID instance 0, C
+	SGWS11S4IPAddressAndTEIDForControlPlane              *TypedFTEID                     // FTEID instance 1, C
+	SGWNodeName                                          *IE                             // FQDN instance 0, C
+	IndicationFlags                                      *IE                             // Indication instance 0, C
+	TraceInformation                                     *IE                             // TraceInformation instance 0, CO
+	HRPDAccessNodeS101IPAddress                          *IE                             // IPAddress instance 0, CO
+	OneXIWSS102IPAddress                                 *IE                             // IPAddress instance 1, CO
+	SubscribedRFSPIndex                                  *TypedRFSPIndex                 // RFSPIndex instance 0, CO
+	RFSPIndexInUse                                       *TypedRFSPIndex                 // RFSPIndex instance 1, CO
+	UETimeZone                                           *TypedUETimeZone                // UETimeZone instance 0, CO
+	MMESGSNLDN                                           *IE                             // LDN instance 0, O
+	MDTConfiguration                                     *IE                             // MDTConfiguration instance 0, CO
+	SGSNNodeName                                         *IE                             // FQDN instance 1, CO
+	MMENodeName                                          *IE                             // FQDN instance 2, CO
+	UserCSGInformation                                   *IE                             // UCI instance 0, CO
+	MonitoringEventInformation                           []*IE                           // MonitoringEventInformation instance 0, CO, multiple
+	UEUsageType                                          *IE                             // IntegerNumber instance 0, CO
+	MMESGSNUESCEFPDNConnections                          []*IE                           // SCEFPDNConnection instance 0, CO, multiple
+	RATType                                              *TypedRATType                   // RATType instance 0, CO
+	ServingPLMNRateControl                               *TypedServingPLMNRateControl    // ServingPLMNRateControl instance 0, CO
+	MOExceptionDataCounter                               *TypedCounter                   // Counter instance 0, CO
+	RemainingRunningServiceGapTimer                      *IE                             // IntegerNumber instance 1, CO
+	ExtendedTraceInformation                             *IE                             // ExtendedTraceInformation instance 0, CO
+	UnknownIEs                                           []*IE
+	decodedIEs
+}
+
+// MessageType returns ContextResponse
+func (message *ContextResponseMessage) MessageType() MessageType {
+	return ContextResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ContextResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ContextResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ContextResponse
+func (message *ContextResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ContextResponse, &message.Header, message)
+}
+
+// ContextResponsePDNConnection is the content of the MMESGSNUEEPSPDNConnections grouped IE in ContextResponse
+type ContextResponsePDNConnection struct {
+	APN                             *IE                             // APN instance 0, M
+	APNRestriction                  *TypedAPNRestriction            // APNRestriction instance 0, C
+	SelectionMode                   *TypedSelectionMode             // SelectionMode instance 0, CO
+	IPv4Address                     *IE                             // IPAddress instance 0, C
+	IPv6Address                     *IE                             // IPAddress instance 1, C
+	LinkedEPSBearerID               *TypedEBI                       // EBI instance 0, M
+	PGWS5S8IPAddressForControlPlane *TypedFTEID                     // FTEID instance 0, M
+	PGWNodeName                     *IE                             // FQDN instance 0, C
+	BearerContexts                  []*ContextResponseBearerContext // BearerContext instance 0, C, multiple
+	AggregateMaximumBitRate         *TypedAMBR                      // AMBR instance 0, M
+	ChargingCharacteristics         *TypedChargingCharacteristics   // ChargingCharacteristics instance 0, C
+	ChangeReportingAction           *IE                             // ChangeReportingAction instance 0, C
+	CSGInformationReportingAction   *IE                             // CSGInformationReportingAction instance 0, CO
+	HeNBInformationReporting        *IE                             // HeNBInformationReporting instance 0, CO
+	IndicationFlags                 *IE                             // Indication instance 0, CO
+	SignallingPriorityIndication    *IE                             // SignallingPriorityIndication instance 0, CO
+	ChangeToReportFlags             *IE                             // ChangetoReportFlags instance 0, CO
+	LocalHomeNetworkID              *IE                             // FQDN instance 1, CO
+	WLANOffloadabilityIndication    *IE                             // WLANOffloadabilityIndication instance 0, CO
+	HeaderCompressionConfiguration  *IE                             // HeaderCompressionConfiguration instance 0, CO
+	UnknownIEs                      []*IE
+	decodedIEs
+}
+
+// ContextResponseBearerContext is the content of the BearerContexts grouped IE in ContextResponse
+type ContextResponseBearerContext struct {
+	EPSBearerID                            *TypedEBI       // EBI instance 0, M
+	TFT                                    *IE             // BearerTFT instance 0, C
+	SGWS1S4S12IPAddressAndTEIDForUserPlane *TypedFTEID     // FTEID instance 0, C
+	PGWS5S8IPAddressAndTEIDForUserPlane    *TypedFTEID     // FTEID instance 1, C
+	BearerLevelQoS                         *TypedBearerQoS // BearerQoS instance 0, M
+	BSSContainer                           *IE             // FContainer instance 0, CO
+	TransactionIdentifier                  *IE             // TI instance 0, C
+	SGWS11IPAddressAndTEIDForUserPlane     *TypedFTEID     // FTEID instance 2, CO
+	UnknownIEs                             []*IE
+	decodedIEs
+}
+
+// ContextAcknowledgeMessage is the typed message (see TypedMessage) for ContextAcknowledge
+type ContextAcknowledgeMessage struct {
+	Header                 MessageHeader
+	Cause                  *TypedCause                        // Cause instance 0, M
+	IndicationFlags        *IE                                // Indication instance 0, C
+	ForwardingFTEID        *TypedFTEID                        // FTEID instance 0, CO
+	BearerContexts         []*ContextAcknowledgeBearerContext // BearerContext instance 0, CO, multiple
+	SGSNNumber             *IE                                // NodeNumber instance 0, CO
+	MMENumberForMTSMS      *IE                                // NodeNumber instance 1, CO
+	SGSNIdentifierForMTSMS *IE                                // NodeIdentifier instance 0, CO
+	MMEIdentifierForMTSMS  *IE                                // NodeIdentifier instance 1, CO
+	UnknownIEs             []*IE
+	decodedIEs
+}
+
+// MessageType returns ContextAcknowledge
+func (message *ContextAcknowledgeMessage) MessageType() MessageType {
+	return ContextAcknowledge
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ContextAcknowledgeMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ContextAcknowledge, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ContextAcknowledge
+func (message *ContextAcknowledgeMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ContextAcknowledge, &message.Header, message)
+}
+
+// ContextAcknowledgeBearerContext is the content of the BearerContexts grouped IE in ContextAcknowledge
+type ContextAcknowledgeBearerContext struct {
+	EPSBearerID     *TypedEBI   // EBI instance 0, M
+	ForwardingFTEID *TypedFTEID // FTEID instance 0, M
+	UnknownIEs      []*IE
+	decodedIEs
+}
+
+// ForwardRelocationRequestMessage is the typed message (see TypedMessage) for ForwardRelocationRequest
+type ForwardRelocationRequestMessage struct {
+	Header                                               MessageHeader
+	IMSI                                                 *TypedIMSI                               // IMSI instance 0, C
+	SenderFTEIDForControlPlane                           *TypedFTEID                              // FTEID instance 0, M
+	MMESGSNUEEPSPDNConnections                           []*ForwardRelocationRequestPDNConnection // PDNConnection instance 0, M, multiple
+	SGWS11S4IPAddressAndTEIDForControlPlane              *TypedFTEID                              // FTEID instance 1, M
+	SGWNodeName                                          *IE                                      // FQDN instance 0, C
+	MMContextGSMKeyAndTriplets                           *IE                                      // MMContextGSMKeyandTriplets instance 0, C
+	MMContextUMTSKeyUsedCipherAndQuintuplets             *IE                                      // MMContextUMTSKeyUsedCipherandQuintuplets instance 0, C
+	MMContextGSMKeyUsedCipherAndQuintuplets              *IE                                      // MMContextGSMKeyUsedCipherandQuintuplets instance 0, C
+	MMContextUMTSKeyAndQuintuplets                       *IE                                      // MMContextUMTSKeyandQuintuplets instance 0, C
+	MMContextEPSSecurityContextQuadrupletsAndQuintuplets *IE                                      // MMContextEPSSecurityContextQuadrupletsandQuintuplets instance 0, C
+	MMContextUMTSKeyQuadrupletsAndQuintuplets            *IE                                      // MMContextUMTSKeyQuadrupletsandQuintuplets instance 0, C
+	IndicationFlags                                      *IE                                      // Indication instance 0, C
+	EUTRANTransparentContainer                           *IE                                      // FContainer instance 0, C
+	UTRANTransparentContainer                            *IE                                      // FContainer instance 1, C
+	BSSContainer                                         *IE                                      // FContainer instance 2, C
+	TargetIdentification                                 *IE                                      // TargetIdentification instance 0, C
+	HRPDAccessNodeS101IPAddress                          *IE                                      // IPAddress instance 0, C
+	OneXIWSS102IPAddress                                 *IE                                      // IPAddress instance 1, C
+	S1APCause                                            *IE                                      // FCause instance 0, C
+	RANAPCause                                           *IE                                      // FCause instance 1, C
+	BSSGPCause                                           *IE                                      // FCause instance 2, C
+	SourceIdentification                                 *IE                                      // SourceIdentification instance 0, C
+	SelectedPLMNID                                       *IE                                      // PLMNID instance 0, C
+	Recovery                                             *TypedRecovery                           // RecoveryRestartCounter instance 0, C
+	TraceInformation                                     *IE                                      // TraceInformation instance 0, C
+	SubscribedRFSPIndex                                  *TypedRFSPIndex                          // RFSPIndex instance 0, CO
+	RFSPIndexInUse                                       *TypedRFSPIndex                          // RFSPIndex instance 1, CO
+	CSGID                                                *TypedCSGID                              // CSGID instance 0, C
+	CSGMembershipIndication                              *IE                                      // CMI instance 0, C
+	UETimeZone                                           *TypedUETimeZone                         // UETimeZone instance 0, CO
+	ServingNetwork                                       *TypedServingNetwork                     // ServingNetwork instance 0, CO
+	MMESGSNLDN                                           *IE                                      // LDN instance 0, O
+	AdditionalMMContextForSRVCC                          *IE                                      // AdditionalMMcontextforSRVCC instance 0, CO
+	AdditionalFlagsForSRVCC                              *IE                                      // AdditionalflagsforSRVCC instance 0, CO
+	STNSR                                                *IE                                      // STNSR instance 0, CO
+	CMSISDN                                              *TypedMSISDN                             // MSISDN instance 0, CO
+	MDTConfiguration                                     *IE                                      // MDTConfiguration instance 0, CO
+	SGSNNodeName                                         *IE                                      // FQDN instance 1, CO
+	MMENodeName                                          *IE                                      // FQDN instance 2, CO
+	UserCSGInformation                                   *IE                                      // UCI instance 0, CO
+	MonitoringEventInformation                           []*IE                                    // MonitoringEventInformation instance 0, CO, multiple
+	UEUsageType                                          *IE                                      // IntegerNumber instance 0, CO
+	MMESGSNUESCEFPDNConnections                          []*IE                                    // SCEFPDNConnection instance 0, CO, multiple
+	MSISDN                                               *TypedMSISDN                             // MSISDN instance 1, CO
+	SourceUDPPortNumber                                  *TypedPortNumber                         // PortNumber instance 0, CO
+	ServingPLMNRateControl                               *TypedServingPLMNRateControl             // ServingPLMNRateControl instance 0, CO
+	ExtendedTraceInformation                             *IE                                      // ExtendedTraceInformation instance 0, CO
+	UnknownIEs                                           []*IE
+	decodedIEs
+}
+
+// MessageType returns ForwardRelocationRequest
+func (message *ForwardRelocationRequestMessage) MessageType() MessageType {
+	return ForwardRelocationRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ForwardRelocationRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ForwardRelocationRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ForwardRelocationRequest
+func (message *ForwardRelocationRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ForwardRelocationRequest, &message.Header, message)
+}
+
+// ForwardRelocationRequestPDNConnection is the content of the MMESGSNUEEPSPDNConnections grouped IE in ForwardRelocationRequest
+type ForwardRelocationRequestPDNConnection struct {
+	APN                             *IE                                      // APN instance 0, M
+	APNRestriction                  *TypedAPNRestriction                     // APNRestriction instance 0, C
+	SelectionMode                   *TypedSelectionMode                      // SelectionMode instance 0, CO
+	IPv4Address                     *IE                                      // IPAddress instance 0, C
+	IPv6Address                     *IE                                      // IPAddress instance 1, C
+	LinkedEPSBearerID               *TypedEBI                                // EBI instance 0, M
+	PGWS5S8IPAddressForControlPlane *TypedFTEID                              // FTEID instance 0, M
+	PGWNodeName                     *IE                                      // FQDN instance 0, C
+	BearerContexts                  []*ForwardRelocationRequestBearerContext // BearerContext instance 0, M, multiple
+	AggregateMaximumBitRate         *TypedAMBR                               // AMBR instance 0, M
+	ChargingCharacteristics         *TypedChargingCharacteristics            // ChargingCharacteristics instance 0, C
+	ChangeReportingAction           *IE                                      // ChangeReportingAction instance 0, C
+	CSGInformationReportingAction   *IE                                      // CSGInformationReportingAction instance 0, CO
+	HeNBInformationReporting        *IE                                      // HeNBInformationReporting instance 0, CO
+	IndicationFlags                 *IE                                      // Indication instance 0, CO
+	SignallingPriorityIndication    *IE                                      // SignallingPriorityIndication instance 0, CO
+	ChangeToReportFlags             *IE                                      // ChangetoReportFlags instance 0, CO
+	LocalHomeNetworkID              *IE                                      // FQDN instance 1, CO
+	WLANOffloadabilityIndication    *IE                                      // WLANOffloadabilityIndication instance 0, CO
+	HeaderCompressionConfiguration  *IE                                      // HeaderCompressionConfiguration instance 0, CO
+	UnknownIEs                      []*IE
+	decodedIEs
+}
+
+// ForwardRelocationRequestBearerContext is the content of the BearerContexts grouped IE in ForwardRelocationRequest
+type ForwardRelocationRequestBearerContext struct {
+	EPSBearerID                            *TypedEBI       // EBI instance 0, M
+	TFT                                    *IE             // BearerTFT instance 0, C
+	SGWS1S4S12IPAddressAndTEIDForUserPlane *TypedFTEID     // FTEID instance 0, M
+	PGWS5S8IPAddressAndTEIDForUserPlane    *TypedFTEID     // FTEID instance 1, C
+	BearerLevelQoS                         *TypedBearerQoS // BearerQoS instance 0, M
+	BSSContainer                           *IE             // FContainer instance 0, C
+	TransactionIdentifier                  *IE             // TI instance 0, C
+	BearerFlags                            *IE             // BearerFlags instance 0, C
+	UnknownIEs                             []*IE
+	decodedIEs
+}
+
+// ForwardRelocationResponseMessage is the typed message (see TypedMessage) for ForwardRelocationResponse
+type ForwardRelocationResponseMessage struct {
+	Header                     MessageHeader
+	Cause                      *TypedCause                                    // Cause instance 0, M
+	SenderFTEIDForControlPlane *TypedFTEID                                    // FTEID instance 0, C
+	IndicationFlags            *IE                                            // Indication instance 0, CO
+	ListOfSetUpBearers         []*ForwardRelocationResponseBearerContextSetUp // BearerContext instance 0, C, multiple
+	ListOfSetUpRABs            []*ForwardRelocationResponseRABSetUp           // BearerContext instance 1, C, multiple
+	ListOfSetUpPFCs            []*ForwardRelocationResponsePFCSetUp           // BearerContext instance 2, O, multiple
+	S1APCause                  *IE                                            // FCause instance 0, C
+	RANAPCause                 *IE                                            // FCause instance 1, C
+	BSSGPCause                 *IE                                            // FCause instance 2, C
+	EUTRANTransparentContainer *IE                                            // FContainer instance 0, C
+	UTRANTransparentContainer  *IE                                            // FContainer instance 1, C
+	BSSContainer               *IE                                            // FContainer instance 2, C
+	MMESGSNLDN                 *IE                                            // LDN instance 0, O
+	SGSNNodeName               *IE                                            // FQDN instance 0, CO
+	MMENodeName                *IE                                            // FQDN instance 1, CO
+	SGSNNumber                 *IE                                            // NodeNumber instance 0, CO
+	SGSNIdentifier             *IE                                            // NodeIdentifier instance 0, CO
+	MMEIdentifier              *IE                                            // NodeIdentifier instance 1, CO
+	MMENumberForMTSMS          *IE                                            // NodeNumber instance 1, CO
+	SGSNIdentifierForMTSMS     *IE                                            // NodeIdentifier instance 2, CO
+	MMEIdentifierForMTSMS      *IE                                            // NodeIdentifier instance 3, CO
+	UnknownIEs                 []*IE
+	decodedIEs
+}
+
+// MessageType returns ForwardRelocationResponse
+func (message *ForwardRelocationResponseMessage) MessageType() MessageType {
+	return ForwardRelocationResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ForwardRelocationResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ForwardRelocationResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ForwardRelocationResponse
+func (message *ForwardRelocationResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ForwardRelocationResponse, &message.Header, message)
+}
+
+// ForwardRelocationResponseBearerContextSetUp is the content of the ListOfSetUpBearers grouped IE in ForwardRelocationResponse
+type ForwardRelocationResponseBearerContextSetUp struct {
+	EPSBearerID                    *TypedEBI   // EBI instance 0, C
+	PacketFlowID                   *IE         // PacketFlowID instance 0, C
+	ENodeBFTEIDForDLDataForwarding *TypedFTEID // FTEID instance 0, C
+	ENodeBFTEIDForULDataForwarding *TypedFTEID // FTEID instance 1, C
+	SGWUPFFTEIDForDLDataForwarding *TypedFTEID // FTEID instance 2, C
+	RNCFTEIDForDLDataForwarding    *TypedFTEID // FTEID instance 3, C
+	SGSNFTEIDForDLDataForwarding   *TypedFTEID // FTEID instance 4, C
+	SGWFTEIDForULDataForwarding    *TypedFTEID // FTEID instance 5, C
+	UnknownIEs                     []*IE
+	decodedIEs
+}
+
+// ForwardRelocationResponseRABSetUp is the content of the ListOfSetUpRABs grouped IE in ForwardRelocationResponse
+type ForwardRelocationResponseRABSetUp struct {
+	EPSBearerID                    *TypedEBI   // EBI instance 0, C
+	PacketFlowID                   *IE         // PacketFlowID instance 0, C
+	ENodeBFTEIDForDLDataForwarding *TypedFTEID // FTEID instance 0, C
+	ENodeBFTEIDForULDataForwarding *TypedFTEID // FTEID instance 1, C
+	SGWUPFFTEIDForDLDataForwarding *TypedFTEID // FTEID instance 2, C
+	RNCFTEIDForDLDataForwarding    *TypedFTEID // FTEID instance 3, C
+	SGSNFTEIDForDLDataForwarding   *TypedFTEID // FTEID instance 4, C
+	SGWFTEIDForULDataForwarding    *TypedFTEID // FTEID instance 5, C
+	UnknownIEs                     []*IE
+	decodedIEs
+}
+
+// ForwardRelocationResponsePFCSetUp is the content of the ListOfSetUpPFCs grouped IE in ForwardRelocationResponse
+type ForwardRelocationResponsePFCSetUp struct {
+	EPSBearerID                  *TypedEBI   // EBI instance 0, C
+	PacketFlowID                 *IE         // PacketFlowID instance 0, C
+	SGSNFTEIDForDLDataForwarding *TypedFTEID // FTEID instance 0, C
+	UnknownIEs                   []*IE
+	decodedIEs
+}
+
+// ForwardRelocationCompleteNotificationMessage is the typed message (see TypedMessage) for ForwardRelocationCompleteNotification
+type ForwardRelocationCompleteNotificationMessage struct {
+	Header          MessageHeader
+	IndicationFlags *IE // Indication instance 0, C
+	MMESGSNLDN      *IE // LDN instance 0, O
+	UnknownIEs      []*IE
+	decodedIEs
+}
+
+// MessageType returns ForwardRelocationCompleteNotification
+func (message *ForwardRelocationCompleteNotificationMessage) MessageType() MessageType {
+	return ForwardRelocationCompleteNotification
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ForwardRelocationCompleteNotificationMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ForwardRelocationCompleteNotification, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ForwardRelocationCompleteNotification
+func (message *ForwardRelocationCompleteNotificationMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ForwardRelocationCompleteNotification, &message.Header, message)
+}
+
+// ForwardRelocationCompleteAcknowledgeMessage is the typed message (see TypedMessage) for ForwardRelocationCompleteAcknowledge
+type ForwardRelocationCompleteAcknowledgeMessage struct {
+	Header     MessageHeader
+	Cause      *TypedCause    // Cause instance 0, M
+	Recovery   *TypedRecovery // RecoveryRestartCounter instance 0, O
+	MMESGSNLDN *IE            // LDN instance 0, O
+	UnknownIEs []*IE
+	decodedIEs
+}
+
+// MessageType returns ForwardRelocationCompleteAcknowledge
+func (message *ForwardRelocationCompleteAcknowledgeMessage) MessageType() MessageType {
+	return ForwardRelocationCompleteAcknowledge
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *ForwardRelocationCompleteAcknowledgeMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(ForwardRelocationCompleteAcknowledge, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type ForwardRelocationCompleteAcknowledge
+func (message *ForwardRelocationCompleteAcknowledgeMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, ForwardRelocationCompleteAcknowledge, &message.Header, message)
+}
+
+// RelocationCancelRequestMessage is the typed message (see TypedMessage) for RelocationCancelRequest
+type RelocationCancelRequestMessage struct {
+	Header          MessageHeader
+	IMSI            *TypedIMSI // IMSI instance 0, C
+	MEI             *TypedMEI  // MEI instance 0, C
+	IndicationFlags *IE        // Indication instance 0, C
+	RANAPCause      *IE        // FCause instance 0, C
+	MMESGSNLDN      *IE        // LDN instance 0, O
+	UnknownIEs      []*IE
+	decodedIEs
+}
+
+// MessageType returns RelocationCancelRequest
+func (message *RelocationCancelRequestMessage) MessageType() MessageType {
+	return RelocationCancelRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *RelocationCancelRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(RelocationCancelRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type RelocationCancelRequest
+func (message *RelocationCancelRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, RelocationCancelRequest, &message.Header, message)
+}
+
+// RelocationCancelResponseMessage is the typed message (see TypedMessage) for RelocationCancelResponse
+type RelocationCancelResponseMessage struct {
+	Header     MessageHeader
+	Cause      *TypedCause // Cause instance 0, M
+	MMESGSNLDN *IE         // LDN instance 0, O
+	UnknownIEs []*IE
+	decodedIEs
+}
+
+// MessageType returns RelocationCancelResponse
+func (message *RelocationCancelResponseMessage) MessageType() MessageType {
+	return RelocationCancelResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *RelocationCancelResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(RelocationCancelResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type RelocationCancelResponse
+func (message *RelocationCancelResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, RelocationCancelResponse, &message.Header, message)
+}
+
+// CreateIndirectDataForwardingTunnelRequestMessage is the typed message (see TypedMessage) for CreateIndirectDataForwardingTunnelRequest
+type CreateIndirectDataForwardingTunnelRequestMessage struct {
+	Header                     MessageHeader
+	IMSI                       *TypedIMSI                                                // IMSI instance 0, C
+	MEI                        *TypedMEI                                                 // MEI instance 0, CO
+	IndicationFlags            *IE                                                       // Indication instance 0, CO
+	SenderFTEIDForControlPlane *TypedFTEID                                               // FTEID instance 0, CO
+	BearerContexts             []*CreateIndirectDataForwardingTunnelRequestBearerContext // BearerContext instance 0, M, multiple
+	Recovery                   *TypedRecovery                                            // RecoveryRestartCounter instance 0, CO
+	UnknownIEs                 []*IE
+	decodedIEs
+}
+
+// MessageType returns CreateIndirectDataForwardingTunnelRequest
+func (message *CreateIndirectDataForwardingTunnelRequestMessage) MessageType() MessageType {
+	return CreateIndirectDataForwardingTunnelRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *CreateIndirectDataForwardingTunnelRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(CreateIndirectDataForwardingTunnelRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type CreateIndirectDataForwardingTunnelRequest
+func (message *CreateIndirectDataForwardingTunnelRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, CreateIndirectDataForwardingTunnelRequest, &message.Header, message)
+}
+
+// CreateIndirectDataForwardingTunnelRequestBearerContext is the content of the BearerContexts grouped IE in CreateIndirectDataForwardingTunnelRequest
+type CreateIndirectDataForwardingTunnelRequestBearerContext struct {
+	EPSBearerID                    *TypedEBI   // EBI instance 0, M
+	ENodeBFTEIDForDLDataForwarding *TypedFTEID // FTEID instance 0, C
+	ENodeBFTEIDForULDataForwarding *TypedFTEID // FTEID instance 1, C
+	SGWUPFFTEIDForDLDataForwarding *TypedFTEID // FTEID instance 2, C
+	SGSNFTEIDForDLDataForwarding   *TypedFTEID // FTEID instance 3, C
+	RNCFTEIDForDLDataForwarding    *TypedFTEID // FTEID instance 4, C
+	SGWFTEIDForULDataForwarding    *TypedFTEID // FTEID instance 5, C
+	MMEFTEIDForDLDataForwarding    *TypedFTEID // FTEID instance 6, CO
+	UnknownIEs                     []*IE
+	decodedIEs
+}
+
+// CreateIndirectDataForwardingTunnelResponseMessage is the typed message (see TypedMessage) for CreateIndirectDataForwardingTunnelResponse
+type CreateIndirectDataForwardingTunnelResponseMessage struct {
+	Header                     MessageHeader
+	Cause                      *TypedCause                                                // Cause instance 0, M
+	SenderFTEIDForControlPlane *TypedFTEID                                                // FTEID instance 0, C
+	BearerContexts             []*CreateIndirectDataForwardingTunnelResponseBearerContext // BearerContext instance 0, M, multiple
+	Recovery                   *TypedRecovery                                             // RecoveryRestartCounter instance 0, CO
+	UnknownIEs                 []*IE
+	decodedIEs
+}
+
+// MessageType returns CreateIndirectDataForwardingTunnelResponse
+func (message *CreateIndirectDataForwardingTunnelResponseMessage) MessageType() MessageType {
+	return CreateIndirectDataForwardingTunnelResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *CreateIndirectDataForwardingTunnelResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(CreateIndirectDataForwardingTunnelResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type CreateIndirectDataForwardingTunnelResponse
+func (message *CreateIndirectDataForwardingTunnelResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, CreateIndirectDataForwardingTunnelResponse, &message.Header, message)
+}
+
+// CreateIndirectDataForwardingTunnelResponseBearerContext is the content of the BearerContexts grouped IE in CreateIndirectDataForwardingTunnelResponse
+type CreateIndirectDataForwardingTunnelResponseBearerContext struct {
+	EPSBearerID                       *TypedEBI   // EBI instance 0, M
+	Cause                             *TypedCause // Cause instance 0, M
+	SGWUPFFTEIDForDLDataForwarding    *TypedFTEID // FTEID instance 0, C
+	SGWUPFFTEIDForULDataForwarding    *TypedFTEID // FTEID instance 1, C
+	SGWFTEIDForDLDataForwardingForMME *TypedFTEID // FTEID instance 2, CO
+	UnknownIEs                        []*IE
+	decodedIEs
+}
+
+// DeleteIndirectDataForwardingTunnelRequestMessage is the typed message (see TypedMessage) for DeleteIndirectDataForwardingTunnelRequest
+type DeleteIndirectDataForwardingTunnelRequestMessage struct {
+	Header     MessageHeader
+	UnknownIEs []*IE
+	decodedIEs
+}
+
+// MessageType returns DeleteIndirectDataForwardingTunnelRequest
+func (message *DeleteIndirectDataForwardingTunnelRequestMessage) MessageType() MessageType {
+	return DeleteIndirectDataForwardingTunnelRequest
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *DeleteIndirectDataForwardingTunnelRequestMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(DeleteIndirectDataForwardingTunnelRequest, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type DeleteIndirectDataForwardingTunnelRequest
+func (message *DeleteIndirectDataForwardingTunnelRequestMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, DeleteIndirectDataForwardingTunnelRequest, &message.Header, message)
+}
+
+// DeleteIndirectDataForwardingTunnelResponseMessage is the typed message (see TypedMessage) for DeleteIndirectDataForwardingTunnelResponse
+type DeleteIndirectDataForwardingTunnelResponseMessage struct {
+	Header     MessageHeader
+	Cause      *TypedCause    // Cause instance 0, M
+	Recovery   *TypedRecovery // RecoveryRestartCounter instance 0, CO
+	UnknownIEs []*IE
+	decodedIEs
+}
+
+// MessageType returns DeleteIndirectDataForwardingTunnelResponse
+func (message *DeleteIndirectDataForwardingTunnelResponseMessage) MessageType() MessageType {
+	return DeleteIndirectDataForwardingTunnelResponse
+}
+
+// ToPDU converts the typed message to a PDU
+func (message *DeleteIndirectDataForwardingTunnelResponseMessage) ToPDU() (*PDU, error) {
+	return typedMessageToPDU(DeleteIndirectDataForwardingTunnelResponse, &message.Header, message)
+}
+
+// FromPDU replaces the content of the typed message with the content of the PDU,
+// which must have the message type DeleteIndirectDataForwardingTunnelResponse
+func (message *DeleteIndirectDataForwardingTunnelResponseMessage) FromPDU(pdu *PDU) error {
+	return typedMessageFromPDU(pdu, DeleteIndirectDataForwardingTunnelResponse, &message.Header, message)
+}
+
+var typedMessageConstructors = map[MessageType]func() TypedMessage{
+	EchoRequest:                                func() TypedMessage { return &EchoRequestMessage{} },
+	EchoResponse:                               func() TypedMessage { return &EchoResponseMessage{} },
+	CreateSessionRequest:                       func() TypedMessage { return &CreateSessionRequestMessage{} },
+	CreateSessionResponse:                      func() TypedMessage { return &CreateSessionResponseMessage{} },
+	ModifyBearerRequest:                        func() TypedMessage { return &ModifyBearerRequestMessage{} },
+	ModifyBearerResponse:                       func() TypedMessage { return &ModifyBearerResponseMessage{} },
+	DeleteSessionRequest:                       func() TypedMessage { return &DeleteSessionRequestMessage{} },
+	DeleteSessionResponse:                      func() TypedMessage { return &DeleteSessionResponseMessage{} },
+	CreateBearerRequest:                        func() TypedMessage { return &CreateBearerRequestMessage{} },
+	CreateBearerResponse:                       func() TypedMessage { return &CreateBearerResponseMessage{} },
+	UpdateBearerRequest:                        func() TypedMessage { return &UpdateBearerRequestMessage{} },
+	UpdateBearerResponse:                       func() TypedMessage { return &UpdateBearerResponseMessage{} },
+	DeleteBearerRequest:                        func() TypedMessage { return &DeleteBearerRequestMessage{} },
+	DeleteBearerResponse:                       func() TypedMessage { return &DeleteBearerResponseMessage{} },
+	ReleaseAccessBearersRequest:                func() TypedMessage { return &ReleaseAccessBearersRequestMessage{} },
+	ReleaseAccessBearersResponse:               func() TypedMessage { return &ReleaseAccessBearersResponseMessage{} },
+	DownlinkDataNotification:                   func() TypedMessage { return &DownlinkDataNotificationMessage{} },
+	DownlinkDataNotificationAcknowledge:        func() TypedMessage { return &DownlinkDataNotificationAcknowledgeMessage{} },
+	IdentificationRequest:                      func() TypedMessage { return &IdentificationRequestMessage{} },
+	IdentificationResponse:                     func() TypedMessage { return &IdentificationResponseMessage{} },
+	ContextRequest:                             func() TypedMessage { return &ContextRequestMessage{} },
+	ContextResponse:                            func() TypedMessage { return &ContextResponseMessage{} },
+	ContextAcknowledge:                         func() TypedMessage { return &ContextAcknowledgeMessage{} },
+	ForwardRelocationRequest:                   func() TypedMessage { return &ForwardRelocationRequestMessage{} },
+	ForwardRelocationResponse:                  func() TypedMessage { return &ForwardRelocationResponseMessage{} },
+	ForwardRelocationCompleteNotification:      func() TypedMessage { return &ForwardRelocationCompleteNotificationMessage{} },
+	ForwardRelocationCompleteAcknowledge:       func() TypedMessage { return &ForwardRelocationCompleteAcknowledgeMessage{} },
+	RelocationCancelRequest:                    func() TypedMessage { return &RelocationCancelRequestMessage{} },
+	RelocationCancelResponse:                   func() TypedMessage { return &RelocationCancelResponseMessage{} },
+	CreateIndirectDataForwardingTunnelRequest:  func() TypedMessage { return &CreateIndirectDataForwardingTunnelRequestMessage{} },
+	CreateIndirectDataForwardingTunnelResponse: func() TypedMessage { return &CreateIndirectDataForwardingTunnelResponseMessage{} },
+	DeleteIndirectDataForwardingTunnelRequest:  func() TypedMessage { return &DeleteIndirectDataForwardingTunnelRequestMessage{} },
+	DeleteIndirectDataForwardingTunnelResponse: func() TypedMessage { return &DeleteIndirectDataForwardingTunnelResponseMessage{} },
+}
@@ -0,0 +1,212 @@
+package gtpv2
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// IETypeIsGrouped returns true if the data of an IE of the provided type are a
+// sequence of IEs (e.g., Bearer Context)
+func IETypeIsGrouped(ieType IEType) bool {
+	return groupedIETypes[ieType]
+}
+
+// partialDecoder accumulates the problems found during a partial decode
+type partialDecoder struct {
+	warnings []*DecodeError
+}
+
+func (decoder *partialDecoder) warn(decodeError *DecodeError) {
+	decoder.warnings = append(decoder.warnings, decodeError)
+}
+
+func joinIELookupPath(parentPath string, segment string) string {
+	if parentPath == "" {
+		return segment
+	}
+
+	return parentPath + "/" + segment
+}
+
+// newUnparsedIE returns an IE that holds a copy of raw, which could not be decoded
+// as an IE
+func newUnparsedIE(raw []byte) *IE {
+	ie := &IE{
+		Type:        ieTypeAtStartOf(raw),
+		TotalLength: uint16(len(raw)),
+		Data:        append([]byte(nil), raw...),
+		Unparsed:    true,
+	}
+
+	if len(raw) >= 4 {
+		ie.InstanceNumber = raw[3] & 0x0f
+	}
+
+	return ie
+}
+
+// decodeIEs decodes stream as a sequence of IEs, where stream starts at offset in
+// the outermost stream and the IEs are at parentPath.  An IE that cannot be decoded
+// is replaced by an unparsed IE.  If its length field can be trusted, decoding
+// continues after it.  Otherwise, the remainder of stream is unparsed.  The data of
+// grouped IEs are decoded in the same way, but only for the warnings.
+func (decoder *partialDecoder) decodeIEs(stream []byte, offset int, parentPath string) []*IE {
+	ies := make([]*IE, 0, 10)
+	countOfIEsByType := make(map[IEType]int)
+
+	for i := 0; i < len(stream); {
+		ieType := ieTypeAtStartOf(stream[i:])
+		path := joinIELookupPath(parentPath, pathSegmentForIE(ieType, countOfIEsByType[ieType]))
+		countOfIEsByType[ieType]++
+
+		ie, err := DecodeIE(stream[i:])
+		if err != nil {
+			var decodeError *DecodeError
+			if !errors.As(err, &decodeError) {
+				decodeError = &DecodeError{Kind: DecodeErrorInvalidIEData, Err: err}
+			}
+			decoder.warn(decodeError.atIE(path, offset+i))
+
+			unparsedLength := len(stream) - i
+			if decodeError.Kind == DecodeErrorInvalidExtendedType {
+				unparsedLength = int(binary.BigEndian.Uint16(stream[i+1:i+3])) + 4
+			}
+
+			ies = append(ies, newUnparsedIE(stream[i:i+unparsedLength]))
+			i += unparsedLength
+			continue
+		}
+
+		if IETypeIsGrouped(ie.Type) {
+			decoder.decodeIEs(ie.Data, offset+i+headerLengthForIEType(ie.Type), path)
+		}
+
+		ies = append(ies, ie)
+		i += int(ie.TotalLength)
+	}
+
+	return ies
+}
+
+// ExtractGroupedIEsPartially is the same as ExtractGroupedIEsFrom(), but rather than
+// failing on the first problem, it returns every IE that can be decoded, replaces
+// those that cannot with unparsed IEs (see IE.Unparsed), and returns the problems as
+// warnings.  The warning Offsets are relative to the start of the grouped IE data and
+// the warning Paths are relative to the grouped IE.  IEs inside nested grouped IEs
+// are checked, but are returned only in the data of the nested grouped IE.
+func ExtractGroupedIEsPartially(groupedIE *IE) (ies []*IE, warnings []*DecodeError) {
+	decoder := &partialDecoder{}
+	ies = decoder.decodeIEs(groupedIE.Data, 0, "")
+
+	return ies, decoder.warnings
+}
+
+// DecodePDUPartially is a lenient version of DecodePDUWithOptions(), intended for
+// examining malformed traffic.  Rather than failing on the first problem, it returns
+// the PDU (and the piggybacked PDU, if any) with every IE it can decode, and returns
+// every problem found as a warning.  An IE that cannot be decoded is replaced by an
+// unparsed IE (see IE.Unparsed).  When the IE length field cannot be trusted, the
+// unparsed IE holds the rest of the PDU.  The data of grouped IEs are checked in the
+// same way, and problems there are also returned as warnings.  If the PDU length field
+// exceeds the stream length, the IEs are decoded from the octets that are present.
+// Header violations (see PDU.ValidateHeader()) are also warnings.  Warning Offsets
+// are relative to the start of stream.  Returns an error (a *DecodeError) only if
+// there is no usable GTPv2 header.
+func DecodePDUPartially(stream []byte) (pdu *PDU, piggybackedPdu *PDU, warnings []*DecodeError, err error) {
+	decoder := &partialDecoder{}
+
+	pdu, piggybackedPdu, err = decoder.decodePDU(stream, 0, false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return pdu, piggybackedPdu, decoder.warnings, nil
+}
+
+func (decoder *partialDecoder) decodePDU(stream []byte, offset int, isPiggybacked bool) (pdu *PDU, piggybackedPdu *PDU, err error) {
+	if len(stream) < 8 {
+		return nil, nil, &DecodeError{Kind: DecodeErrorTruncated, Offset: offset, InPiggybackedPDU: isPiggybacked, ExpectedLength: 8, ActualLength: len(stream), Message: fmt.Sprintf("stream length (%d) too short for a GTPv2 PDU", len(stream))}
+	}
+
+	if (stream[0] >> 5) != 2 {
+		return nil, nil, &DecodeError{Kind: DecodeErrorBadVersion, Offset: offset, InPiggybackedPDU: isPiggybacked, Message: fmt.Sprintf("GTPv2 PDU version should be 2, but in stream, it is (%d)", (stream[0] >> 5))}
+	}
+
+	hasTeidField := (stream[0] & 0x08) == 0x08
+	headerLength := 8
+	if hasTeidField {
+		headerLength = 12
+	}
+
+	if len(stream) < headerLength {
+		return nil, nil, &DecodeError{Kind: DecodeErrorTruncated, Offset: offset, InPiggybackedPDU: isPiggybacked, ExpectedLength: headerLength, ActualLength: len(stream), Message: "GTPv2 PDU has TEID flag set, but stream is too short for the header"}
+	}
+
+	if err := validateEncodedHeader(stream); err != nil {
+		decoder.warn(&DecodeError{Kind: DecodeErrorInvalidHeader, Offset: offset, InPiggybackedPDU: isPiggybacked, Err: err})
+	}
+
+	msgLengthFieldValue := binary.BigEndian.Uint16(stream[2:4])
+	totalPduLength := int(msgLengthFieldValue) + 4
+
+	if totalPduLength < headerLength {
+		decoder.warn(&DecodeError{Kind: DecodeErrorLengthMismatch, Offset: offset, InPiggybackedPDU: isPiggybacked, ExpectedLength: headerLength, ActualLength: totalPduLength, Message: fmt.Sprintf("GTPv2 PDU length field (%d) is too short for the header", msgLengthFieldValue)})
+		totalPduLength = headerLength
+	} else if totalPduLength > len(stream) {
+		decoder.warn(&DecodeError{Kind: DecodeErrorTruncated, Offset: offset, InPiggybackedPDU: isPiggybacked, ExpectedLength: totalPduLength, ActualLength: len(stream), Message: fmt.Sprintf("GTPv2 PDU length field is (%d), so total length should be (%d), but stream length is (%d)", msgLengthFieldValue, totalPduLength, len(stream))})
+		totalPduLength = len(stream)
+	}
+
+	hasPiggybackedPdu := (stream[0] & 0x10) == 0x10
+	hasPriorityField := hasTeidField && (stream[0]&0x04) == 0x04
+
+	pdu = &PDU{
+		IsCarryingPiggybackedPDU: hasPiggybackedPdu,
+		TEIDFieldIsPresent:       hasTeidField,
+		PriorityFieldIsPresent:   hasPriorityField,
+		Type:                     MessageType(stream[1]),
+		TotalLength:              uint16(totalPduLength),
+	}
+
+	if hasTeidField {
+		pdu.TEID = binary.BigEndian.Uint32(stream[4:8])
+		pdu.SequenceNumber = binary.BigEndian.Uint32(stream[8:12]) >> 8
+		if hasPriorityField {
+			pdu.Priority = (uint8(stream[11]) & 0xf0) >> 4
+		}
+	} else {
+		pdu.SequenceNumber = binary.BigEndian.Uint32(stream[4:8]) >> 8
+	}
+
+	pdu.InformationElements = decoder.decodeIEs(stream[headerLength:totalPduLength], offset+headerLength, "")
+
+	remainingStream := stream[totalPduLength:]
+
+	switch {
+	case hasPiggybackedPdu && isPiggybacked:
+		decoder.warn(&DecodeError{Kind: DecodeErrorInvalidPiggyback, Offset: offset, InPiggybackedPDU: true, Message: "GTPv2 PDU has piggybacked PDU but the piggyback flag for that piggybacked PDU is not 0"})
+
+	case hasPiggybackedPdu && len(remainingStream) == 0:
+		decoder.warn(&DecodeError{Kind: DecodeErrorInvalidPiggyback, Offset: offset + totalPduLength, Message: "GTPv2 PDU piggyback flag is set, but there is no piggybacked PDU in stream"})
+
+	case hasPiggybackedPdu:
+		piggybackedPdu, _, err = decoder.decodePDU(remainingStream, offset+totalPduLength, true)
+		if err != nil {
+			var decodeError *DecodeError
+			if !errors.As(err, &decodeError) {
+				decodeError = &DecodeError{Kind: DecodeErrorInvalidPiggyback, Offset: offset + totalPduLength, InPiggybackedPDU: true, Err: err}
+			}
+			decoder.warn(decodeError)
+			piggybackedPdu = nil
+		} else {
+			remainingStream = remainingStream[piggybackedPdu.TotalLength:]
+		}
+	}
+
+	if !isPiggybacked && len(remainingStream) > 0 && (piggybackedPdu != nil || !hasPiggybackedPdu) {
+		decoder.warn(&DecodeError{Kind: DecodeErrorLengthMismatch, Offset: offset + len(stream) - len(remainingStream), ExpectedLength: len(stream) - len(remainingStream), ActualLength: len(stream), Message: "stream contains octets after the PDU"})
+	}
+
+	return pdu, piggybackedPdu, nil
+}
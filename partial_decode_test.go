@@ -0,0 +1,169 @@
+package gtpv2
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+)
+
+func TestDecodePDUPartially(t *testing.T) {
+	validFTEIDData := (&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1), InterfaceType: 10, Key: 0x01}).ToIE().Data
+
+	malformedBearerContext := NewIEWithRawData(BearerContext, append([]byte{0x49, 0x00, 0x01, 0x00, 0x05, 0x57, 0x00, 0x20, 0x00}, validFTEIDData...))
+	invalidExtendedTypeIE := &IE{Type: ExtensionType, TotalLength: 7, Data: []byte{0x00, 0x10, 0xaa}}
+	truncatedTail := &IE{Type: MEI, TotalLength: 6, Data: []byte{0x01, 0x02}}
+
+	pdu := NewPDU(CreateSessionRequest, 0x10, []*IE{
+		(&TypedIMSI{AsString: "001002789012345"}).ToIE(),
+		malformedBearerContext,
+		invalidExtendedTypeIE,
+		NewIEWithRawData(RATType, []byte{0x06}),
+		truncatedTail,
+	}).SetTEID(0x01020304)
+
+	encoded := pdu.Encode()
+	binary.BigEndian.PutUint16(encoded[len(encoded)-5:len(encoded)-3], 8)
+
+	decodedPDU, piggybackedPDU, warnings, err := DecodePDUPartially(encoded)
+	if err != nil {
+		t.Fatalf("[TestDecodePDUPartially] expected no error, got = (%s)", err)
+	}
+
+	if piggybackedPDU != nil {
+		t.Errorf("[TestDecodePDUPartially] expected no piggybacked PDU, got one")
+	}
+
+	if decodedPDU.TEID != 0x01020304 || decodedPDU.SequenceNumber != 0x10 {
+		t.Errorf("[TestDecodePDUPartially] expected TEID (0x01020304) and sequence number (0x10), got = (0x%08x), (0x%x)", decodedPDU.TEID, decodedPDU.SequenceNumber)
+	}
+
+	expectedIEs := []struct {
+		ieType   IEType
+		unparsed bool
+	}{
+		{IMSI, false},
+		{BearerContext, false},
+		{ExtensionType, true},
+		{RATType, false},
+		{MEI, true},
+	}
+
+	if len(decodedPDU.InformationElements) != len(expectedIEs) {
+		t.Fatalf("[TestDecodePDUPartially] expected (%d) IEs, got = (%d)", len(expectedIEs), len(decodedPDU.InformationElements))
+	}
+
+	for i, expected := range expectedIEs {
+		ie := decodedPDU.InformationElements[i]
+		if ie.Type != expected.ieType || ie.Unparsed != expected.unparsed {
+			t.Errorf("[TestDecodePDUPartially] for IE (%d), expected type (%d) and unparsed (%t), got = (%d), (%t)", i, expected.ieType, expected.unparsed, ie.Type, ie.Unparsed)
+		}
+	}
+
+	if err := compareByteArrays(encoded, decodedPDU.Encode()); err != nil {
+		t.Errorf("[TestDecodePDUPartially] on re-encode of partially decoded PDU: %s", err)
+	}
+
+	bearerContextOffset := 12 + 12
+	expectedWarnings := []struct {
+		kind   DecodeErrorKind
+		path   string
+		offset int
+	}{
+		{DecodeErrorTruncated, "BearerContext/FTEID", bearerContextOffset + 4 + 5},
+		{DecodeErrorInvalidExtendedType, "ExtensionType", bearerContextOffset + int(malformedBearerContext.TotalLength)},
+		{DecodeErrorTruncated, "MEI", len(encoded) - 6},
+	}
+
+	if len(warnings) != len(expectedWarnings) {
+		t.Fatalf("[TestDecodePDUPartially] expected (%d) warnings, got = (%d): %v", len(expectedWarnings), len(warnings), warnings)
+	}
+
+	for i, expected := range expectedWarnings {
+		if warnings[i].Kind != expected.kind || warnings[i].Path != expected.path || warnings[i].Offset != expected.offset {
+			t.Errorf("[TestDecodePDUPartially] for warning (%d), expected kind (%s), path (%s), offset (%d), got = (%s), (%s), (%d)", i, expected.kind, expected.path, expected.offset, warnings[i].Kind, warnings[i].Path, warnings[i].Offset)
+		}
+	}
+
+	if _, _, err := DecodePDU(encoded); err == nil {
+		t.Errorf("[TestDecodePDUPartially] expected error on strict DecodePDU of same stream, got none")
+	}
+}
+
+func TestDecodePDUPartiallyHeaderProblems(t *testing.T) {
+	stream := []byte{0x58, 0x01, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x03, 0x00, 0x01, 0x00, 0x05}
+
+	pdu, piggybackedPDU, warnings, err := DecodePDUPartially(stream)
+	if err != nil {
+		t.Fatalf("[TestDecodePDUPartiallyHeaderProblems] expected no error, got = (%s)", err)
+	}
+
+	if piggybackedPDU != nil || pdu.TotalLength != uint16(len(stream)) || len(pdu.InformationElements) != 1 {
+		t.Errorf("[TestDecodePDUPartiallyHeaderProblems] expected PDU with length (%d) and one IE and no piggybacked PDU, got = (%d), (%d), (%v)", len(stream), pdu.TotalLength, len(pdu.InformationElements), piggybackedPDU)
+	}
+
+	expectedKinds := []DecodeErrorKind{DecodeErrorInvalidHeader, DecodeErrorTruncated, DecodeErrorInvalidPiggyback}
+	if len(warnings) != len(expectedKinds) {
+		t.Fatalf("[TestDecodePDUPartiallyHeaderProblems] expected (%d) warnings, got = (%d): %v", len(expectedKinds), len(warnings), warnings)
+	}
+
+	for i, expectedKind := range expectedKinds {
+		if warnings[i].Kind != expectedKind {
+			t.Errorf("[TestDecodePDUPartiallyHeaderProblems] for warning (%d), expected kind (%s), got = (%s)", i, expectedKind, warnings[i].Kind)
+		}
+	}
+
+	if _, _, _, err := DecodePDUPartially([]byte{0x28, 0x01, 0x00, 0x04, 0x00, 0x00, 0x01, 0x00}); err == nil {
+		t.Errorf("[TestDecodePDUPartiallyHeaderProblems] expected error for version 1 PDU, got none")
+	}
+}
+
+func TestExtractGroupedIEsPartially(t *testing.T) {
+	groupedIE := NewIEWithRawData(BearerContext, []byte{0x49, 0x00, 0x01, 0x00, 0x05, 0x57, 0x00, 0x20, 0x00, 0x01})
+
+	ies, warnings := ExtractGroupedIEsPartially(groupedIE)
+
+	if len(ies) != 2 || ies[0].Type != EBI || ies[0].Unparsed || ies[1].Type != FTEID || !ies[1].Unparsed {
+		t.Fatalf("[TestExtractGroupedIEsPartially] expected EBI and unparsed F-TEID, got = (%v)", ies)
+	}
+
+	if err := compareByteArrays([]byte{0x57, 0x00, 0x20, 0x00, 0x01}, ies[1].Data); err != nil {
+		t.Errorf("[TestExtractGroupedIEsPartially] on unparsed F-TEID data: %s", err)
+	}
+
+	if len(warnings) != 1 || warnings[0].Path != "FTEID" || warnings[0].Offset != 5 {
+		t.Errorf("[TestExtractGroupedIEsPartially] expected one warning with path (FTEID) and offset (5), got = (%v)", warnings)
+	}
+
+	if reencoded, err := NewGroupedIEErrorable(BearerContext, ies); err != nil {
+		t.Errorf("[TestExtractGroupedIEsPartially] expected no error on NewGroupedIEErrorable, got = (%s)", err)
+	} else if err := compareByteArrays(groupedIE.Data, reencoded.Data); err != nil {
+		t.Errorf("[TestExtractGroupedIEsPartially] on re-encode of grouped IE: %s", err)
+	}
+}
+
+func TestDecodePartiallyWithLargeIELengthFields(t *testing.T) {
+	for _, lengthFieldValue := range []uint16{0xfffc, 0xfffd, 0xfffe, 0xffff} {
+		ieOctets := []byte{byte(RATType), byte(lengthFieldValue >> 8), byte(lengthFieldValue), 0x00, 0x06}
+
+		stream := append([]byte{0x48, 0x20, 0x00, byte(8 + len(ieOctets)), 0, 0, 0, 1, 0, 0, 1, 0}, ieOctets...)
+
+		pdu, _, warnings, err := DecodePDUPartially(stream)
+		if err != nil {
+			t.Fatalf("[TestDecodePartiallyWithLargeIELengthFields] for length field (0x%04x) expected no error, got = (%s)", lengthFieldValue, err)
+		}
+
+		if len(pdu.InformationElements) != 1 || !pdu.InformationElements[0].Unparsed {
+			t.Errorf("[TestDecodePartiallyWithLargeIELengthFields] for length field (0x%04x) expected one unparsed IE, got = (%v)", lengthFieldValue, pdu.InformationElements)
+		}
+
+		if len(warnings) != 1 || warnings[0].Kind != DecodeErrorTruncated || warnings[0].Offset != 12 {
+			t.Errorf("[TestDecodePartiallyWithLargeIELengthFields] for length field (0x%04x) expected one truncated warning at offset (12), got = (%v)", lengthFieldValue, warnings)
+		}
+
+		groupedIE := &IE{Type: BearerContext, Data: ieOctets}
+		ies, warnings := ExtractGroupedIEsPartially(groupedIE)
+		if len(ies) != 1 || !ies[0].Unparsed || len(warnings) != 1 {
+			t.Errorf("[TestDecodePartiallyWithLargeIELengthFields] on ExtractGroupedIEsPartially() for length field (0x%04x) expected one unparsed IE and one warning, got = (%v), (%v)", lengthFieldValue, ies, warnings)
+		}
+	}
+}
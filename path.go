@@ -0,0 +1,377 @@
+package gtpv2
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// PathState is the state of the path to a peer monitored by an Endpoint (see
+// Endpoint.MonitorPeer())
+type PathState int
+
+// Possible PathState values
+const (
+	// PathStateUnknown is the state of a path until the first Echo Request to the
+	// peer is answered, or fails
+	PathStateUnknown PathState = iota
+
+	// PathStateUp is the state of a path after an Echo Request to the peer is
+	// answered
+	PathStateUp
+
+	// PathStateDown is the state of a path after PathFailureEchoes consecutive Echo
+	// Requests to the peer are not answered (see EndpointOptions)
+	PathStateDown
+)
+
+var pathStateNames = map[PathState]string{
+	PathStateUnknown: "Unknown",
+	PathStateUp:      "Up",
+	PathStateDown:    "Down",
+}
+
+func (state PathState) String() string {
+	if name, isKnown := pathStateNames[state]; isKnown {
+		return name
+	}
+
+	return fmt.Sprintf("unknown path state (%d)", int(state))
+}
+
+// PathEventKind is the kind of change reported by a PathEvent
+type PathEventKind int
+
+// Possible PathEventKind values
+const (
+	// PathEventUp is emitted when the path to a peer changes to PathStateUp
+	PathEventUp PathEventKind = iota + 1
+
+	// PathEventDown is emitted when the path to a peer changes to PathStateDown
+	PathEventDown
+
+	// PathEventPeerRestarted is emitted when the Recovery restart counter in an Echo
+	// Request or Echo Response from a peer differs from the one previously received,
+	// which means that the peer has restarted (TS 29.274 section 7.1)
+	PathEventPeerRestarted
+)
+
+var pathEventKindNames = map[PathEventKind]string{
+	PathEventUp:            "Up",
+	PathEventDown:          "Down",
+	PathEventPeerRestarted: "Peer Restarted",
+}
+
+func (kind PathEventKind) String() string {
+	if name, isKnown := pathEventKindNames[kind]; isKnown {
+		return name
+	}
+
+	return fmt.Sprintf("unknown path event kind (%d)", int(kind))
+}
+
+// PeerStatus is the state of a peer monitored by an Endpoint.  LastSeen is when a
+// message was last received from the peer, and is the zero time if none has been.
+// RTT is the time between the last transmission of the last answered Echo Request
+// and the receipt of its answer.  UnansweredEchoes is the count of consecutive
+// Echo Requests that were not answered.  RecoveryRestartCounter is the last one
+// received from the peer in an Echo Request or Echo Response, if
+// RecoveryRestartCounterIsKnown is true.
+type PeerStatus struct {
+	Peer                          net.Addr
+	State                         PathState
+	LastSeen                      time.Time
+	RTT                           time.Duration
+	UnansweredEchoes              int
+	RecoveryRestartCounter        uint8
+	RecoveryRestartCounterIsKnown bool
+}
+
+// PathEvent reports a change to the path to a monitored peer (see
+// Endpoint.PathEvents()).  Status is the status of the peer after the change.
+type PathEvent struct {
+	Kind   PathEventKind
+	Status PeerStatus
+}
+
+// EchoTimeoutWithoutT3 is the time after which an Echo Request sent for path
+// management fails if it is not answered, when EndpointOptions T3 is negative, so
+// that requests are otherwise not timed out
+const EchoTimeoutWithoutT3 = (DefaultN3 + 1) * DefaultT3
+
+// pathEventQueueLength is the number of path events held for PathEvents() before
+// further events are dropped
+const pathEventQueueLength = 64
+
+type peerMonitor struct {
+	status    PeerStatus
+	timer     Timer
+	isStopped bool
+}
+
+// pathManager holds the state of path management for an Endpoint
+type pathManager struct {
+	lock     sync.Mutex
+	monitors map[string]*peerMonitor
+	events   chan PathEvent
+	isClosed bool
+}
+
+func newPathManager() *pathManager {
+	return &pathManager{
+		monitors: make(map[string]*peerMonitor),
+		events:   make(chan PathEvent, pathEventQueueLength),
+	}
+}
+
+// emit delivers the event for the monitor, unless the PathEvents() channel is full.
+// The path manager lock must be held.
+func (paths *pathManager) emit(kind PathEventKind, monitor *peerMonitor) {
+	select {
+	case paths.events <- PathEvent{Kind: kind, Status: monitor.status}:
+	default:
+	}
+}
+
+// close stops every monitor and closes the PathEvents() channel
+func (paths *pathManager) close() {
+	paths.lock.Lock()
+	defer paths.lock.Unlock()
+
+	if paths.isClosed {
+		return
+	}
+	paths.isClosed = true
+
+	for _, monitor := range paths.monitors {
+		monitor.isStopped = true
+		if monitor.timer != nil {
+			monitor.timer.Stop()
+		}
+	}
+
+	close(paths.events)
+}
+
+// recordMessageFrom updates the status of the monitored peer, if any, that sent the
+// PDU.  The Recovery restart counter in an Echo Response is handled by
+// handleEchoResult() instead.
+func (paths *pathManager) recordMessageFrom(peer net.Addr, pdu *PDU, now time.Time) {
+	paths.lock.Lock()
+	defer paths.lock.Unlock()
+
+	monitor, isMonitored := paths.monitors[peer.String()]
+	if !isMonitored || paths.isClosed {
+		return
+	}
+
+	monitor.status.LastSeen = now
+
+	if pdu.Type == EchoRequest {
+		paths.recordRecoveryRestartCounter(monitor, pdu)
+	}
+}
+
+// recordRecoveryRestartCounter updates the monitor from the Recovery restart counter
+// in the Echo Request or Echo Response from the peer, emitting
+// PathEventPeerRestarted if it has changed.  The path manager lock must be held.
+func (paths *pathManager) recordRecoveryRestartCounter(monitor *peerMonitor, pdu *PDU) {
+	recovery := pdu.FirstIEOfType(RecoveryRestartCounter)
+	if recovery == nil || len(recovery.Data) < 1 {
+		return
+	}
+
+	restartCounter := recovery.Data[0]
+	previouslyKnown, previousCounter := monitor.status.RecoveryRestartCounterIsKnown, monitor.status.RecoveryRestartCounter
+
+	monitor.status.RecoveryRestartCounter = restartCounter
+	monitor.status.RecoveryRestartCounterIsKnown = true
+
+	if previouslyKnown && previousCounter != restartCounter {
+		paths.emit(PathEventPeerRestarted, monitor)
+	}
+}
+
+// PathEvents returns the channel on which changes to the paths to monitored peers
+// are delivered.  If the channel is full, further events are dropped until there is
+// room.  The channel is closed when the Endpoint is closed.  Returns nil if path
+// management is not enabled (see EndpointOptions).
+func (endpoint *Endpoint) PathEvents() <-chan PathEvent {
+	if endpoint.paths == nil {
+		return nil
+	}
+
+	return endpoint.paths.events
+}
+
+// MonitorPeer starts path management for the peer.  An Echo Request is sent to the
+// peer immediately, and then EchoInterval after each Echo Request is answered or
+// fails (see EndpointOptions).  Monitoring a peer that is already monitored has no
+// effect.  Returns an error if path management is not enabled, or if the Endpoint
+// is closed.
+func (endpoint *Endpoint) MonitorPeer(peer net.Addr) error {
+	if endpoint.paths == nil {
+		return fmt.Errorf("path management is not enabled for the endpoint")
+	}
+
+	paths := endpoint.paths
+
+	paths.lock.Lock()
+	if paths.isClosed {
+		paths.lock.Unlock()
+		return ErrEndpointClosed
+	}
+
+	if _, isMonitored := paths.monitors[peer.String()]; isMonitored {
+		paths.lock.Unlock()
+		return nil
+	}
+
+	monitor := &peerMonitor{status: PeerStatus{Peer: peer}}
+	paths.monitors[peer.String()] = monitor
+	paths.lock.Unlock()
+
+	endpoint.sendEchoRequest(monitor)
+
+	return nil
+}
+
+// StopMonitoringPeer stops path management for the peer, and discards its status.
+// An Echo Request to the peer that is waiting for an answer is not cancelled, but
+// its outcome is ignored.
+func (endpoint *Endpoint) StopMonitoringPeer(peer net.Addr) {
+	if endpoint.paths == nil {
+		return
+	}
+
+	paths := endpoint.paths
+
+	paths.lock.Lock()
+	defer paths.lock.Unlock()
+
+	if monitor, isMonitored := paths.monitors[peer.String()]; isMonitored {
+		monitor.isStopped = true
+		if monitor.timer != nil {
+			monitor.timer.Stop()
+		}
+		delete(paths.monitors, peer.String())
+	}
+}
+
+// PeerStatus returns the status of the monitored peer.  The boolean is false if the
+// peer is not monitored.
+func (endpoint *Endpoint) PeerStatus(peer net.Addr) (PeerStatus, bool) {
+	if endpoint.paths == nil {
+		return PeerStatus{}, false
+	}
+
+	endpoint.paths.lock.Lock()
+	defer endpoint.paths.lock.Unlock()
+
+	monitor, isMonitored := endpoint.paths.monitors[peer.String()]
+	if !isMonitored {
+		return PeerStatus{}, false
+	}
+
+	return monitor.status, true
+}
+
+// Peers returns the status of every monitored peer, in no particular order
+func (endpoint *Endpoint) Peers() []PeerStatus {
+	if endpoint.paths == nil {
+		return nil
+	}
+
+	endpoint.paths.lock.Lock()
+	defer endpoint.paths.lock.Unlock()
+
+	statuses := make([]PeerStatus, 0, len(endpoint.paths.monitors))
+	for _, monitor := range endpoint.paths.monitors {
+		statuses = append(statuses, monitor.status)
+	}
+
+	return statuses
+}
+
+func (endpoint *Endpoint) newEchoPDU(messageType MessageType) *PDU {
+	return NewPDU(messageType, 0, []*IE{NewIEWithRawData(RecoveryRestartCounter, []byte{endpoint.options.RecoveryRestartCounter})})
+}
+
+// answerEchoRequest sends the Echo Response for the Echo Request
+func (endpoint *Endpoint) answerEchoRequest(peer net.Addr, request *PDU) {
+	endpoint.SendResponse(peer, request, endpoint.newEchoPDU(EchoResponse))
+}
+
+// sendEchoRequest sends an Echo Request to the monitored peer.  If T3 is negative,
+// so that the Echo Request would not otherwise time out, it is cancelled after
+// EchoTimeoutWithoutT3.
+func (endpoint *Endpoint) sendEchoRequest(monitor *peerMonitor) {
+	paths := endpoint.paths
+
+	paths.lock.Lock()
+	isStopped := monitor.isStopped
+	paths.lock.Unlock()
+
+	if isStopped {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var timeoutTimer Timer
+	if endpoint.options.T3 < 0 {
+		timeoutTimer = endpoint.options.Clock.AfterFunc(EchoTimeoutWithoutT3, cancel)
+	}
+
+	endpoint.sendRequest(ctx, monitor.status.Peer, endpoint.newEchoPDU(EchoRequest), func(result *RequestResult, sentAt time.Time) {
+		if timeoutTimer != nil {
+			timeoutTimer.Stop()
+		}
+		cancel()
+
+		endpoint.handleEchoResult(monitor, result, sentAt)
+	})
+}
+
+// handleEchoResult starts the timer for the next Echo Request to the monitored peer,
+// and updates the monitor from the outcome of this one, which was last transmitted
+// at sentAt
+func (endpoint *Endpoint) handleEchoResult(monitor *peerMonitor, result *RequestResult, sentAt time.Time) {
+	if result.Err == ErrEndpointClosed {
+		return
+	}
+
+	now := endpoint.options.Clock.Now()
+	paths := endpoint.paths
+
+	paths.lock.Lock()
+	defer paths.lock.Unlock()
+
+	if monitor.isStopped {
+		return
+	}
+
+	monitor.timer = endpoint.options.Clock.AfterFunc(endpoint.options.EchoInterval, func() {
+		endpoint.sendEchoRequest(monitor)
+	})
+
+	if result.Err == nil {
+		monitor.status.RTT = now.Sub(sentAt)
+		monitor.status.UnansweredEchoes = 0
+		paths.recordRecoveryRestartCounter(monitor, result.Response)
+
+		if monitor.status.State != PathStateUp {
+			monitor.status.State = PathStateUp
+			paths.emit(PathEventUp, monitor)
+		}
+	} else {
+		monitor.status.UnansweredEchoes++
+
+		if monitor.status.State != PathStateDown && monitor.status.UnansweredEchoes >= endpoint.options.PathFailureEchoes {
+			monitor.status.State = PathStateDown
+			paths.emit(PathEventDown, monitor)
+		}
+	}
+}
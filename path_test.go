@@ -0,0 +1,256 @@
+package gtpv2
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+func expectPathEvent(t *testing.T, endpoint *Endpoint, kind PathEventKind) PathEvent {
+	select {
+	case event := <-endpoint.PathEvents():
+		if event.Kind != kind {
+			t.Fatalf("[TestEndpointPathManagement] expected path event (%s), got (%s)", kind, event.Kind)
+		}
+		return event
+	case <-time.After(time.Second):
+		t.Fatalf("[TestEndpointPathManagement] expected path event (%s), got none", kind)
+	}
+
+	return PathEvent{}
+}
+
+func expectNoPathEvent(t *testing.T, endpoint *Endpoint) {
+	select {
+	case event := <-endpoint.PathEvents():
+		t.Errorf("[TestEndpointPathManagement] expected no path event, got (%s)", event.Kind)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// readEchoRequest reads an Echo Request from the peer socket and, unless
+// restartCounter is negative, answers it with that Recovery restart counter
+func readEchoRequest(t *testing.T, peer net.PacketConn, endpoint *Endpoint, restartCounter int) {
+	datagram := readDatagram(peer, time.Second)
+	if datagram == nil {
+		t.Fatalf("[TestEndpointPathManagement] expected Echo Request, got none")
+	}
+
+	request, _, err := DecodePDU(datagram)
+	if err != nil || request.Type != EchoRequest {
+		t.Fatalf("[TestEndpointPathManagement] expected Echo Request, got (%v) with error (%v)", request, err)
+	}
+
+	if recovery := request.FirstIEOfType(RecoveryRestartCounter); recovery == nil || recovery.Data[0] != 0x21 {
+		t.Errorf("[TestEndpointPathManagement] expected Echo Request with local Recovery restart counter (0x21)")
+	}
+
+	if restartCounter >= 0 {
+		response := NewPDU(EchoResponse, request.SequenceNumber, []*IE{NewIEWithRawData(RecoveryRestartCounter, []byte{byte(restartCounter)})})
+		peer.WriteTo(response.Encode(), endpoint.LocalAddr())
+	}
+}
+
+func TestEndpointPathManagement(t *testing.T) {
+	clock := newTestClock()
+
+	endpoint, err := ListenEndpointWithOptions("127.0.0.1:0", EndpointOptions{
+		Clock:                  clock,
+		T3:                     time.Second,
+		N3:                     -1,
+		PathManagement:         true,
+		RecoveryRestartCounter: 0x21,
+		EchoInterval:           10 * time.Second,
+		PathFailureEchoes:      2,
+	})
+	if err != nil {
+		t.Fatalf("[TestEndpointPathManagement] on ListenEndpointWithOptions() expected no error, got = (%s)", err)
+	}
+
+	peer, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("[TestEndpointPathManagement] on ListenPacket() expected no error, got = (%s)", err)
+	}
+	defer peer.Close()
+
+	if err := endpoint.MonitorPeer(peer.LocalAddr()); err != nil {
+		t.Fatalf("[TestEndpointPathManagement] on MonitorPeer() expected no error, got = (%s)", err)
+	}
+
+	readEchoRequest(t, peer, endpoint, 3)
+	event := expectPathEvent(t, endpoint, PathEventUp)
+	if event.Status.State != PathStateUp || event.Status.RecoveryRestartCounter != 3 || !event.Status.RecoveryRestartCounterIsKnown || event.Status.LastSeen != clock.Now() {
+		t.Errorf("[TestEndpointPathManagement] unexpected status after first Echo Response: %+v", event.Status)
+	}
+
+	// a different Recovery restart counter means the peer restarted
+	clock.Advance(10 * time.Second)
+	readEchoRequest(t, peer, endpoint, 4)
+	if event := expectPathEvent(t, endpoint, PathEventPeerRestarted); event.Status.RecoveryRestartCounter != 4 {
+		t.Errorf("[TestEndpointPathManagement] expected Recovery restart counter (4) after restart, got (%d)", event.Status.RecoveryRestartCounter)
+	}
+
+	// the path fails after two unanswered Echo Requests
+	for i := 1; i <= 2; i++ {
+		clock.Advance(10 * time.Second)
+		readEchoRequest(t, peer, endpoint, -1)
+		clock.Advance(time.Second)
+
+		if i == 1 {
+			expectNoPathEvent(t, endpoint)
+			if status, _ := endpoint.PeerStatus(peer.LocalAddr()); status.UnansweredEchoes != 1 || status.State != PathStateUp {
+				t.Errorf("[TestEndpointPathManagement] unexpected status after one unanswered Echo Request: %+v", status)
+			}
+		}
+	}
+
+	if event := expectPathEvent(t, endpoint, PathEventDown); event.Status.UnansweredEchoes != 2 || event.Status.State != PathStateDown {
+		t.Errorf("[TestEndpointPathManagement] unexpected status after path failure: %+v", event.Status)
+	}
+
+	// the path is restored when an Echo Request is answered again
+	clock.Advance(10 * time.Second)
+	readEchoRequest(t, peer, endpoint, 4)
+	if event := expectPathEvent(t, endpoint, PathEventUp); event.Status.UnansweredEchoes != 0 {
+		t.Errorf("[TestEndpointPathManagement] expected no unanswered Echo Requests after path restored, got (%d)", event.Status.UnansweredEchoes)
+	}
+
+	if statuses := endpoint.Peers(); len(statuses) != 1 || statuses[0].Peer.String() != peer.LocalAddr().String() {
+		t.Errorf("[TestEndpointPathManagement] expected one monitored peer, got (%d)", len(statuses))
+	}
+
+	endpoint.StopMonitoringPeer(peer.LocalAddr())
+	clock.Advance(10 * time.Second)
+
+	if datagram := readDatagram(peer, 50*time.Millisecond); datagram != nil {
+		t.Errorf("[TestEndpointPathManagement] expected no Echo Request after StopMonitoringPeer(), got one")
+	}
+
+	if _, isMonitored := endpoint.PeerStatus(peer.LocalAddr()); isMonitored {
+		t.Errorf("[TestEndpointPathManagement] expected peer not to be monitored after StopMonitoringPeer()")
+	}
+
+	endpoint.Close()
+
+	if _, isOpen := <-endpoint.PathEvents(); isOpen {
+		t.Errorf("[TestEndpointPathManagement] expected PathEvents() to be closed after Close()")
+	}
+
+	if err := endpoint.MonitorPeer(peer.LocalAddr()); err != ErrEndpointClosed {
+		t.Errorf("[TestEndpointPathManagement] on MonitorPeer() after Close() expected error (%s), got = (%v)", ErrEndpointClosed, err)
+	}
+}
+
+func TestEndpointAnswersEchoRequests(t *testing.T) {
+	server, err := ListenEndpointWithOptions("127.0.0.1:0", EndpointOptions{PathManagement: true, RecoveryRestartCounter: 0x07})
+	if err != nil {
+		t.Fatalf("[TestEndpointAnswersEchoRequests] on ListenEndpointWithOptions() expected no error, got = (%s)", err)
+	}
+	defer server.Close()
+
+	client := listenEndpointForTest(t)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	response, err := client.SendRequest(ctx, server.LocalAddr(), NewPDU(EchoRequest, 0, []*IE{NewIEWithRawData(RecoveryRestartCounter, []byte{0x01})}))
+	if err != nil {
+		t.Fatalf("[TestEndpointAnswersEchoRequests] on SendRequest() expected no error, got = (%s)", err)
+	}
+
+	if recovery := response.FirstIEOfType(RecoveryRestartCounter); recovery == nil || recovery.Data[0] != 0x07 {
+		t.Errorf("[TestEndpointAnswersEchoRequests] expected Echo Response with Recovery restart counter (0x07)")
+	}
+
+	expectNoIncoming(t, "TestEndpointAnswersEchoRequests", server)
+
+	if err := client.MonitorPeer(server.LocalAddr()); err == nil {
+		t.Errorf("[TestEndpointAnswersEchoRequests] on MonitorPeer() without path management expected error, got none")
+	}
+
+	if client.PathEvents() != nil {
+		t.Errorf("[TestEndpointAnswersEchoRequests] expected nil PathEvents() without path management")
+	}
+}
+
+func TestPathStateAndEventKindNames(t *testing.T) {
+	if PathStateDown.String() != "Down" || PathEventPeerRestarted.String() != "Peer Restarted" {
+		t.Errorf("[TestPathStateAndEventKindNames] expected (Down) and (Peer Restarted), got (%s) and (%s)", PathStateDown, PathEventPeerRestarted)
+	}
+
+	if PathState(9).String() != "unknown path state (9)" {
+		t.Errorf("[TestPathStateAndEventKindNames] expected (unknown path state (9)), got (%s)", PathState(9))
+	}
+}
+
+func listenPathManagementEndpointForTest(t *testing.T, testName string, options EndpointOptions) (*Endpoint, net.PacketConn) {
+	endpoint, err := ListenEndpointWithOptions("127.0.0.1:0", options)
+	if err != nil {
+		t.Fatalf("[%s] on ListenEndpointWithOptions() expected no error, got = (%s)", testName, err)
+	}
+
+	peer, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		endpoint.Close()
+		t.Fatalf("[%s] on ListenPacket() expected no error, got = (%s)", testName, err)
+	}
+
+	if err := endpoint.MonitorPeer(peer.LocalAddr()); err != nil {
+		t.Fatalf("[%s] on MonitorPeer() expected no error, got = (%s)", testName, err)
+	}
+
+	return endpoint, peer
+}
+
+func TestEndpointPathManagementRTTAfterRetransmission(t *testing.T) {
+	clock := newTestClock()
+
+	endpoint, peer := listenPathManagementEndpointForTest(t, "TestEndpointPathManagementRTTAfterRetransmission", EndpointOptions{
+		Clock:                  clock,
+		T3:                     time.Second,
+		N3:                     1,
+		PathManagement:         true,
+		RecoveryRestartCounter: 0x21,
+	})
+	defer endpoint.Close()
+	defer peer.Close()
+
+	// the first transmission is not answered, and the retransmission is answered
+	// 300 milliseconds after it is sent
+	readEchoRequest(t, peer, endpoint, -1)
+	clock.Advance(time.Second)
+	clock.Advance(300 * time.Millisecond)
+	readEchoRequest(t, peer, endpoint, 3)
+
+	if event := expectPathEvent(t, endpoint, PathEventUp); event.Status.RTT != 300*time.Millisecond {
+		t.Errorf("[TestEndpointPathManagementRTTAfterRetransmission] expected RTT (300ms), got (%s)", event.Status.RTT)
+	}
+}
+
+func TestEndpointPathManagementWithoutT3(t *testing.T) {
+	clock := newTestClock()
+
+	endpoint, peer := listenPathManagementEndpointForTest(t, "TestEndpointPathManagementWithoutT3", EndpointOptions{
+		Clock:                  clock,
+		T3:                     -1,
+		PathManagement:         true,
+		RecoveryRestartCounter: 0x21,
+		EchoInterval:           10 * time.Second,
+	})
+	defer endpoint.Close()
+	defer peer.Close()
+
+	readEchoRequest(t, peer, endpoint, -1)
+	clock.Advance(EchoTimeoutWithoutT3 - time.Millisecond)
+	expectNoPathEvent(t, endpoint)
+
+	clock.Advance(time.Millisecond)
+	expectPathEvent(t, endpoint, PathEventDown)
+
+	// monitoring continues after the failed Echo Request
+	clock.Advance(10 * time.Second)
+	readEchoRequest(t, peer, endpoint, 3)
+	expectPathEvent(t, endpoint, PathEventUp)
+}
@@ -3,145 +3,13 @@ package gtpv2
 import (
 	"encoding/binary"
 	"fmt"
+	"io"
+	"math"
 )
 
 // MessageType represents possible GTPv2 message type values
 type MessageType uint8
 
-// GTPv2 MessageTypes
-const (
-	EchoRequest                                MessageType = 1
-	EchoResponse                               MessageType = 2
-	CreateSessionRequest                       MessageType = 32
-	CreateSessionResponse                      MessageType = 33
-	ModifyBearerRequest                        MessageType = 34
-	ModifyBearerResponse                       MessageType = 35
-	DeleteSessionRequest                       MessageType = 36
-	DeleteSessionResponse                      MessageType = 37
-	RemoteUEReportNotification                 MessageType = 40
-	RemoteUEReportAcknowlegement               MessageType = 41
-	ChangeNotificationRequest                  MessageType = 38
-	ChangeNotificationResponse                 MessageType = 39
-	ModifyBearerCommand                        MessageType = 64
-	ModifyBearerFailureIndication              MessageType = 65
-	DeleteBearerCommand                        MessageType = 66
-	DeleteBearerFailureIndication              MessageType = 67
-	BearerResourceCommand                      MessageType = 68
-	BearerResourceFailureIndication            MessageType = 69
-	DownlinkDataNotificationFailureIndication  MessageType = 70
-	TraceSessionActivation                     MessageType = 71
-	TraceSessionDeactivation                   MessageType = 72
-	StopPagingIndication                       MessageType = 73
-	CreateBearerRequest                        MessageType = 95
-	CreateBearerResponse                       MessageType = 96
-	UpdateBearerRequest                        MessageType = 97
-	UpdateBearerResponse                       MessageType = 98
-	DeleteBearerRequest                        MessageType = 99
-	DeleteBearerResponse                       MessageType = 100
-	DeletePDNConnectionSetRequest              MessageType = 101
-	DeletePDNConnectionSetResponse             MessageType = 102
-	PGWDownlinkTriggeringNotification          MessageType = 103
-	PGWDownlinkTriggeringAcknowledge           MessageType = 104
-	IdentificationRequest                      MessageType = 128
-	IdentificationResponse                     MessageType = 129
-	ContextRequest                             MessageType = 130
-	ContextResponse                            MessageType = 131
-	ContextAcknowledge                         MessageType = 132
-	ForwardRelocationRequest                   MessageType = 133
-	ForwardRelocationResponse                  MessageType = 134
-	ForwardRelocationCompleteNotification      MessageType = 135
-	ForwardRelocationCompleteAcknowledge       MessageType = 136
-	ForwardAccessContextNotification           MessageType = 137
-	ForwardAccessContextAcknowledge            MessageType = 138
-	RelocationCancelRequest                    MessageType = 139
-	RelocationCancelResponse                   MessageType = 140
-	ConfigurationTransferTunnel                MessageType = 141
-	DetachNotification                         MessageType = 149
-	DetachAcknowledge                          MessageType = 150
-	CSPagingIndication                         MessageType = 151
-	RANInformationRelay                        MessageType = 152
-	AlertMMENotification                       MessageType = 153
-	AlertMMEAcknowledge                        MessageType = 154
-	UEActivityNotification                     MessageType = 155
-	UEActivityAcknowledge                      MessageType = 156
-	ISRStatusIndication                        MessageType = 157
-	UERegistrationQueryRequest                 MessageType = 158
-	UERegistrationQueryResponse                MessageType = 159
-	CreateForwardingTunnelRequest              MessageType = 160
-	CreateForwardingTunnelResponse             MessageType = 161
-	SuspendNotification                        MessageType = 162
-	SuspendAcknowledge                         MessageType = 163
-	ResumeNotification                         MessageType = 164
-	ResumeAcknowledge                          MessageType = 165
-	CreateIndirectDataForwardingTunnelRequest  MessageType = 166
-	CreateIndirectDataForwardingTunnelResponse MessageType = 167
-	DeleteIndirectDataForwardingTunnelRequest  MessageType = 168
-	DeleteIndirectDataForwardingTunnelResponse MessageType = 169
-	ReleaseAccessBearersRequest                MessageType = 170
-	ReleaseAccessBearersResponse               MessageType = 171
-	DownlinkDataNotification                   MessageType = 176
-	DownlinkDataNotificationAcknowledge        MessageType = 177
-	PGWRestartNotification                     MessageType = 179
-	PGWRestartNotificationAcknowledge          MessageType = 180
-	UpdatePDNConnectionSetRequest              MessageType = 200
-	UpdatePDNConnectionSetResponse             MessageType = 201
-)
-
-var messageNames = []string{
-	"Reserved", "Echo Request", "Echo Response", "Version Not Supported Indication", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 19
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Create Session Request", "Create Session Response", "Modify Bearer Request",
-	"Modify Bearer Response", "Delete Session Request", "Delete Session Response", "Change Notification Request", "Change Notification Response", // 39
-	"Remote UE Report Notification", "Remote UE Report Acknowledge", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 59
-	"Reserved", "Reserved", "Reserved", "Reserved", "Modify Bearer Command",
-	"Modify Bearer Failure Indication", "Delete Bearer Command", "Delete Bearer Failure Indication", "Bearer Resource Command", "Bearer Resource Failure Indication",
-	"Downlink Data Notification Failure Indication", "Trace Session Activation", "Trace Session Deactivation", "Stop Paging Indication", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 79
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Create Bearer Request", "Create Bearer Response", "Update Bearer Request", "Update Bearer Response", "Delete Bearer Request", // 99
-	"Delete Bearer Response", "Delete PDN Connection Set Request", "Delete PDN Connection Set Response", "PGW Downlink Triggering Notification", "PGW Downlink Triggering Acknowledge",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 119
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Identification Request", "Identification Response",
-	"Context Request", "Context Response", "Context Acknowledge", "Forward Relocation Request", "Forward Relocation Response",
-	"Forward Relocation Complete Notification", "Forward Relocation Complete Acknowledge", "Forward Access Context Notification", "Forward Access Context Acknowledge", "Relocation Cancel Request", // 139
-	"Relocation Cancel Response", "Configuration Transfer Tunnel", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Detach Notification",
-	"Detach Acknowledge", "CS Paging Indication", "RAN Information Relay", "Alert MME Notification", "Alert MME Acknowledge",
-	"UE Activity Notification", "UE Activity Acknowledge", "ISR Status Indication", "UE Registration Query Request", "UE Registration Query Response", // 159
-	"Create Forwarding Tunnel Request", "Create Forwarding Tunnel Response", "Suspend Notification", "Suspend Acknowledge", "Resume Notification",
-	"Resume Acknowledge", "Create Indirect Data Forwarding Tunnel Request", "Create Indirect Data Forwarding Tunnel Response", "Delete Indirect Data Forwarding Tunnel Request", "Delete Indirect Data Forwarding Tunnel Response",
-	"Release Access Bearers Request", "Release Access Bearers Response", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Downlink Data Notification", "Downlink Data Notification Acknowledge", "Reserved", "PGW Restart Notification", // 179
-	"PGW Restart Notification Acknowledge", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 199
-	"Update PDN Connection Set Request", "Update PDN Connection Set Response", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Modify Access Bearers Request", "Modify Access Bearers Response", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved", // 219
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "MBMS Session Start Request", "MBMS Session Start Response", "MBMS Session Update Request", "MBMS Session Update Response",
-	"MBMS Session Stop Request", "MBMS Session Stop Response", "Reserved", "Reserved", "Reserved", // 239
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", "Reserved", "Reserved", "Reserved", "Reserved",
-	"Reserved", // 255
-}
-
 // NameOfMessageForType returns a string identifier (from TS 29.274 section 8.1) for
 // a GTPv2 IE based on the type integer value
 func NameOfMessageForType(msgType MessageType) string {
@@ -168,18 +36,19 @@ type PDU struct {
 
 // NewPDU constructs a new base GTPv2 PDU.  It uses a builder pattern to
 // add non-mandatory elements, including a TEID and a priority.  A piggybacked
-// PDU is added at the time of encoding and revealed on decoding.  If you change
-// struct values after construction, Encode() may not operate as expected and may
-// even panic, so the struct values should usually be treated as read-only.
-// This version of the constructor will panic if the length of the IEs exceeds
-// the maximum PDU length.  If you want to be able to catch this condition,
-// construct the PDU struct manually.
+// PDU is added at the time of encoding (see EncodeWithPiggybackedPDU()) and
+// revealed on decoding.  If you change struct values directly after construction,
+// Encode() may not operate as expected and may even panic, so use the editing
+// methods (e.g., AddIE(), SetTEID()), which keep TotalLength consistent, or call
+// RecomputeTotalLength() afterward.  This version of the constructor will panic
+// if the length of the IEs exceeds the maximum PDU length.  If you want to be
+// able to catch this condition, construct the PDU struct manually.
 func NewPDU(pduType MessageType, sequenceNumber uint32, ies []*IE) *PDU {
 	pduLength := uint32(8)
 
 	for _, ie := range ies {
-		// compute of IE length is data length + 4 bytes for IE header
-		pduLength += uint32(len(ie.Data) + 4)
+		// compute of IE length is data length + 4 (or 6, for extended types) bytes for IE header
+		pduLength += uint32(encodedLengthOfIE(ie))
 	}
 
 	if pduLength > 0xffff {
@@ -199,13 +68,10 @@ func NewPDU(pduType MessageType, sequenceNumber uint32, ies []*IE) *PDU {
 	}
 }
 
-// AddTEID sets the TEID field and the teid presence flag
+// AddTEID sets the TEID field and the teid presence flag.  It is the same
+// as SetTEID().
 func (pdu *PDU) AddTEID(teid uint32) *PDU {
-	pdu.TEIDFieldIsPresent = true
-	pdu.TEID = teid
-	pdu.TotalLength += 4
-
-	return pdu
+	return pdu.SetTEID(teid)
 }
 
 // AddPriority sets the priority field and the priority presence flag
@@ -218,134 +84,320 @@ func (pdu *PDU) AddPriority(priority uint8) *PDU {
 // Encode encodes the GTPv2 PDU as a byte stream in network byte order,
 // suitable for trasmission.
 func (pdu *PDU) Encode() []byte {
-	encoded := make([]byte, pdu.TotalLength)
+	return pdu.AppendEncode(make([]byte, 0, pdu.TotalLength))
+}
 
-	encoded[0] = 0x40
-	encoded[1] = uint8(pdu.Type)
-	binary.BigEndian.PutUint16(encoded[2:4], pdu.TotalLength-4)
+// AppendEncode is the same as Encode(), but appends the encoded PDU to dst and
+// returns the extended slice.  Each IE is encoded directly into dst (see
+// IE.AppendEncode()), so if dst has sufficient capacity, nothing is allocated,
+// and dst may be a buffer that is reused (e.g., from a pool).  The length field
+// in the header is computed from the encoded IEs, rather than taken from
+// TotalLength.
+func (pdu *PDU) AppendEncode(dst []byte) []byte {
+	start := len(dst)
 
-	ieOffsetByteIndex := 0
+	flags := byte(0x40)
+	sequenceNumber := pdu.SequenceNumber << 8
 
 	if pdu.TEIDFieldIsPresent {
-		encoded[0] |= 0x08
-		binary.BigEndian.PutUint32(encoded[4:8], pdu.TEID)
-		binary.BigEndian.PutUint32(encoded[8:12], pdu.SequenceNumber<<8)
-
+		flags |= 0x08
 		if pdu.PriorityFieldIsPresent {
-			encoded[0] |= 0x04
-			encoded[11] = pdu.Priority << 4
+			flags |= 0x04
+			sequenceNumber |= uint32(pdu.Priority << 4)
 		}
-		ieOffsetByteIndex = 12
+
+		dst = append(dst, flags, byte(pdu.Type), 0, 0, byte(pdu.TEID>>24), byte(pdu.TEID>>16), byte(pdu.TEID>>8), byte(pdu.TEID))
 	} else {
-		binary.BigEndian.PutUint32(encoded[4:8], pdu.SequenceNumber<<8)
-		ieOffsetByteIndex = 8
+		dst = append(dst, flags, byte(pdu.Type), 0, 0)
 	}
 
+	dst = append(dst, byte(sequenceNumber>>24), byte(sequenceNumber>>16), byte(sequenceNumber>>8), byte(sequenceNumber))
+
 	for _, ie := range pdu.InformationElements {
-		encodedIE := ie.Encode()
-		offsetForEndOfIE := ieOffsetByteIndex + len(encodedIE)
+		dst = ie.AppendEncode(dst)
+	}
+
+	binary.BigEndian.PutUint16(dst[start+2:start+4], uint16(len(dst)-start-4))
+
+	return dst
+}
+
+// WriteTo writes the encoded PDU (see Encode()) to w, using a single call to
+// w.Write(), so w may be a datagram socket (e.g., a *net.UDPConn).  The PDU is
+// encoded into a pooled buffer, so nothing is allocated for the encoding.  It
+// satisfies io.WriterTo.
+func (pdu *PDU) WriteTo(w io.Writer) (int64, error) {
+	bufferPointer := encodeBufferPool.Get().(*[]byte)
+	encoded := pdu.AppendEncode((*bufferPointer)[:0])
 
-		copy(encoded[ieOffsetByteIndex:offsetForEndOfIE], encodedIE)
+	bytesWritten, err := w.Write(encoded)
 
-		ieOffsetByteIndex = offsetForEndOfIE
+	*bufferPointer = encoded[:0]
+	encodeBufferPool.Put(bufferPointer)
+
+	return int64(bytesWritten), err
+}
+
+// allowedPiggybackedMessageTypes maps a message type to the message types that
+// may be piggybacked on it (TS 29.274 section 5.5.1)
+var allowedPiggybackedMessageTypes = map[MessageType][]MessageType{
+	CreateSessionResponse: {CreateBearerRequest},
+	ModifyBearerRequest:   {CreateBearerResponse},
+}
+
+// PiggybackingIsAllowed returns true if a PDU of type piggybackedType may be
+// piggybacked on a PDU of type primaryType
+func PiggybackingIsAllowed(primaryType MessageType, piggybackedType MessageType) bool {
+	for _, allowedType := range allowedPiggybackedMessageTypes[primaryType] {
+		if allowedType == piggybackedType {
+			return true
+		}
 	}
 
-	return encoded
+	return false
+}
+
+// EncodeWithPiggybackedPDU encodes the PDU followed by the piggybacked PDU, as a
+// single byte stream in network byte order.  The piggyback flag is set in the header
+// of this PDU and cleared in the header of the piggybacked PDU, regardless of the
+// IsCarryingPiggybackedPDU fields.  Returns an error if the piggybacked PDU message
+// type may not be piggybacked on this PDU message type.
+func (pdu *PDU) EncodeWithPiggybackedPDU(piggybacked *PDU) ([]byte, error) {
+	if piggybacked == nil {
+		return nil, fmt.Errorf("piggybacked PDU is nil")
+	}
+
+	if !PiggybackingIsAllowed(pdu.Type, piggybacked.Type) {
+		return nil, fmt.Errorf("%s may not be piggybacked on %s", NameOfMessageForType(piggybacked.Type), NameOfMessageForType(pdu.Type))
+	}
+
+	encoded := pdu.AppendEncode(make([]byte, 0, int(pdu.TotalLength)+int(piggybacked.TotalLength)))
+	piggybackedStart := len(encoded)
+	encoded = piggybacked.AppendEncode(encoded)
+
+	encoded[0] |= 0x10
+	encoded[piggybackedStart] &^= 0x10
+
+	return encoded, nil
+}
+
+// DecodeOptions changes the behavior of DecodePDUWithOptions()
+type DecodeOptions struct {
+	// StrictHeader causes decoding to fail with a *HeaderError if the PDU header
+	// does not conform to TS 29.274 section 5.5 (see PDU.ValidateHeader()).  When
+	// it is false, non-conforming headers are decoded as well as possible.
+	StrictHeader bool
 }
 
 // DecodePDU decodes a stream of bytes that contain either exactly one well-formed
 // GTPv2 PDU, or two GTPv2 PDUs when the piggyback flag on the first is set to true.
-// Returns an error if the stream cannot be decoded into one or two PDUs.
+// Returns a *DecodeError if the stream cannot be decoded into one or two PDUs.  Header
+// fields that do not conform to TS 29.274 section 5.5 are tolerated.  To reject
+// them, use DecodePDUWithOptions().
 func DecodePDU(stream []byte) (pdu *PDU, piggybackedPdu *PDU, err error) {
-	piggybackedPdu = nil
+	return DecodePDUWithOptions(stream, DecodeOptions{})
+}
+
+// DecodePDUWithOptions is the same as DecodePDU(), but with behavior changed by
+// the provided options
+func DecodePDUWithOptions(stream []byte, options DecodeOptions) (pdu *PDU, piggybackedPdu *PDU, err error) {
+	pdu = &PDU{}
+
+	if len(stream) > 0 && (stream[0]&0x10) == 0x10 {
+		piggybackedPdu = &PDU{}
+	}
+
+	if err := decodePDUInto(stream, pdu, piggybackedPdu, options, false); err != nil {
+		return nil, nil, err
+	}
 
+	return pdu, piggybackedPdu, nil
+}
+
+// DecodePDUInto is the same as DecodePDUWithOptions(), but decodes into the provided
+// PDU rather than allocating a new one, so that a caller decoding many messages can
+// reuse the same PDU for each.  The IEs referenced by pdu.InformationElements, up to
+// the capacity of that slice, are overwritten and reused, and additional IEs are
+// allocated only when there are more IEs in stream than on any previous decode into
+// the PDU.  Thus, once the PDU has been used for the largest message, decoding
+// allocates nothing.  The Data of each decoded IE is not copied: it references
+// (aliases) stream, so stream must not be modified or reused while the PDU is in use,
+// and an IE or its Data must be copied if it is needed after the next decode into the
+// PDU.  If the piggyback flag is set in stream, the piggybacked PDU is decoded in the
+// same way into piggybackedPdu, which must not be nil in that case.  Whether it was
+// is indicated by pdu.IsCarryingPiggybackedPDU.  If an error is returned, the content
+// of both PDUs is undefined.
+func DecodePDUInto(stream []byte, pdu *PDU, piggybackedPdu *PDU, options DecodeOptions) error {
+	if err := decodePDUInto(stream, pdu, piggybackedPdu, options, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// decodePDUInto decodes stream into pdu and, if the piggyback flag is set,
+// piggybackedPdu.  If aliasData is true, IE Data references stream.
+func decodePDUInto(stream []byte, pdu *PDU, piggybackedPdu *PDU, options DecodeOptions, aliasData bool) *DecodeError {
 	if len(stream) < 8 {
-		return nil, nil, fmt.Errorf("stream length (%d) too short for a GTPv2 PDU", len(stream))
+		return &DecodeError{Kind: DecodeErrorTruncated, ExpectedLength: 8, ActualLength: len(stream), Message: fmt.Sprintf("stream length (%d) too short for a GTPv2 PDU", len(stream))}
 	}
 
 	if (stream[0] >> 5) != 2 {
-		return nil, nil, fmt.Errorf("GTPv2 PDU version should be 2, but in stream, it is (%d)", (stream[0] >> 5))
+		return &DecodeError{Kind: DecodeErrorBadVersion, Message: fmt.Sprintf("GTPv2 PDU version should be 2, but in stream, it is (%d)", (stream[0] >> 5))}
 	}
 
 	hasPiggybackedPdu := (stream[0] & 0x10) == 0x10
 
 	msgLengthFieldValue := binary.BigEndian.Uint16(stream[2:4])
-	totalPduLength := msgLengthFieldValue + 4
+	totalPduLength := int(msgLengthFieldValue) + 4
+
+	if len(stream) < totalPduLength {
+		return &DecodeError{Kind: DecodeErrorTruncated, ExpectedLength: totalPduLength, ActualLength: len(stream), Message: fmt.Sprintf("GTPv2 PDU length field is (%d), so total length should be (%d), but stream length is (%d)", msgLengthFieldValue, totalPduLength, len(stream))}
+	}
 
-	if len(stream) < int(totalPduLength) {
-		return nil, nil, fmt.Errorf("GTPv2 PDU length field is (%d), so total length should be (%d), but stream length is (%d)", msgLengthFieldValue, totalPduLength, len(stream))
+	if totalPduLength > math.MaxUint16 {
+		return &DecodeError{Kind: DecodeErrorLengthMismatch, ExpectedLength: math.MaxUint16, ActualLength: totalPduLength, Message: fmt.Sprintf("GTPv2 PDU length field is (%d), so its total length (%d) exceeds the largest PDU total length (%d)", msgLengthFieldValue, totalPduLength, math.MaxUint16)}
+	}
+
+	hasTeidField := (stream[0] & 0x08) == 0x08
+
+	headerLength := 8
+	if hasTeidField {
+		headerLength = 12
+	}
+
+	if totalPduLength < headerLength {
+		return &DecodeError{Kind: DecodeErrorLengthMismatch, ExpectedLength: headerLength, ActualLength: totalPduLength, Message: fmt.Sprintf("GTPv2 PDU length field (%d) is too short for the (%d) octet header", msgLengthFieldValue, headerLength)}
+	}
+
+	if options.StrictHeader {
+		if err := validateEncodedHeader(stream); err != nil {
+			return &DecodeError{Kind: DecodeErrorInvalidHeader, Err: err}
+		}
 	}
 
 	if !hasPiggybackedPdu {
-		if len(stream) != int(totalPduLength) {
-			return nil, nil, fmt.Errorf("GTPv2 PDU length field is (%d), so total length should be (%d), but stream length is (%d)", msgLengthFieldValue, totalPduLength, len(stream))
+		if len(stream) != totalPduLength {
+			return &DecodeError{Kind: DecodeErrorLengthMismatch, ExpectedLength: totalPduLength, ActualLength: len(stream), Message: fmt.Sprintf("GTPv2 PDU length field is (%d), so total length should be (%d), but stream length is (%d)", msgLengthFieldValue, totalPduLength, len(stream))}
 		}
 	} else {
 		piggybackedPduStream := stream[totalPduLength:]
 
+		if len(piggybackedPduStream) == 0 {
+			return &DecodeError{Kind: DecodeErrorInvalidPiggyback, Offset: totalPduLength, Message: "GTPv2 PDU piggyback flag is set, but there is no piggybacked PDU in stream"}
+		}
+
 		if (piggybackedPduStream[0] & 0x10) != 0 {
-			return nil, nil, fmt.Errorf("GTPv2 PDU has piggybacked PDU but the piggyback flag for that piggybacked PDU is not 0")
+			return &DecodeError{Kind: DecodeErrorInvalidPiggyback, Offset: totalPduLength, InPiggybackedPDU: true, Message: "GTPv2 PDU has piggybacked PDU but the piggyback flag for that piggybacked PDU is not 0"}
 		}
 
-		piggybackedPdu, _, err = DecodePDU(piggybackedPduStream)
+		if piggybackedPdu == nil {
+			return &DecodeError{Kind: DecodeErrorInvalidPiggyback, Offset: totalPduLength, InPiggybackedPDU: true, Message: "GTPv2 PDU has piggybacked PDU but no PDU was provided to decode it into"}
+		}
 
-		if err != nil {
-			return nil, nil, fmt.Errorf("on piggybacked PDU: %s", err)
+		if err := decodePDUInto(piggybackedPduStream, piggybackedPdu, nil, options, aliasData); err != nil {
+			decodeError := *err
+			decodeError.Offset += totalPduLength
+			decodeError.InPiggybackedPDU = true
+			return &decodeError
 		}
 
-		if len(stream) != int(totalPduLength)+int(piggybackedPdu.TotalLength) {
-			return nil, nil, fmt.Errorf("stream contains more than single PDU and piggybacked PDU")
+		if len(stream) != totalPduLength+int(piggybackedPdu.TotalLength) {
+			return &DecodeError{
+				Kind:           DecodeErrorLengthMismatch,
+				ExpectedLength: totalPduLength + int(piggybackedPdu.TotalLength),
+				ActualLength:   len(stream),
+				Message:        "stream contains more than single PDU and piggybacked PDU",
+			}
 		}
 	}
 
 	teid := uint32(0)
 	sequenceNumber := uint32(0)
-	var headerLength int
-	hasTeidField := false
 
-	if (stream[0] & 0x08) == 0x08 {
-		hasTeidField = true
+	// the message priority is carried only in the TEID form of the header, so
+	// without a TEID, the MP flag is ignored
+	hasPriorityField := hasTeidField && (stream[0]&0x04) == 0x04
+	priority := uint8(0)
+
+	if hasTeidField {
 		teid = binary.BigEndian.Uint32(stream[4:8])
 		sequenceNumber = binary.BigEndian.Uint32(stream[8:12]) >> 8
-		headerLength = 12
+
+		if hasPriorityField {
+			priority = (uint8(stream[11]) & 0xf0) >> 4
+		}
 	} else {
 		sequenceNumber = binary.BigEndian.Uint32(stream[4:8]) >> 8
-		headerLength = 8
 	}
 
-	hasPriorityField := (stream[0] & 0x04) == 0x04
+	ieSet := pdu.InformationElements[:0]
+	if ieSet == nil {
+		ieSet = make([]*IE, 0, 10)
+	}
 
-	priority := uint8(0)
-	if hasPriorityField {
-		priority = (uint8(stream[11]) & 0xf0) >> 4
+	ieSet, err := decodeIEsInto(stream[headerLength:totalPduLength], headerLength, ieSet, aliasData)
+	if err != nil {
+		return err
 	}
 
-	pdu = &PDU{
+	*pdu = PDU{
 		IsCarryingPiggybackedPDU: hasPiggybackedPdu,
 		TEIDFieldIsPresent:       hasTeidField,
 		PriorityFieldIsPresent:   hasPriorityField,
 		TEID:                     teid,
 		Priority:                 priority,
 		SequenceNumber:           sequenceNumber,
-		TotalLength:              totalPduLength,
+		TotalLength:              uint16(totalPduLength),
 		Type:                     MessageType(stream[1]),
+		InformationElements:      ieSet,
 	}
 
-	ieSet := make([]*IE, 0, 10)
-
-	for i := headerLength; i < int(totalPduLength); {
-		nextIEInStream, err := DecodeIE(stream[i:])
+	return nil
+}
 
-		if err != nil {
-			return nil, nil, err
+// decodeIEsInto decodes the IEs in stream, which starts at offset in the stream
+// being decoded, appending them to ies.  An IE referenced by ies beyond its length
+// (but within its capacity) is reused rather than allocating a new IE.  If
+// aliasData is true, IE Data references stream.
+func decodeIEsInto(stream []byte, offset int, ies []*IE, aliasData bool) ([]*IE, *DecodeError) {
+	firstIndex := len(ies)
+
+	for i := 0; i < len(stream); {
+		var ie *IE
+		ies, ie = appendReusableIE(ies)
+
+		if err := decodeIEInto(stream[i:], ie, aliasData); err != nil {
+			ieType := ieTypeAtStartOf(stream[i:])
+			precedingCount := 0
+			for _, precedingIE := range ies[firstIndex : len(ies)-1] {
+				if precedingIE.Type == ieType {
+					precedingCount++
+				}
+			}
+			return nil, err.atIE(pathSegmentForIE(ieType, precedingCount), offset+i)
 		}
 
-		ieSet = append(ieSet, nextIEInStream)
-
-		i += int(nextIEInStream.TotalLength)
+		i += int(ie.TotalLength)
 	}
 
-	pdu.InformationElements = ieSet
+	return ies, nil
+}
 
-	return pdu, piggybackedPdu, nil
+// appendReusableIE extends ies by one element and returns the IE in that element,
+// which is the IE already referenced beyond the length of ies, if there is one, or
+// a new IE otherwise
+func appendReusableIE(ies []*IE) ([]*IE, *IE) {
+	if len(ies) < cap(ies) {
+		ies = ies[:len(ies)+1]
+		if ie := ies[len(ies)-1]; ie != nil {
+			return ies, ie
+		}
+		ie := &IE{}
+		ies[len(ies)-1] = ie
+		return ies, ie
+	}
+
+	ie := &IE{}
+	return append(ies, ie), ie
 }
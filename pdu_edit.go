@@ -0,0 +1,239 @@
+package gtpv2
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The methods in this file change a PDU after construction (or after decoding)
+// while keeping TotalLength consistent with the header flags and IEs, so that
+// Encode() continues to operate correctly.  Each method that can fail leaves
+// the PDU unchanged when it returns an error.
+
+func encodedLengthOfIE(ie *IE) int {
+	if ie.Unparsed {
+		return len(ie.Data)
+	}
+
+	return len(ie.Data) + headerLengthForIEType(ie.Type)
+}
+
+func computePDUTotalLength(teidFieldIsPresent bool, ies []*IE) (uint16, error) {
+	length := 8
+	if teidFieldIsPresent {
+		length = 12
+	}
+
+	for _, ie := range ies {
+		length += encodedLengthOfIE(ie)
+	}
+
+	if length > 0xffff {
+		return 0, fmt.Errorf("combined IE lengths exceed maximum PDU length")
+	}
+
+	return uint16(length), nil
+}
+
+func (pdu *PDU) setInformationElements(ies []*IE) error {
+	totalLength, err := computePDUTotalLength(pdu.TEIDFieldIsPresent, ies)
+	if err != nil {
+		return err
+	}
+
+	pdu.InformationElements = ies
+	pdu.TotalLength = totalLength
+
+	return nil
+}
+
+// RecomputeTotalLength sets TotalLength from the header flags and the current
+// IEs.  It is needed only if the struct fields or IE Data are changed directly.
+// Returns an error if the resulting length exceeds the maximum PDU length.
+func (pdu *PDU) RecomputeTotalLength() error {
+	return pdu.setInformationElements(pdu.InformationElements)
+}
+
+// SetTEID sets the TEID field and the teid presence flag
+func (pdu *PDU) SetTEID(teid uint32) *PDU {
+	if !pdu.TEIDFieldIsPresent {
+		pdu.TEIDFieldIsPresent = true
+		pdu.TotalLength += 4
+	}
+
+	pdu.TEID = teid
+
+	return pdu
+}
+
+// ClearTEID removes the TEID field and clears the teid presence flag
+func (pdu *PDU) ClearTEID() *PDU {
+	if pdu.TEIDFieldIsPresent {
+		pdu.TEIDFieldIsPresent = false
+		pdu.TotalLength -= 4
+	}
+
+	pdu.TEID = 0
+
+	return pdu
+}
+
+// SetSequenceNumber sets the sequence number.  Bits beyond the 24-bit field
+// size are discarded.
+func (pdu *PDU) SetSequenceNumber(sequenceNumber uint32) *PDU {
+	pdu.SequenceNumber = sequenceNumber & 0x00ffffff
+	return pdu
+}
+
+// IndexOfIE returns the index in InformationElements of the provided IE (compared
+// by identity, not value), or -1 if the IE is not in the PDU
+func (pdu *PDU) IndexOfIE(ie *IE) int {
+	for i, pduIE := range pdu.InformationElements {
+		if pduIE == ie {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (pdu *PDU) validateIEIndex(index int, allowEnd bool) error {
+	maximumIndex := len(pdu.InformationElements) - 1
+	if allowEnd {
+		maximumIndex++
+	}
+
+	if index < 0 || index > maximumIndex {
+		return fmt.Errorf("IE index (%d) is out of range for PDU with (%d) IEs", index, len(pdu.InformationElements))
+	}
+
+	return nil
+}
+
+// AddIE appends an IE to the PDU
+func (pdu *PDU) AddIE(ie *IE) error {
+	return pdu.InsertIE(len(pdu.InformationElements), ie)
+}
+
+// InsertIE inserts an IE into the PDU so that it is at the provided index.  An index
+// equal to the number of IEs appends it.
+func (pdu *PDU) InsertIE(index int, ie *IE) error {
+	if err := pdu.validateIEIndex(index, true); err != nil {
+		return err
+	}
+
+	ies := make([]*IE, 0, len(pdu.InformationElements)+1)
+	ies = append(ies, pdu.InformationElements[:index]...)
+	ies = append(ies, ie)
+	ies = append(ies, pdu.InformationElements[index:]...)
+
+	return pdu.setInformationElements(ies)
+}
+
+// RemoveIE removes the IE at the provided index from the PDU
+func (pdu *PDU) RemoveIE(index int) error {
+	if err := pdu.validateIEIndex(index, false); err != nil {
+		return err
+	}
+
+	ies := make([]*IE, 0, len(pdu.InformationElements)-1)
+	ies = append(ies, pdu.InformationElements[:index]...)
+	ies = append(ies, pdu.InformationElements[index+1:]...)
+
+	return pdu.setInformationElements(ies)
+}
+
+// ReplaceIE replaces the IE at the provided index with a different IE
+func (pdu *PDU) ReplaceIE(index int, ie *IE) error {
+	if err := pdu.validateIEIndex(index, false); err != nil {
+		return err
+	}
+
+	ies := make([]*IE, len(pdu.InformationElements))
+	copy(ies, pdu.InformationElements)
+	ies[index] = ie
+
+	return pdu.setInformationElements(ies)
+}
+
+// EditGroupedIE changes the IEs inside the grouped IE identified by path (see
+// IE.LookupIE() for the path syntax), which may itself be inside other grouped IEs.
+// The edit function receives the decoded IEs of the grouped IE and returns the IEs
+// that replace them.  The grouped IE and every grouped IE that contains it are then
+// re-encoded, and TotalLength is updated.  The grouped IE is replaced by a new IE,
+// so references to the original IE remain unchanged.
+func (pdu *PDU) EditGroupedIE(path string, edit func(groupedIEs []*IE) ([]*IE, error)) error {
+	ies, err := editGroupedIEAtPath(pdu.InformationElements, strings.Split(path, "/"), edit)
+	if err != nil {
+		return err
+	}
+
+	return pdu.setInformationElements(ies)
+}
+
+// editGroupedIEAtPath returns a copy of ies in which the IE selected by the first
+// path segment is replaced by its edited version
+func editGroupedIEAtPath(ies []*IE, segments []string, edit func(groupedIEs []*IE) ([]*IE, error)) ([]*IE, error) {
+	parsedSegment, err := parseIELookupPathSegment(segments[0])
+	if err != nil {
+		return nil, err
+	}
+
+	target := nthMatchingIE(ies, parsedSegment.ieType, parsedSegment.instance, parsedSegment.index)
+	if target == nil {
+		return nil, fmt.Errorf("no IE matches path segment (%s)", segments[0])
+	}
+
+	groupedIEs, err := ExtractGroupedIEsFrom(target)
+	if err != nil {
+		var decodeError *DecodeError
+		if errors.As(err, &decodeError) {
+			return nil, decodeError.withinIE(segments[0])
+		}
+		return nil, err
+	}
+
+	if len(segments) == 1 {
+		groupedIEs, err = edit(groupedIEs)
+	} else {
+		groupedIEs, err = editGroupedIEAtPath(groupedIEs, segments[1:], edit)
+	}
+
+	if err != nil {
+		if decodeError, isDecodeError := err.(*DecodeError); isDecodeError {
+			return nil, decodeError.withinIE(segments[0])
+		}
+		return nil, err
+	}
+
+	editedIE, err := NewGroupedIEErrorable(target.Type, groupedIEs)
+	if err != nil {
+		return nil, err
+	}
+	editedIE.InstanceNumber = target.InstanceNumber
+
+	editedIEs := make([]*IE, len(ies))
+	for i, ie := range ies {
+		if ie == target {
+			editedIEs[i] = editedIE
+		} else {
+			editedIEs[i] = ie
+		}
+	}
+
+	return editedIEs, nil
+}
+
+// SetData replaces the IE data and updates TotalLength.  Returns an error if the
+// data are too long for an IE.
+func (ie *IE) SetData(data []byte) error {
+	if len(data)+headerLengthForIEType(ie.Type)-4 > 65535 {
+		return fmt.Errorf("data length %d exceeds maximum for an Information Element", len(data))
+	}
+
+	ie.Data = data
+	ie.TotalLength = uint16(len(data) + headerLengthForIEType(ie.Type))
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package gtpv2
+
+import (
+	"net"
+	"testing"
+)
+
+func encodesAndDecodesConsistently(pdu *PDU) error {
+	encoded := pdu.Encode()
+
+	decodedPdu, _, err := DecodePDU(encoded)
+	if err != nil {
+		return err
+	}
+
+	return compareTwoPDUObjects(pdu, decodedPdu)
+}
+
+func TestPDUTEIDEditing(t *testing.T) {
+	pdu := NewPDU(ModifyBearerRequest, 0x1acc, []*IE{NewIEWithRawData(RATType, []byte{0x06})})
+
+	pdu.AddTEID(0x01).AddTEID(0x02).SetTEID(0x03)
+	if pdu.TotalLength != 17 || pdu.TEID != 0x03 {
+		t.Errorf("[TestPDUTEIDEditing] after repeated TEID set, expected TotalLength = (17) and TEID = (3), got = (%d) and (%d)", pdu.TotalLength, pdu.TEID)
+	}
+
+	if err := encodesAndDecodesConsistently(pdu); err != nil {
+		t.Errorf("[TestPDUTEIDEditing] with TEID: %s", err.Error())
+	}
+
+	pdu.ClearTEID().ClearTEID()
+	if pdu.TotalLength != 13 || pdu.TEIDFieldIsPresent {
+		t.Errorf("[TestPDUTEIDEditing] after ClearTEID, expected TotalLength = (13) and no TEID, got = (%d) and (%t)", pdu.TotalLength, pdu.TEIDFieldIsPresent)
+	}
+
+	pdu.SetSequenceNumber(0x01abcdef)
+	if pdu.SequenceNumber != 0xabcdef {
+		t.Errorf("[TestPDUTEIDEditing] expected SetSequenceNumber to truncate to (0xabcdef), got = (0x%x)", pdu.SequenceNumber)
+	}
+
+	if err := encodesAndDecodesConsistently(pdu); err != nil {
+		t.Errorf("[TestPDUTEIDEditing] without TEID: %s", err.Error())
+	}
+}
+
+func TestPDUIEEditing(t *testing.T) {
+	ratType := NewIEWithRawData(RATType, []byte{0x06})
+	recovery := NewIEWithRawData(RecoveryRestartCounter, []byte{0x95})
+
+	pdu := NewPDU(ModifyBearerRequest, 0x1acc, []*IE{ratType}).SetTEID(0x05403b2e)
+
+	if err := pdu.AddIE(recovery); err != nil {
+		t.Fatalf("[TestPDUIEEditing] expected no error on AddIE, got error = (%s)", err.Error())
+	}
+
+	if err := pdu.InsertIE(0, NewIEWithRawData(DelayValue, []byte{0x00})); err != nil {
+		t.Fatalf("[TestPDUIEEditing] expected no error on InsertIE, got error = (%s)", err.Error())
+	}
+
+	if pdu.InformationElements[0].Type != DelayValue || pdu.IndexOfIE(recovery) != 2 || pdu.TotalLength != 27 {
+		t.Errorf("[TestPDUIEEditing] after AddIE and InsertIE, IEs or TotalLength are not as expected, TotalLength = (%d)", pdu.TotalLength)
+	}
+
+	if err := pdu.ReplaceIE(pdu.IndexOfIE(ratType), NewIEWithRawData(ULI, []byte{0x18, 0x00, 0x11, 0x00, 0xff, 0x00, 0x00, 0x11})); err != nil {
+		t.Fatalf("[TestPDUIEEditing] expected no error on ReplaceIE, got error = (%s)", err.Error())
+	}
+
+	if err := pdu.RemoveIE(0); err != nil {
+		t.Fatalf("[TestPDUIEEditing] expected no error on RemoveIE, got error = (%s)", err.Error())
+	}
+
+	if len(pdu.InformationElements) != 2 || pdu.InformationElements[0].Type != ULI || pdu.TotalLength != 29 {
+		t.Errorf("[TestPDUIEEditing] after ReplaceIE and RemoveIE, IEs or TotalLength are not as expected, TotalLength = (%d)", pdu.TotalLength)
+	}
+
+	if err := encodesAndDecodesConsistently(pdu); err != nil {
+		t.Errorf("[TestPDUIEEditing] %s", err.Error())
+	}
+
+	if err := pdu.RemoveIE(2); err == nil {
+		t.Errorf("[TestPDUIEEditing] expected error on RemoveIE with index out of range, but got none")
+	}
+
+	if err := pdu.AddIE(NewIEWithRawData(PrivateExtension, make([]byte, 65530))); err == nil {
+		t.Errorf("[TestPDUIEEditing] expected error on AddIE exceeding maximum PDU length, but got none")
+	}
+
+	if len(pdu.InformationElements) != 2 || pdu.TotalLength != 29 {
+		t.Errorf("[TestPDUIEEditing] after failed AddIE, expected PDU to be unchanged")
+	}
+
+	pdu.InformationElements[0].SetData([]byte{0x18})
+	if err := pdu.RecomputeTotalLength(); err != nil || pdu.TotalLength != 22 {
+		t.Errorf("[TestPDUIEEditing] after SetData and RecomputeTotalLength, expected TotalLength = (22), got = (%d), error = (%v)", pdu.TotalLength, err)
+	}
+}
+
+func TestPDUEditGroupedIE(t *testing.T) {
+	originalBearerContext := NewGroupedIE(BearerContext, []*IE{
+		NewIEWithRawData(EBI, []byte{0x05}),
+	})
+
+	pdu := NewPDU(CreateSessionRequest, 0x01, []*IE{
+		NewIEWithRawData(RATType, []byte{0x06}),
+		originalBearerContext,
+	})
+
+	err := pdu.EditGroupedIE("BearerContext", func(groupedIEs []*IE) ([]*IE, error) {
+		fteid := (&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1), InterfaceType: 1, Key: 0x01}).ToIE()
+		fteid.InstanceNumber = 2
+		return append(groupedIEs, fteid), nil
+	})
+
+	if err != nil {
+		t.Fatalf("[TestPDUEditGroupedIE] expected no error on EditGroupedIE, got error = (%s)", err.Error())
+	}
+
+	if len(originalBearerContext.Data) != 5 {
+		t.Errorf("[TestPDUEditGroupedIE] expected original Bearer Context to be unchanged, but it has (%d) data bytes", len(originalBearerContext.Data))
+	}
+
+	if ie, err := pdu.LookupIE("BearerContext/FTEID#2"); err != nil || ie == nil {
+		t.Errorf("[TestPDUEditGroupedIE] expected to find added F-TEID, got error = (%v)", err)
+	}
+
+	if pdu.TotalLength != 8+5+4+5+13 {
+		t.Errorf("[TestPDUEditGroupedIE] expected TotalLength = (35), got = (%d)", pdu.TotalLength)
+	}
+
+	if err := encodesAndDecodesConsistently(pdu); err != nil {
+		t.Errorf("[TestPDUEditGroupedIE] %s", err.Error())
+	}
+
+	if err := pdu.EditGroupedIE("BearerContext[1]", func(groupedIEs []*IE) ([]*IE, error) { return groupedIEs, nil }); err == nil {
+		t.Errorf("[TestPDUEditGroupedIE] expected error on EditGroupedIE for missing grouped IE, but got none")
+	}
+}
@@ -1,6 +1,8 @@
 package gtpv2
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -271,6 +273,62 @@ func TestPDUEncodeValid(t *testing.T) {
 	}
 }
 
+func TestPDUEncodeWithPiggybackedPDU(t *testing.T) {
+	createSessionResponse := NewPDU(CreateSessionResponse, 0x10, []*IE{
+		NewIEWithRawData(Cause, []byte{0x10, 0x00}),
+	}).AddTEID(0x01020304)
+
+	createBearerRequest := NewPDU(CreateBearerRequest, 0x11, []*IE{
+		NewIEWithRawData(EBI, []byte{0x05}),
+	}).AddTEID(0x05060708)
+
+	encoded, err := createSessionResponse.EncodeWithPiggybackedPDU(createBearerRequest)
+	if err != nil {
+		t.Fatalf("On EncodeWithPiggybackedPDU() expected no error, got = (%s)", err)
+	}
+
+	expectedOctets := []byte{
+		0x58, 0x21, 0x00, 0x0e, 0x01, 0x02, 0x03, 0x04, 0x00, 0x00, 0x10, 0x00,
+		0x02, 0x00, 0x02, 0x00, 0x10, 0x00,
+		0x48, 0x5f, 0x00, 0x0d, 0x05, 0x06, 0x07, 0x08, 0x00, 0x00, 0x11, 0x00,
+		0x49, 0x00, 0x01, 0x00, 0x05,
+	}
+
+	if err := compareByteArrays(expectedOctets, encoded); err != nil {
+		t.Errorf("On EncodeWithPiggybackedPDU(): %s", err)
+	}
+
+	pdu, piggybackedPdu, err := DecodePDU(encoded)
+	if err != nil {
+		t.Fatalf("On DecodePDU() of piggybacked stream expected no error, got = (%s)", err)
+	}
+
+	createSessionResponse.IsCarryingPiggybackedPDU = true
+	if err := compareTwoPDUObjects(createSessionResponse, pdu); err != nil {
+		t.Errorf("On DecodePDU() of piggybacked stream, primary PDU: %s", err)
+	}
+
+	if piggybackedPdu == nil {
+		t.Fatalf("On DecodePDU() of piggybacked stream, expected piggybacked PDU, got nil")
+	}
+
+	if err := compareTwoPDUObjects(createBearerRequest, piggybackedPdu); err != nil {
+		t.Errorf("On DecodePDU() of piggybacked stream, piggybacked PDU: %s", err)
+	}
+
+	if _, err := createBearerRequest.EncodeWithPiggybackedPDU(createSessionResponse); err == nil {
+		t.Errorf("On EncodeWithPiggybackedPDU() with disallowed pairing, expected error, got none")
+	}
+
+	if !PiggybackingIsAllowed(ModifyBearerRequest, CreateBearerResponse) || PiggybackingIsAllowed(ModifyBearerRequest, ModifyBearerRequest) {
+		t.Errorf("PiggybackingIsAllowed() did not return expected values for Modify Bearer Request")
+	}
+
+	if _, _, err := DecodePDU(expectedOctets[:18]); err == nil {
+		t.Errorf("On DecodePDU() with piggyback flag and no piggybacked PDU, expected error, got none")
+	}
+}
+
 func compareTwoPDUObjects(expected *PDU, got *PDU) error {
 	if expected.Type != got.Type {
 		return fmt.Errorf("Expected Type = (%d) [%s], got = (%d) [%s]", expected.Type, NameOfMessageForType(expected.Type), got.Type, NameOfMessageForType(got.Type))
@@ -316,3 +374,251 @@ func compareTwoPDUObjects(expected *PDU, got *PDU) error {
 
 	return nil
 }
+
+// modifyBearerRequestOctets is the valid Modify Bearer Request from
+// TestPDUDecodeValidCases()
+var modifyBearerRequestOctets = []byte{
+	0x48, 0x22, 0x00, 0x3e, 0x05, 0x40, 0x3b, 0x2e, 0x00, 0x1a, 0xcc, 0x00,
+	0x56, 0x00, 0x0d, 0x00, 0x18, 0x00, 0x11, 0x00, 0xff, 0x00, 0x00, 0x11,
+	0x00, 0x0f, 0x42, 0x4d, 0x00,
+	0x52, 0x00, 0x01, 0x00, 0x06,
+	0x5c, 0x00, 0x01, 0x00, 0x00,
+	0x5d, 0x00, 0x12, 0x00, 0x49, 0x00, 0x01, 0x00, 0x05, 0x57, 0x00, 0x09,
+	0x00, 0x80, 0xe4, 0x03, 0xfb, 0x94, 0xac, 0x13, 0x01, 0xb2,
+	0x03, 0x00, 0x01, 0x00, 0x95,
+}
+
+func TestDecodePDUInto(t *testing.T) {
+	createSessionResponse := NewPDU(CreateSessionResponse, 0x10, []*IE{
+		NewIEWithRawData(Cause, []byte{0x10, 0x00}),
+	}).AddTEID(0x01020304)
+
+	createBearerRequest := NewPDU(CreateBearerRequest, 0x11, []*IE{
+		NewIEWithRawData(EBI, []byte{0x05}),
+	}).AddTEID(0x05060708)
+
+	piggybackedOctets, err := createSessionResponse.EncodeWithPiggybackedPDU(createBearerRequest)
+	if err != nil {
+		t.Fatalf("[TestDecodePDUInto] on EncodeWithPiggybackedPDU() expected no error, got = (%s)", err)
+	}
+
+	pdu, piggybackedPdu := &PDU{}, &PDU{}
+
+	for _, stream := range [][]byte{modifyBearerRequestOctets, piggybackedOctets, modifyBearerRequestOctets} {
+		expectedPdu, expectedPiggybackedPdu, err := DecodePDU(stream)
+		if err != nil {
+			t.Fatalf("[TestDecodePDUInto] on DecodePDU() expected no error, got = (%s)", err)
+		}
+
+		if err := DecodePDUInto(stream, pdu, piggybackedPdu, DecodeOptions{}); err != nil {
+			t.Fatalf("[TestDecodePDUInto] on DecodePDUInto() for (%s) expected no error, got = (%s)", NameOfMessageForType(expectedPdu.Type), err)
+		}
+
+		if err := compareTwoPDUObjects(expectedPdu, pdu); err != nil {
+			t.Errorf("[TestDecodePDUInto] for (%s): %s", NameOfMessageForType(expectedPdu.Type), err)
+		}
+
+		if expectedPiggybackedPdu != nil {
+			if err := compareTwoPDUObjects(expectedPiggybackedPdu, piggybackedPdu); err != nil {
+				t.Errorf("[TestDecodePDUInto] for (%s), piggybacked PDU: %s", NameOfMessageForType(expectedPdu.Type), err)
+			}
+		}
+	}
+
+	stream := append([]byte(nil), modifyBearerRequestOctets...)
+	if err := DecodePDUInto(stream, pdu, nil, DecodeOptions{}); err != nil {
+		t.Fatalf("[TestDecodePDUInto] on DecodePDUInto() expected no error, got = (%s)", err)
+	}
+
+	stream[33] = 0x07
+	if pdu.InformationElements[1].Data[0] != 0x07 {
+		t.Errorf("[TestDecodePDUInto] expected RAT Type Data to alias stream, but it did not")
+	}
+
+	if _, err := pdu.InformationElements[1].TypedDataErrorable(); err != nil {
+		t.Errorf("[TestDecodePDUInto] on TypedDataErrorable() for aliased IE expected no error, got = (%s)", err)
+	}
+
+	if err := DecodePDUInto(piggybackedOctets, pdu, nil, DecodeOptions{}); err == nil {
+		t.Errorf("[TestDecodePDUInto] on DecodePDUInto() with piggybacked PDU and nil piggybackedPdu expected error, got none")
+	}
+
+	if err := DecodePDUInto(modifyBearerRequestOctets[:40], pdu, nil, DecodeOptions{}); err == nil {
+		t.Errorf("[TestDecodePDUInto] on DecodePDUInto() with truncated stream expected error, got none")
+	}
+
+	allocations := testing.AllocsPerRun(100, func() {
+		if err := DecodePDUInto(modifyBearerRequestOctets, pdu, piggybackedPdu, DecodeOptions{StrictHeader: true}); err != nil {
+			t.Fatalf("[TestDecodePDUInto] on DecodePDUInto() expected no error, got = (%s)", err)
+		}
+	})
+
+	if allocations != 0 {
+		t.Errorf("[TestDecodePDUInto] expected no allocations on reuse, got (%.1f) per decode", allocations)
+	}
+}
+
+func TestPDUDecodeLengthFieldWrap(t *testing.T) {
+	for _, lengthFieldValue := range []uint16{0xfffc, 0xfffd, 0xfffe, 0xffff} {
+		stream := []byte{0x48, 0x20, byte(lengthFieldValue >> 8), byte(lengthFieldValue), 0, 0, 0, 1, 0, 0, 1, 0}
+
+		_, _, err := DecodePDU(stream)
+
+		var decodeError *DecodeError
+		if !errors.As(err, &decodeError) || decodeError.Kind != DecodeErrorTruncated || decodeError.ExpectedLength != int(lengthFieldValue)+4 {
+			t.Errorf("[TestPDUDecodeLengthFieldWrap] for length field (0x%04x) expected truncated error with expected length (%d), got = (%v)", lengthFieldValue, int(lengthFieldValue)+4, err)
+		}
+	}
+
+	// an IE with a length field that would wrap, in a PDU long enough to hold it
+	stream := append([]byte{0x48, 0x20, 0xff, 0xff, 0, 0, 0, 1, 0, 0, 1, 0, 0x01, 0xff, 0xfc, 0x00}, make([]byte, 0xfff3)...)
+	if _, _, err := DecodePDU(stream); err == nil {
+		t.Errorf("[TestPDUDecodeLengthFieldWrap] for IE length field (0xfffc) in PDU expected error, got none")
+	}
+}
+
+func TestPDUDecodeLengthShorterThanHeader(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		stream         []byte
+		expectedLength int
+	}{
+		{"piggyback without TEID", []byte{0x50, 0x21, 0x00, 0x00, 0x40, 0x5f, 0x00, 0x04, 0, 0, 2, 0}, 8},
+		{"without TEID", []byte{0x40, 0x01, 0x00, 0x02, 0, 0, 1, 0}, 8},
+		{"piggyback with TEID", []byte{0x58, 0x21, 0x00, 0x04, 0, 0, 0, 1, 0x40, 0x5f, 0x00, 0x04, 0, 0, 2, 0}, 12},
+	} {
+		_, _, err := DecodePDU(testCase.stream)
+
+		var decodeError *DecodeError
+		if !errors.As(err, &decodeError) || decodeError.Kind != DecodeErrorLengthMismatch || decodeError.ExpectedLength != testCase.expectedLength {
+			t.Errorf("[TestPDUDecodeLengthShorterThanHeader] for (%s) expected length mismatch error with expected length (%d), got = (%v)", testCase.name, testCase.expectedLength, err)
+		}
+	}
+}
+
+func TestExtractGroupedIEsInto(t *testing.T) {
+	bearerContext := NewGroupedIE(BearerContext, []*IE{
+		NewIEWithRawData(EBI, []byte{0x05}),
+		NewIEWithRawData(Cause, []byte{0x10, 0x00}),
+	})
+
+	expectedIEs, err := ExtractGroupedIEsFrom(bearerContext)
+	if err != nil {
+		t.Fatalf("[TestExtractGroupedIEsInto] on ExtractGroupedIEsFrom() expected no error, got = (%s)", err)
+	}
+
+	var ies []*IE
+	for i := 0; i < 2; i++ {
+		if ies, err = ExtractGroupedIEsInto(bearerContext, ies); err != nil {
+			t.Fatalf("[TestExtractGroupedIEsInto] on ExtractGroupedIEsInto() expected no error, got = (%s)", err)
+		}
+
+		if len(ies) != len(expectedIEs) {
+			t.Fatalf("[TestExtractGroupedIEsInto] expected (%d) IEs, got (%d)", len(expectedIEs), len(ies))
+		}
+
+		for j := range ies {
+			if err := compareTwoIEObjects(expectedIEs[j], ies[j]); err != nil {
+				t.Errorf("[TestExtractGroupedIEsInto] on IE (%d): %s", j+1, err)
+			}
+		}
+	}
+
+	allocations := testing.AllocsPerRun(100, func() {
+		ies, _ = ExtractGroupedIEsInto(bearerContext, ies)
+	})
+
+	if allocations != 0 {
+		t.Errorf("[TestExtractGroupedIEsInto] expected no allocations on reuse, got (%.1f) per extraction", allocations)
+	}
+}
+
+func BenchmarkDecodePDU(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, _, err := DecodePDU(modifyBearerRequestOctets); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodePDUInto(b *testing.B) {
+	b.ReportAllocs()
+
+	pdu := &PDU{}
+
+	for i := 0; i < b.N; i++ {
+		if err := DecodePDUInto(modifyBearerRequestOctets, pdu, nil, DecodeOptions{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestPDUAppendEncode(t *testing.T) {
+	prefix := []byte{0xde, 0xad}
+
+	for _, pdu := range []*PDU{
+		NewPDU(EchoRequest, 0x123456, []*IE{NewIEWithRawData(RecoveryRestartCounter, []byte{0x95})}),
+		NewPDU(ModifyBearerRequest, 0x1acc, []*IE{
+			NewIEWithRawData(RATType, []byte{0x06}),
+			NewGroupedIE(BearerContext, []*IE{NewIEWithRawData(EBI, []byte{0x05})}),
+			NewIEWithRawData(IEType(300), []byte{0x01, 0x02}),
+		}).AddTEID(0x05403b2e).AddPriority(0x0a),
+	} {
+		expected := append(append([]byte(nil), prefix...), pdu.Encode()...)
+
+		if err := compareByteArrays(expected, pdu.AppendEncode(append([]byte(nil), prefix...))); err != nil {
+			t.Errorf("[TestPDUAppendEncode] on AppendEncode() for (%s): %s", NameOfMessageForType(pdu.Type), err)
+		}
+
+		var buffer bytes.Buffer
+		bytesWritten, err := pdu.WriteTo(&buffer)
+		if err != nil {
+			t.Errorf("[TestPDUAppendEncode] on WriteTo() for (%s) expected no error, got = (%s)", NameOfMessageForType(pdu.Type), err)
+		} else if bytesWritten != int64(pdu.TotalLength) {
+			t.Errorf("[TestPDUAppendEncode] on WriteTo() for (%s) expected (%d) bytes written, got (%d)", NameOfMessageForType(pdu.Type), pdu.TotalLength, bytesWritten)
+		} else if err := compareByteArrays(expected[len(prefix):], buffer.Bytes()); err != nil {
+			t.Errorf("[TestPDUAppendEncode] on WriteTo() for (%s): %s", NameOfMessageForType(pdu.Type), err)
+		}
+
+		decodedPdu, _, err := DecodePDU(buffer.Bytes())
+		if err != nil {
+			t.Errorf("[TestPDUAppendEncode] on DecodePDU() of WriteTo() output for (%s) expected no error, got = (%s)", NameOfMessageForType(pdu.Type), err)
+		} else if err := compareTwoPDUObjects(pdu, decodedPdu); err != nil {
+			t.Errorf("[TestPDUAppendEncode] on DecodePDU() of WriteTo() output for (%s): %s", NameOfMessageForType(pdu.Type), err)
+		}
+	}
+
+	pdu, _, _ := DecodePDU(modifyBearerRequestOctets)
+	buffer := make([]byte, 0, 1500)
+
+	allocations := testing.AllocsPerRun(100, func() {
+		buffer = pdu.AppendEncode(buffer[:0])
+	})
+
+	if allocations != 0 {
+		t.Errorf("[TestPDUAppendEncode] expected no allocations on AppendEncode() into buffer with sufficient capacity, got (%.1f)", allocations)
+	}
+}
+
+func BenchmarkPDUEncode(b *testing.B) {
+	b.ReportAllocs()
+
+	pdu, _, _ := DecodePDU(modifyBearerRequestOctets)
+
+	for i := 0; i < b.N; i++ {
+		pdu.Encode()
+	}
+}
+
+func BenchmarkPDUAppendEncode(b *testing.B) {
+	b.ReportAllocs()
+
+	pdu, _, _ := DecodePDU(modifyBearerRequestOctets)
+	buffer := make([]byte, 0, 1500)
+
+	for i := 0; i < b.N; i++ {
+		buffer = pdu.AppendEncode(buffer[:0])
+	}
+}
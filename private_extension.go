@@ -0,0 +1,108 @@
+package gtpv2
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+)
+
+// PrivateExtensionDecoder converts the proprietary value of a Private Extension IE
+// into a vendor-defined typed value.  The value slice references the IE data, so
+// a decoder that retains it should copy() it first.
+type PrivateExtensionDecoder func(enterpriseID uint16, value []byte) (TypedIE, error)
+
+var privateExtensionDecoders = struct {
+	sync.RWMutex
+	byEnterpriseID map[uint16]PrivateExtensionDecoder
+}{
+	byEnterpriseID: make(map[uint16]PrivateExtensionDecoder),
+}
+
+// RegisterPrivateExtensionDecoder sets the decoder used by TypedDataErrorable() for
+// Private Extension IEs carrying the provided Enterprise ID.  A previously registered
+// decoder for the same Enterprise ID is replaced.  A nil decoder removes the
+// registration.
+func RegisterPrivateExtensionDecoder(enterpriseID uint16, decoder PrivateExtensionDecoder) {
+	privateExtensionDecoders.Lock()
+	defer privateExtensionDecoders.Unlock()
+
+	if decoder == nil {
+		delete(privateExtensionDecoders.byEnterpriseID, enterpriseID)
+	} else {
+		privateExtensionDecoders.byEnterpriseID[enterpriseID] = decoder
+	}
+}
+
+func privateExtensionDecoderFor(enterpriseID uint16) PrivateExtensionDecoder {
+	privateExtensionDecoders.RLock()
+	defer privateExtensionDecoders.RUnlock()
+
+	return privateExtensionDecoders.byEnterpriseID[enterpriseID]
+}
+
+// TypedPrivateExtension is a structured version of a Private Extension IE.  EnterpriseID
+// is the IANA SMI Network Management Private Enterprise Code of the vendor, and Value
+// is the proprietary value that follows it.
+type TypedPrivateExtension struct {
+	EnterpriseID uint16
+	Value        []byte
+}
+
+// ToIE creates an IE from the structured version of a Private Extension, and
+// panics if there is an error
+func (extension *TypedPrivateExtension) ToIE() *IE {
+	ie, err := extension.ToIEErrorable()
+
+	if err != nil {
+		panic(err)
+	}
+
+	return ie
+}
+
+// ToIEErrorable is the same as ToIE, but returns an error if one
+// occurs, rather than panicing
+func (extension *TypedPrivateExtension) ToIEErrorable() (*IE, error) {
+	data := make([]byte, 2+len(extension.Value))
+
+	binary.BigEndian.PutUint16(data[0:2], extension.EnterpriseID)
+	copy(data[2:], extension.Value)
+
+	return NewIEWithRawDataErrorable(PrivateExtension, data)
+}
+
+func makeTypedPrivateExtension(fromIE *IE) (*TypedPrivateExtension, error) {
+	if fromIE.Type != PrivateExtension {
+		return nil, fmt.Errorf("supplied IE is not of type Private Extension")
+	}
+
+	if len(fromIE.Data) < 2 {
+		return nil, fmt.Errorf("length of IE data is not correct for Private Extension type")
+	}
+
+	return &TypedPrivateExtension{
+		EnterpriseID: binary.BigEndian.Uint16(fromIE.Data[0:2]),
+		Value:        fromIE.Data[2:],
+	}, nil
+}
+
+// makeTypedPrivateExtensionOrVendorValue uses the decoder registered for the
+// IE Enterprise ID if there is one, and the raw TypedPrivateExtension otherwise
+func makeTypedPrivateExtensionOrVendorValue(fromIE *IE) (TypedIE, error) {
+	extension, err := makeTypedPrivateExtension(fromIE)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := privateExtensionDecoderFor(extension.EnterpriseID)
+	if decoder == nil {
+		return extension, nil
+	}
+
+	vendorValue, err := decoder(extension.EnterpriseID, extension.Value)
+	if err != nil {
+		return nil, fmt.Errorf("on Private Extension for Enterprise ID (%d): %s", extension.EnterpriseID, err)
+	}
+
+	return vendorValue, nil
+}
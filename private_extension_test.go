@@ -0,0 +1,106 @@
+package gtpv2
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+)
+
+type testVendorCounters struct {
+	Sessions uint32
+}
+
+func (counters *testVendorCounters) ToIE() *IE {
+	ie, err := counters.ToIEErrorable()
+
+	if err != nil {
+		panic(err)
+	}
+
+	return ie
+}
+
+func (counters *testVendorCounters) ToIEErrorable() (*IE, error) {
+	value := make([]byte, 4)
+	binary.BigEndian.PutUint32(value, counters.Sessions)
+
+	return (&TypedPrivateExtension{EnterpriseID: 9999, Value: value}).ToIEErrorable()
+}
+
+func decodeTestVendorCounters(enterpriseID uint16, value []byte) (TypedIE, error) {
+	if len(value) != 4 {
+		return nil, fmt.Errorf("vendor value must be 4 bytes")
+	}
+
+	return &testVendorCounters{Sessions: binary.BigEndian.Uint32(value)}, nil
+}
+
+func TestTypedPrivateExtension(t *testing.T) {
+	extension := &TypedPrivateExtension{EnterpriseID: 0x1234, Value: []byte{0xaa, 0xbb, 0xcc}}
+
+	ie, err := extension.ToIEErrorable()
+	if err != nil {
+		t.Fatalf("[TestTypedPrivateExtension] expected no error on ToIEErrorable, got error = (%s)", err.Error())
+	}
+
+	if err := compareByteArrays([]byte{0x12, 0x34, 0xaa, 0xbb, 0xcc}, ie.Data); err != nil {
+		t.Errorf("[TestTypedPrivateExtension] data in IE from ToIEErrorable does not match expected: %s", err.Error())
+	}
+
+	typedIE, err := ie.TypedDataErrorable()
+	if err != nil {
+		t.Fatalf("[TestTypedPrivateExtension] expected no error on TypedData, got error = (%s)", err.Error())
+	}
+
+	decodedExtension, isRawExtension := typedIE.(*TypedPrivateExtension)
+	if !isRawExtension {
+		t.Fatalf("[TestTypedPrivateExtension] expected *TypedPrivateExtension for unregistered Enterprise ID, got = (%T)", typedIE)
+	}
+
+	if decodedExtension.EnterpriseID != 0x1234 {
+		t.Errorf("[TestTypedPrivateExtension] expected EnterpriseID = (0x1234), got = (0x%04x)", decodedExtension.EnterpriseID)
+	}
+
+	if err := compareByteArrays(extension.Value, decodedExtension.Value); err != nil {
+		t.Errorf("[TestTypedPrivateExtension] decoded Value does not match expected: %s", err.Error())
+	}
+
+	if _, err := NewIEWithRawData(PrivateExtension, []byte{0x12}).TypedDataErrorable(); err == nil {
+		t.Errorf("[TestTypedPrivateExtension] expected error on TypedData for IE without complete Enterprise ID, but got none")
+	}
+}
+
+func TestRegisterPrivateExtensionDecoder(t *testing.T) {
+	RegisterPrivateExtensionDecoder(9999, decodeTestVendorCounters)
+	defer RegisterPrivateExtensionDecoder(9999, nil)
+
+	typedIE, err := (&testVendorCounters{Sessions: 42}).ToIE().TypedDataErrorable()
+	if err != nil {
+		t.Fatalf("[TestRegisterPrivateExtensionDecoder] expected no error on TypedData, got error = (%s)", err.Error())
+	}
+
+	counters, isVendorValue := typedIE.(*testVendorCounters)
+	if !isVendorValue {
+		t.Fatalf("[TestRegisterPrivateExtensionDecoder] expected *testVendorCounters, got = (%T)", typedIE)
+	}
+
+	if counters.Sessions != 42 {
+		t.Errorf("[TestRegisterPrivateExtensionDecoder] expected Sessions = (42), got = (%d)", counters.Sessions)
+	}
+
+	malformedIE := (&TypedPrivateExtension{EnterpriseID: 9999, Value: []byte{0x01}}).ToIE()
+	if _, err := malformedIE.TypedDataErrorable(); err == nil {
+		t.Errorf("[TestRegisterPrivateExtensionDecoder] expected error from vendor decoder, but got none")
+	}
+
+	RegisterPrivateExtensionDecoder(9999, nil)
+
+	typedIE, err = (&testVendorCounters{Sessions: 42}).ToIE().TypedDataErrorable()
+	if err != nil {
+		t.Fatalf("[TestRegisterPrivateExtensionDecoder] after unregister, expected no error on TypedData, got error = (%s)", err.Error())
+	}
+
+	if _, isRawExtension := typedIE.(*TypedPrivateExtension); !isRawExtension {
+		t.Errorf("[TestRegisterPrivateExtensionDecoder] after unregister, expected *TypedPrivateExtension, got = (%T)", typedIE)
+	}
+}
@@ -0,0 +1,194 @@
+package gtpv2
+
+// InterfaceProfile restricts messages and IEs to those allowed on an interface.  A
+// message type is allowed if MessageType.IsAllowedOnInterface() is true for the
+// interface.  An IE is allowed, and its presence and F-TEID interface type values
+// are determined, by its schema (see IESchema).  For example, the S5/S8 profile
+// rejects a Create Session Request that carries an S1-U eNodeB F-TEID, which is
+// sent only on S11.
+type InterfaceProfile struct {
+	Interface Interface
+}
+
+// ProfileForInterface returns the profile for the provided interface
+func ProfileForInterface(iface Interface) *InterfaceProfile {
+	return &InterfaceProfile{Interface: iface}
+}
+
+// AllowsMessageType returns true if a message of the provided type may appear on
+// the interface
+func (profile *InterfaceProfile) AllowsMessageType(messageType MessageType) bool {
+	return messageType.IsAllowedOnInterface(profile.Interface)
+}
+
+// PresenceOf returns the presence of the IE described by the schema on the
+// interface.  The boolean is false if the IE may not appear on the interface.
+func (profile *InterfaceProfile) PresenceOf(schema *IESchema) (IEPresence, bool) {
+	if !profile.allowsIESchema(schema) {
+		return 0, false
+	}
+
+	if presence, isOverridden := schema.PresenceByInterface[profile.Interface]; isOverridden {
+		return presence, true
+	}
+
+	return schema.Presence, true
+}
+
+// AllowsFTEIDInterfaceType returns true if an F-TEID described by the schema may
+// have the provided interface type value on the interface
+func (profile *InterfaceProfile) AllowsFTEIDInterfaceType(schema *IESchema, interfaceType FTEIDInterfaceType) bool {
+	allowedTypes, isRestricted := schema.FTEIDInterfaceTypes[profile.Interface]
+	if !isRestricted {
+		return true
+	}
+
+	for _, allowedType := range allowedTypes {
+		if allowedType == interfaceType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (profile *InterfaceProfile) allowsIESchema(schema *IESchema) bool {
+	if len(schema.Interfaces) == 0 {
+		return true
+	}
+
+	for _, iface := range schema.Interfaces {
+		if iface == profile.Interface {
+			return true
+		}
+	}
+
+	return false
+}
+
+// violationForIE returns the kind of violation, if any, caused by the IE with the
+// provided schema on the interface
+func (profile *InterfaceProfile) violationForIE(schema *IESchema, ie *IE) (SchemaViolationKind, bool) {
+	if !profile.allowsIESchema(schema) {
+		return ViolationIENotAllowedOnInterface, true
+	}
+
+	if ie.Type == FTEID && len(ie.Data) > 0 && !profile.AllowsFTEIDInterfaceType(schema, FTEIDInterfaceType(ie.Data[0]&0x3f)) {
+		return ViolationUnexpectedFTEIDInterfaceType, true
+	}
+
+	return 0, false
+}
+
+// Validate is the same as the package function Validate(), but also checks the PDU
+// against the interface.  If the message type is not allowed on the interface, the
+// only violation returned is ViolationMessageNotAllowedOnInterface.  Otherwise, IEs
+// that are not allowed on the interface are reported, F-TEIDs are checked for
+// allowed interface type values, and mandatory IEs are determined by PresenceOf().
+func (profile *InterfaceProfile) Validate(pdu *PDU) []*SchemaViolation {
+	if !profile.AllowsMessageType(pdu.Type) {
+		return []*SchemaViolation{{Kind: ViolationMessageNotAllowedOnInterface, MessageType: pdu.Type, Interface: profile.Interface}}
+	}
+
+	schema, isKnown := SchemaForMessageType(pdu.Type)
+	if !isKnown {
+		return nil
+	}
+
+	return validateIEsAgainstSchema(pdu.InformationElements, schema.IEs, "", profile)
+}
+
+// IESchemaForRole is the same as the package function IESchemaForRole(), but
+// returns an error if the message type, or any role in the role path, is not
+// allowed on the interface
+func (profile *InterfaceProfile) IESchemaForRole(messageType MessageType, rolePath string) (*IESchema, error) {
+	if !profile.AllowsMessageType(messageType) {
+		return nil, &SchemaViolation{Kind: ViolationMessageNotAllowedOnInterface, MessageType: messageType, Interface: profile.Interface}
+	}
+
+	schemaPath, err := ieSchemaPathForRole(messageType, rolePath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, schema := range schemaPath {
+		if !profile.allowsIESchema(schema) {
+			return nil, &SchemaViolation{Kind: ViolationIENotAllowedOnInterface, Path: rolePath, Role: schema.Role, Type: schema.Type, Instance: schema.Instance, Interface: profile.Interface}
+		}
+	}
+
+	return schemaPath[len(schemaPath)-1], nil
+}
+
+// NewIEForRole is the same as the package function NewIEForRole(), but the role
+// must be allowed on the interface (see IESchemaForRole()).  For an F-TEID, the
+// interface type value must also be allowed (see AllowsFTEIDInterfaceType()).
+func (profile *InterfaceProfile) NewIEForRole(messageType MessageType, rolePath string, data []byte) (*IE, error) {
+	schema, err := profile.IESchemaForRole(messageType, rolePath)
+	if err != nil {
+		return nil, err
+	}
+
+	ie, err := newIEForSchema(schema, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile.checkedIEForRole(schema, rolePath, ie)
+}
+
+// NewTypedIEForRole is the same as the package function NewTypedIEForRole(), but
+// with the restrictions of NewIEForRole()
+func (profile *InterfaceProfile) NewTypedIEForRole(messageType MessageType, rolePath string, typedValue TypedIE) (*IE, error) {
+	schema, err := profile.IESchemaForRole(messageType, rolePath)
+	if err != nil {
+		return nil, err
+	}
+
+	ie, err := newTypedIEForSchema(schema, rolePath, typedValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile.checkedIEForRole(schema, rolePath, ie)
+}
+
+// NewGroupedIEForRole is the same as the package function NewGroupedIEForRole(),
+// but the role must be allowed on the interface (see IESchemaForRole()).  The
+// grouped IEs are not checked; use Validate() on the completed PDU for that.
+func (profile *InterfaceProfile) NewGroupedIEForRole(messageType MessageType, rolePath string, groupedIEs []*IE) (*IE, error) {
+	schema, err := profile.IESchemaForRole(messageType, rolePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGroupedIEForSchema(schema, messageType, rolePath, groupedIEs)
+}
+
+func (profile *InterfaceProfile) checkedIEForRole(schema *IESchema, rolePath string, ie *IE) (*IE, error) {
+	if kind, isViolation := profile.violationForIE(schema, ie); isViolation {
+		return nil, &SchemaViolation{Kind: kind, Path: rolePath, Role: schema.Role, Type: schema.Type, Instance: schema.Instance, Interface: profile.Interface}
+	}
+
+	return ie, nil
+}
+
+// MessageToPDU converts the typed message to a PDU (see TypedMessage), and returns
+// an error if the message type, or an IE in the message, is not allowed on the
+// interface, or if an F-TEID has an interface type value that is not allowed for
+// its role.  Missing mandatory IEs are not treated as errors.
+func (profile *InterfaceProfile) MessageToPDU(message TypedMessage) (*PDU, error) {
+	pdu, err := message.ToPDU()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, violation := range profile.Validate(pdu) {
+		switch violation.Kind {
+		case ViolationMessageNotAllowedOnInterface, ViolationIENotAllowedOnInterface, ViolationUnexpectedFTEIDInterfaceType:
+			return nil, violation
+		}
+	}
+
+	return pdu, nil
+}
@@ -0,0 +1,126 @@
+package gtpv2
+
+import (
+	"errors"
+	"net"
+	"testing"
+)
+
+func createSessionRequestForProfileTest(senderInterfaceType FTEIDInterfaceType, bearerContextIEs []*IE) *PDU {
+	pdu := NewPDU(CreateSessionRequest, 1, []*IE{
+		NewIEWithRawData(RATType, []byte{0x06}),
+		(&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1).To4(), InterfaceType: senderInterfaceType, Key: 0x01}).ToIE(),
+		NewIEWithRawData(APN, []byte{0x03, 'a', 'p', 'n'}),
+		NewGroupedIE(BearerContext, bearerContextIEs),
+	})
+	pdu.TEIDFieldIsPresent = true
+	pdu.TotalLength += 4
+
+	return pdu
+}
+
+func TestInterfaceProfileValidate(t *testing.T) {
+	s11Profile := ProfileForInterface(InterfaceS11)
+	s5s8Profile := ProfileForInterface(InterfaceS5S8)
+
+	bearerContextIEs := []*IE{
+		NewIEWithRawData(EBI, []byte{0x05}),
+		(&TypedFTEID{IPv4Addr: net.IPv4(10, 2, 2, 2).To4(), InterfaceType: 0, Key: 0x02}).ToIE(),
+		NewIEWithRawData(BearerQoS, make([]byte, 22)),
+	}
+
+	pdu := createSessionRequestForProfileTest(10, bearerContextIEs)
+
+	if violations := s11Profile.Validate(pdu); len(violations) != 0 {
+		t.Errorf("[TestInterfaceProfileValidate] on S11 Validate(), expected no violations, got = (%v)", violations)
+	}
+
+	violations := s5s8Profile.Validate(pdu)
+	if len(violations) != 2 {
+		t.Fatalf("[TestInterfaceProfileValidate] on S5/S8 Validate(), expected (2) violations, got = (%v)", violations)
+	}
+
+	if violations[0].Kind != ViolationUnexpectedFTEIDInterfaceType || violations[0].Role != "SenderFTEIDForControlPlane" || violations[0].Interface != InterfaceS5S8 {
+		t.Errorf("[TestInterfaceProfileValidate] on S5/S8 Validate(), expected unexpected F-TEID interface type for SenderFTEIDForControlPlane, got = (%v)", violations[0])
+	}
+
+	if violations[1].Kind != ViolationIENotAllowedOnInterface || violations[1].Role != "S1UeNodeBFTEID" || violations[1].Path != "BearerContext/FTEID" {
+		t.Errorf("[TestInterfaceProfileValidate] on S5/S8 Validate(), expected S1UeNodeBFTEID not allowed at BearerContext/FTEID, got = (%v)", violations[1])
+	}
+
+	s2bViolations := ProfileForInterface(InterfaceS2b).Validate(createSessionRequestForProfileTest(30, bearerContextIEs[:1]))
+	missingIMSI := false
+	for _, violation := range s2bViolations {
+		if violation.Kind == ViolationMissingMandatoryIE && violation.Role == "IMSI" {
+			missingIMSI = true
+		}
+	}
+	if !missingIMSI {
+		t.Errorf("[TestInterfaceProfileValidate] on S2b Validate(), expected IMSI to be mandatory, got = (%v)", s2bViolations)
+	}
+
+	for _, violation := range Validate(pdu) {
+		if violation.Role == "IMSI" {
+			t.Errorf("[TestInterfaceProfileValidate] on Validate() without profile, expected IMSI to be conditional, got = (%v)", violation)
+		}
+	}
+
+	contextRequest := NewPDU(ContextRequest, 1, nil)
+	violations = s11Profile.Validate(contextRequest)
+	if len(violations) != 1 || violations[0].Kind != ViolationMessageNotAllowedOnInterface {
+		t.Errorf("[TestInterfaceProfileValidate] on S11 Validate() for Context Request, expected message not allowed, got = (%v)", violations)
+	}
+
+	if !ProfileForInterface(InterfaceS10).AllowsMessageType(ContextRequest) {
+		t.Errorf("[TestInterfaceProfileValidate] expected Context Request to be allowed on S10")
+	}
+}
+
+func TestInterfaceProfileBuilders(t *testing.T) {
+	s11Profile := ProfileForInterface(InterfaceS11)
+	s5s8Profile := ProfileForInterface(InterfaceS5S8)
+
+	mmeFTEID := &TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1).To4(), InterfaceType: 10, Key: 0x01}
+
+	if ie, err := s11Profile.NewTypedIEForRole(CreateSessionRequest, "SenderFTEIDForControlPlane", mmeFTEID); err != nil || ie.InstanceNumber != 0 {
+		t.Errorf("[TestInterfaceProfileBuilders] on S11 NewTypedIEForRole(SenderFTEIDForControlPlane), expected F-TEID, got = (%v), error = (%v)", ie, err)
+	}
+
+	var violation *SchemaViolation
+
+	_, err := s5s8Profile.NewTypedIEForRole(CreateSessionRequest, "SenderFTEIDForControlPlane", mmeFTEID)
+	if !errors.As(err, &violation) || violation.Kind != ViolationUnexpectedFTEIDInterfaceType {
+		t.Errorf("[TestInterfaceProfileBuilders] on S5/S8 NewTypedIEForRole() with S11 MME interface type, expected unexpected F-TEID interface type, got = (%v)", err)
+	}
+
+	_, err = s5s8Profile.NewIEForRole(CreateSessionRequest, "BearerContextsToBeCreated/S1UeNodeBFTEID", []byte{0x00, 0, 0, 0, 1})
+	if !errors.As(err, &violation) || violation.Kind != ViolationIENotAllowedOnInterface {
+		t.Errorf("[TestInterfaceProfileBuilders] on S5/S8 NewIEForRole(BearerContextsToBeCreated/S1UeNodeBFTEID), expected IE not allowed, got = (%v)", err)
+	}
+
+	_, err = s5s8Profile.NewGroupedIEForRole(CreateSessionRequest, "BearerContextsToBeRemoved", nil)
+	if !errors.As(err, &violation) || violation.Kind != ViolationIENotAllowedOnInterface {
+		t.Errorf("[TestInterfaceProfileBuilders] on S5/S8 NewGroupedIEForRole(BearerContextsToBeRemoved), expected IE not allowed, got = (%v)", err)
+	}
+
+	_, err = s11Profile.NewIEForRole(ContextRequest, "IMSI", []byte{0x00})
+	if !errors.As(err, &violation) || violation.Kind != ViolationMessageNotAllowedOnInterface {
+		t.Errorf("[TestInterfaceProfileBuilders] on S11 NewIEForRole() for Context Request, expected message not allowed, got = (%v)", err)
+	}
+
+	message := &CreateSessionRequestMessage{
+		RATType:                       &TypedRATType{Value: 6},
+		SenderFTEIDForControlPlane:    &TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1).To4(), InterfaceType: 6, Key: 0x01},
+		PGWS5S8AddressForControlPlane: &TypedFTEID{IPv4Addr: net.IPv4(10, 3, 3, 3).To4(), InterfaceType: 7, Key: 0x03},
+	}
+
+	_, err = s5s8Profile.MessageToPDU(message)
+	if !errors.As(err, &violation) || violation.Role != "PGWS5S8AddressForControlPlane" {
+		t.Errorf("[TestInterfaceProfileBuilders] on S5/S8 MessageToPDU() with PGW S5/S8 address, expected IE not allowed, got = (%v)", err)
+	}
+
+	message.PGWS5S8AddressForControlPlane = nil
+	if _, err := s5s8Profile.MessageToPDU(message); err != nil {
+		t.Errorf("[TestInterfaceProfileBuilders] on S5/S8 MessageToPDU(), expected no error, got = (%s)", err)
+	}
+}
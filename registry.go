@@ -0,0 +1,136 @@
+package gtpv2
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// TypedIEDecoder converts an IE into its typed representation.  The IE Data
+// should not be retained by the typed value unless it is copied first.
+type TypedIEDecoder func(ie *IE) (TypedIE, error)
+
+type messageSpecificIEType struct {
+	messageType MessageType
+	ieType      IEType
+}
+
+var typedIEDecoders = struct {
+	sync.RWMutex
+	byIEType           map[IEType]TypedIEDecoder
+	byMessageAndIEType map[messageSpecificIEType]TypedIEDecoder
+}{
+	byIEType: map[IEType]TypedIEDecoder{
+		IMSI: func(ie *IE) (TypedIE, error) {
+			imsi, err := makeTypedIMSI(ie)
+			if err != nil {
+				return nil, err
+			}
+			return imsi, nil
+		},
+		FTEID: func(ie *IE) (TypedIE, error) {
+			fteid, err := makeTypedFTEID(ie)
+			if err != nil {
+				return nil, err
+			}
+			return fteid, nil
+		},
+		SecondaryRATUsageDataReport: func(ie *IE) (TypedIE, error) {
+			report, err := makeTypedSecondaryRATUsageDataReport(ie)
+			if err != nil {
+				return nil, err
+			}
+			return report, nil
+		},
+		UPFunctionSelectionIndicationFlags: func(ie *IE) (TypedIE, error) {
+			flags, err := makeTypedUPFunctionSelectionIndicationFlags(ie)
+			if err != nil {
+				return nil, err
+			}
+			return flags, nil
+		},
+		PrivateExtension: makeTypedPrivateExtensionOrVendorValue,
+	},
+	byMessageAndIEType: make(map[messageSpecificIEType]TypedIEDecoder),
+}
+
+// RegisterTypedIE sets the decoder used by IE.TypedDataErrorable() for the provided
+// IE type.  This replaces any previously registered decoder, including the decoders
+// that are built into this package.  A nil decoder removes the registration.
+func RegisterTypedIE(ieType IEType, decoder TypedIEDecoder) {
+	typedIEDecoders.Lock()
+	defer typedIEDecoders.Unlock()
+
+	if decoder == nil {
+		delete(typedIEDecoders.byIEType, ieType)
+	} else {
+		typedIEDecoders.byIEType[ieType] = decoder
+	}
+}
+
+// RegisterTypedIEForMessage sets a decoder for the provided IE type that is used only
+// when the IE is decoded in the context of the provided message type (that is, by
+// IE.TypedDataForMessageErrorable()).  It overrides the decoder set by RegisterTypedIE()
+// for that context.  A nil decoder removes the override.
+func RegisterTypedIEForMessage(messageType MessageType, ieType IEType, decoder TypedIEDecoder) {
+	typedIEDecoders.Lock()
+	defer typedIEDecoders.Unlock()
+
+	key := messageSpecificIEType{messageType: messageType, ieType: ieType}
+
+	if decoder == nil {
+		delete(typedIEDecoders.byMessageAndIEType, key)
+	} else {
+		typedIEDecoders.byMessageAndIEType[key] = decoder
+	}
+}
+
+func typedIEDecoderFor(messageType MessageType, useMessageContext bool, ieType IEType) TypedIEDecoder {
+	typedIEDecoders.RLock()
+	defer typedIEDecoders.RUnlock()
+
+	if useMessageContext {
+		if decoder, isRegistered := typedIEDecoders.byMessageAndIEType[messageSpecificIEType{messageType, ieType}]; isRegistered {
+			return decoder
+		}
+	}
+
+	return typedIEDecoders.byIEType[ieType]
+}
+
+// DecodeTypedInto converts the IE into its typed value (using IE.TypedDataErrorable())
+// and stores the result in target, which must be a non-nil pointer.  target may point
+// either to a variable of the typed value type (e.g., **TypedFTEID) or to the struct
+// itself (e.g., *TypedFTEID).  Returns an error if the IE cannot be converted or if the
+// typed value registered for the IE type cannot be stored in target.
+func DecodeTypedInto(ie *IE, target interface{}) error {
+	targetValue := reflect.ValueOf(target)
+
+	if targetValue.Kind() != reflect.Ptr || targetValue.IsNil() {
+		return fmt.Errorf("target for DecodeTypedInto must be a non-nil pointer, but got (%T)", target)
+	}
+
+	typedValue, err := ie.TypedDataErrorable()
+	if err != nil {
+		return err
+	}
+
+	if typedValue == nil {
+		return fmt.Errorf("typed value for IE type (%s) is nil, which cannot be stored in target", NameOfIEForType(ie.Type))
+	}
+
+	decodedValue := reflect.ValueOf(typedValue)
+	targetElement := targetValue.Elem()
+
+	if decodedValue.Type().AssignableTo(targetElement.Type()) {
+		targetElement.Set(decodedValue)
+		return nil
+	}
+
+	if decodedValue.Kind() == reflect.Ptr && !decodedValue.IsNil() && decodedValue.Elem().Type().AssignableTo(targetElement.Type()) {
+		targetElement.Set(decodedValue.Elem())
+		return nil
+	}
+
+	return fmt.Errorf("typed value for IE type (%s) is (%T), which cannot be stored in target of type (%T)", NameOfIEForType(ie.Type), typedValue, target)
+}
@@ -0,0 +1,122 @@
+package gtpv2
+
+import (
+	"fmt"
+	"net"
+	"testing"
+)
+
+type testTypedIndication struct {
+	Value uint8
+}
+
+func (indication *testTypedIndication) ToIE() *IE {
+	return NewIEWithRawData(Indication, []byte{indication.Value})
+}
+
+func (indication *testTypedIndication) ToIEErrorable() (*IE, error) {
+	return NewIEWithRawDataErrorable(Indication, []byte{indication.Value})
+}
+
+func decodeTestTypedIndication(ie *IE) (TypedIE, error) {
+	if len(ie.Data) != 1 {
+		return nil, fmt.Errorf("Indication must be 1 byte")
+	}
+
+	return &testTypedIndication{Value: ie.Data[0]}, nil
+}
+
+func TestRegisterTypedIE(t *testing.T) {
+	ie := NewIEWithRawData(Indication, []byte{0x06})
+
+	if _, err := ie.TypedDataErrorable(); err == nil {
+		t.Errorf("[TestRegisterTypedIE] expected error on TypedData before registration, but got none")
+	}
+
+	RegisterTypedIE(Indication, decodeTestTypedIndication)
+	defer RegisterTypedIE(Indication, nil)
+
+	typedIE, err := ie.TypedDataErrorable()
+	if err != nil {
+		t.Fatalf("[TestRegisterTypedIE] expected no error on TypedData after registration, got error = (%s)", err.Error())
+	}
+
+	if indication, isExpectedType := typedIE.(*testTypedIndication); !isExpectedType {
+		t.Errorf("[TestRegisterTypedIE] expected *testTypedIndication, got = (%T)", typedIE)
+	} else if indication.Value != 6 {
+		t.Errorf("[TestRegisterTypedIE] expected Value = (6), got = (%d)", indication.Value)
+	}
+
+	RegisterTypedIEForMessage(CreateSessionRequest, Indication, func(ie *IE) (TypedIE, error) {
+		return &testTypedIndication{Value: ie.Data[0] + 100}, nil
+	})
+	defer RegisterTypedIEForMessage(CreateSessionRequest, Indication, nil)
+
+	typedIE, err = ie.TypedDataForMessageErrorable(CreateSessionRequest)
+	if err != nil {
+		t.Fatalf("[TestRegisterTypedIE] expected no error on TypedDataForMessage, got error = (%s)", err.Error())
+	}
+
+	if indication := typedIE.(*testTypedIndication); indication.Value != 106 {
+		t.Errorf("[TestRegisterTypedIE] expected message specific decoder to produce Value = (106), got = (%d)", indication.Value)
+	}
+
+	typedIE, err = ie.TypedDataForMessageErrorable(ModifyBearerRequest)
+	if err != nil {
+		t.Fatalf("[TestRegisterTypedIE] expected no error on TypedDataForMessage without override, got error = (%s)", err.Error())
+	}
+
+	if indication := typedIE.(*testTypedIndication); indication.Value != 6 {
+		t.Errorf("[TestRegisterTypedIE] expected general decoder to produce Value = (6), got = (%d)", indication.Value)
+	}
+}
+
+func TestDecodeTypedInto(t *testing.T) {
+	ie := (&TypedFTEID{IPv4Addr: net.ParseIP("10.11.12.13"), InterfaceType: 1, Key: 0xaabbccdd}).ToIE()
+
+	var fteidPointer *TypedFTEID
+	if err := DecodeTypedInto(ie, &fteidPointer); err != nil {
+		t.Errorf("[TestDecodeTypedInto] expected no error for **TypedFTEID target, got error = (%s)", err.Error())
+	} else if fteidPointer.Key != 0xaabbccdd {
+		t.Errorf("[TestDecodeTypedInto] for **TypedFTEID target, expected Key = (0xaabbccdd), got = (0x%08x)", fteidPointer.Key)
+	}
+
+	var fteid TypedFTEID
+	if err := DecodeTypedInto(ie, &fteid); err != nil {
+		t.Errorf("[TestDecodeTypedInto] expected no error for *TypedFTEID target, got error = (%s)", err.Error())
+	} else if !fteid.IPv4Addr.Equal(net.ParseIP("10.11.12.13")) {
+		t.Errorf("[TestDecodeTypedInto] for *TypedFTEID target, expected IPv4Addr = (10.11.12.13), got = (%s)", fteid.IPv4Addr)
+	}
+
+	var typedIE TypedIE
+	if err := DecodeTypedInto(ie, &typedIE); err != nil {
+		t.Errorf("[TestDecodeTypedInto] expected no error for *TypedIE target, got error = (%s)", err.Error())
+	}
+
+	var imsi TypedIMSI
+	if err := DecodeTypedInto(ie, &imsi); err == nil {
+		t.Errorf("[TestDecodeTypedInto] expected error for mismatched target type, but got none")
+	}
+
+	if err := DecodeTypedInto(ie, fteid); err == nil {
+		t.Errorf("[TestDecodeTypedInto] expected error for non-pointer target, but got none")
+	}
+}
+
+func TestTypedDecoderReturningNoValue(t *testing.T) {
+	RegisterTypedIE(Indication, func(ie *IE) (TypedIE, error) {
+		return nil, nil
+	})
+	defer RegisterTypedIE(Indication, nil)
+
+	ie := NewIEWithRawData(Indication, []byte{0x06})
+
+	if _, err := ie.TypedDataErrorable(); err == nil {
+		t.Errorf("[TestTypedDecoderReturningNoValue] on TypedDataErrorable() expected error, but got none")
+	}
+
+	var indication *testTypedIndication
+	if err := DecodeTypedInto(ie, &indication); err == nil {
+		t.Errorf("[TestTypedDecoderReturningNoValue] on DecodeTypedInto() expected error, but got none")
+	}
+}
@@ -0,0 +1,68 @@
+package gtpv2
+
+//go:generate go run gen_catalogue.go
+
+// Release is a 3GPP release of TS 29.274
+type Release uint8
+
+// 3GPP releases in which GTPv2 IE types and message types were introduced
+const (
+	Release8  Release = 8
+	Release9  Release = 9
+	Release10 Release = 10
+	Release11 Release = 11
+	Release12 Release = 12
+	Release13 Release = 13
+	Release14 Release = 14
+	Release15 Release = 15
+	Release16 Release = 16
+	Release17 Release = 17
+)
+
+// CurrentRelease is the most recent release of TS 29.274 covered by the
+// IE type and message type catalogue
+const CurrentRelease = Release17
+
+// ReleaseIntroducingIEType returns the release in which the IE type was introduced.
+// The boolean is false if the IE type is not known.
+func ReleaseIntroducingIEType(ieType IEType) (Release, bool) {
+	release, isKnown := ieTypeReleases[ieType]
+	return release, isKnown
+}
+
+// IETypeIsKnownInRelease returns true if the IE type is defined in the provided
+// release of TS 29.274
+func IETypeIsKnownInRelease(ieType IEType, release Release) bool {
+	introducedIn, isKnown := ieTypeReleases[ieType]
+	return isKnown && introducedIn <= release
+}
+
+// ReleaseIntroducingMessageType returns the release in which the message type was
+// introduced.  The boolean is false if the message type is not known.
+func ReleaseIntroducingMessageType(messageType MessageType) (Release, bool) {
+	release, isKnown := messageTypeReleases[messageType]
+	return release, isKnown
+}
+
+// MessageTypeIsKnownInRelease returns true if the message type is defined in the
+// provided release of TS 29.274
+func MessageTypeIsKnownInRelease(messageType MessageType, release Release) bool {
+	introducedIn, isKnown := messageTypeReleases[messageType]
+	return isKnown && introducedIn <= release
+}
+
+// IETypeForYamlName returns the IE type for a name used in YAML templates, which
+// is either one of the IE type constant names (e.g., "FTEID") or an alternate key
+// (e.g., "F-TEID").  The boolean is false if the name is not known.
+func IETypeForYamlName(name string) (IEType, bool) {
+	ieType, isKnown := mapOfYamlIETypeToIEType[name]
+	return ieType, isKnown
+}
+
+// MessageTypeForYamlName returns the message type for a name used in YAML templates,
+// which is one of the MessageType constant names (e.g., "CreateSessionRequest").
+// The boolean is false if the name is not known.
+func MessageTypeForYamlName(name string) (MessageType, bool) {
+	messageType, isKnown := mapOfYamlPduTypeToMessageType[name]
+	return messageType, isKnown
+}
@@ -0,0 +1,70 @@
+package gtpv2
+
+import "testing"
+
+func TestIETypeReleases(t *testing.T) {
+	testCases := []struct {
+		ieType          IEType
+		release         Release
+		expectedIsKnown bool
+	}{
+		{IMSI, Release8, true},
+		{FTEID, Release8, true},
+		{SecondaryRATUsageDataReport, Release14, false},
+		{SecondaryRATUsageDataReport, Release15, true},
+		{BitRate, Release15, false},
+		{BitRate, CurrentRelease, true},
+		{AlternativeIMSI, CurrentRelease, true},
+		{230, CurrentRelease, false},
+	}
+
+	for _, testCase := range testCases {
+		if got := IETypeIsKnownInRelease(testCase.ieType, testCase.release); got != testCase.expectedIsKnown {
+			t.Errorf("For IE type (%d) in release (%d), expected IETypeIsKnownInRelease() = (%t), got = (%t)", testCase.ieType, testCase.release, testCase.expectedIsKnown, got)
+		}
+	}
+
+	if release, isKnown := ReleaseIntroducingIEType(MaximumPacketLossRate); !isKnown || release != Release15 {
+		t.Errorf("For Maximum Packet Loss Rate, expected release (15), got release = (%d), isKnown = (%t)", release, isKnown)
+	}
+}
+
+func TestMessageTypeReleases(t *testing.T) {
+	testCases := []struct {
+		messageType     MessageType
+		release         Release
+		expectedIsKnown bool
+	}{
+		{EchoRequest, Release8, true},
+		{VersionNotSupportedIndication, Release8, true},
+		{ModifyAccessBearersRequest, Release10, false},
+		{ModifyAccessBearersRequest, Release11, true},
+		{MBMSSessionStopResponse, Release9, true},
+		{RemoteUEReportNotification, Release12, false},
+		{250, CurrentRelease, false},
+	}
+
+	for _, testCase := range testCases {
+		if got := MessageTypeIsKnownInRelease(testCase.messageType, testCase.release); got != testCase.expectedIsKnown {
+			t.Errorf("For message type (%d) in release (%d), expected MessageTypeIsKnownInRelease() = (%t), got = (%t)", testCase.messageType, testCase.release, testCase.expectedIsKnown, got)
+		}
+	}
+}
+
+func TestYamlNames(t *testing.T) {
+	if ieType, isKnown := IETypeForYamlName("F-TEID"); !isKnown || ieType != FTEID {
+		t.Errorf("For YAML name (F-TEID), expected IE type (%d), got = (%d), isKnown = (%t)", FTEID, ieType, isKnown)
+	}
+
+	if ieType, isKnown := IETypeForYamlName("BitRate"); !isKnown || ieType != BitRate {
+		t.Errorf("For YAML name (BitRate), expected IE type (%d), got = (%d), isKnown = (%t)", BitRate, ieType, isKnown)
+	}
+
+	if _, isKnown := IETypeForYamlName("NotAnIE"); isKnown {
+		t.Errorf("For YAML name (NotAnIE), expected isKnown = false, got true")
+	}
+
+	if messageType, isKnown := MessageTypeForYamlName("ModifyAccessBearersRequest"); !isKnown || messageType != ModifyAccessBearersRequest {
+		t.Errorf("For YAML name (ModifyAccessBearersRequest), expected message type (%d), got = (%d), isKnown = (%t)", ModifyAccessBearersRequest, messageType, isKnown)
+	}
+}
@@ -0,0 +1,117 @@
+package gtpv2
+
+import (
+	"container/list"
+	"net"
+	"sync"
+	"time"
+)
+
+// The response cache lets an Endpoint recognize a retransmitted request (TS
+// 29.274 section 7.6).  Each request received from a peer is recorded by peer
+// address, sequence number and message type.  A duplicate of a request whose
+// response has been sent is answered by resending the encoded response, and a
+// duplicate of a request whose response has not yet been sent is discarded.
+// Either way, the duplicate is not delivered again.  Records expire after a
+// window measured from the receipt of the request, or from the sending of its
+// response, whichever is later.
+
+type receivedRequestKey struct {
+	peer           string
+	sequenceNumber uint32
+	messageType    MessageType
+}
+
+type receivedRequest struct {
+	key             receivedRequestKey
+	encodedResponse []byte
+	expiresAt       time.Time
+}
+
+type responseCache struct {
+	lock     sync.Mutex
+	window   time.Duration
+	clock    Clock
+	requests map[receivedRequestKey]*list.Element
+	byExpiry *list.List
+}
+
+func newResponseCache(window time.Duration, clock Clock) *responseCache {
+	return &responseCache{
+		window:   window,
+		clock:    clock,
+		requests: make(map[receivedRequestKey]*list.Element),
+		byExpiry: list.New(),
+	}
+}
+
+func receivedRequestKeyFor(peer net.Addr, request *PDU) receivedRequestKey {
+	return receivedRequestKey{peer: peer.String(), sequenceNumber: request.SequenceNumber, messageType: request.Type}
+}
+
+// evictExpired removes expired records.  Since the window is fixed, records are
+// ordered by expiry.  The cache lock must be held.
+func (cache *responseCache) evictExpired(now time.Time) {
+	for element := cache.byExpiry.Front(); element != nil; element = cache.byExpiry.Front() {
+		request := element.Value.(*receivedRequest)
+		if request.expiresAt.After(now) {
+			return
+		}
+
+		cache.byExpiry.Remove(element)
+		delete(cache.requests, request.key)
+	}
+}
+
+// recordRequest records the request received from the peer, unless it is a
+// duplicate of a recorded request.  For a duplicate, isDuplicate is true, and
+// encodedResponse is the response to resend, or nil if it has not been sent.
+func (cache *responseCache) recordRequest(peer net.Addr, request *PDU) (encodedResponse []byte, isDuplicate bool) {
+	now := cache.clock.Now()
+	key := receivedRequestKeyFor(peer, request)
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	cache.evictExpired(now)
+
+	if element, isRecorded := cache.requests[key]; isRecorded {
+		return element.Value.(*receivedRequest).encodedResponse, true
+	}
+
+	cache.requests[key] = cache.byExpiry.PushBack(&receivedRequest{key: key, expiresAt: now.Add(cache.window)})
+
+	return nil, false
+}
+
+// forgetRequest removes the record of the request received from the peer, so that
+// a retransmission of it is not treated as a duplicate
+func (cache *responseCache) forgetRequest(peer net.Addr, request *PDU) {
+	key := receivedRequestKeyFor(peer, request)
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	if element, isRecorded := cache.requests[key]; isRecorded {
+		cache.byExpiry.Remove(element)
+		delete(cache.requests, key)
+	}
+}
+
+// recordResponse stores the encoded response to the request received from the
+// peer, and restarts the window for the request
+func (cache *responseCache) recordResponse(peer net.Addr, request *PDU, encodedResponse []byte) {
+	now := cache.clock.Now()
+	key := receivedRequestKeyFor(peer, request)
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	cache.evictExpired(now)
+
+	if element, isRecorded := cache.requests[key]; isRecorded {
+		cache.byExpiry.Remove(element)
+	}
+
+	cache.requests[key] = cache.byExpiry.PushBack(&receivedRequest{key: key, encodedResponse: encodedResponse, expiresAt: now.Add(cache.window)})
+}
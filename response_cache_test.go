@@ -0,0 +1,111 @@
+package gtpv2
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+// expectNoIncoming fails the test if the endpoint delivers a message within a
+// short wait
+func expectNoIncoming(t *testing.T, testName string, endpoint *Endpoint) {
+	select {
+	case message := <-endpoint.Incoming():
+		t.Errorf("[%s] expected no incoming message, got (%s) with sequence number (%d)", testName, NameOfMessageForType(message.PDU.Type), message.PDU.SequenceNumber)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func expectIncoming(t *testing.T, testName string, endpoint *Endpoint, messageType MessageType) *IncomingMessage {
+	select {
+	case message := <-endpoint.Incoming():
+		if message.PDU.Type != messageType {
+			t.Fatalf("[%s] expected incoming (%s), got (%s)", testName, NameOfMessageForType(messageType), NameOfMessageForType(message.PDU.Type))
+		}
+		return message
+	case <-time.After(time.Second):
+		t.Fatalf("[%s] expected incoming (%s), got none", testName, NameOfMessageForType(messageType))
+	}
+
+	return nil
+}
+
+func TestEndpointDuplicateRequests(t *testing.T) {
+	clock := newTestClock()
+
+	server, err := ListenEndpointWithOptions("127.0.0.1:0", EndpointOptions{Clock: clock, ResponseCacheWindow: 10 * time.Second})
+	if err != nil {
+		t.Fatalf("[TestEndpointDuplicateRequests] on ListenEndpointWithOptions() expected no error, got = (%s)", err)
+	}
+	defer server.Close()
+
+	client, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("[TestEndpointDuplicateRequests] on ListenPacket() expected no error, got = (%s)", err)
+	}
+	defer client.Close()
+
+	request := NewPDU(CreateSessionRequest, 0x0105, []*IE{NewIEWithRawData(RATType, []byte{0x06})}).AddTEID(0).Encode()
+
+	// a duplicate received before the response is sent is discarded
+	client.WriteTo(request, server.LocalAddr())
+	message := expectIncoming(t, "TestEndpointDuplicateRequests", server, CreateSessionRequest)
+
+	client.WriteTo(request, server.LocalAddr())
+	expectNoIncoming(t, "TestEndpointDuplicateRequests", server)
+
+	if err := message.Respond(NewPDU(CreateSessionResponse, 0, []*IE{NewIEWithRawData(Cause, []byte{0x10, 0x00})}).AddTEID(0x1234)); err != nil {
+		t.Fatalf("[TestEndpointDuplicateRequests] on Respond() expected no error, got = (%s)", err)
+	}
+
+	response := readDatagram(client, time.Second)
+	if response == nil {
+		t.Fatalf("[TestEndpointDuplicateRequests] expected response, got none")
+	}
+
+	// a duplicate received after the response is sent is answered with the same
+	// response
+	clock.Advance(9 * time.Second)
+	client.WriteTo(request, server.LocalAddr())
+
+	if err := compareByteArrays(response, readDatagram(client, time.Second)); err != nil {
+		t.Errorf("[TestEndpointDuplicateRequests] on replayed response: %s", err)
+	}
+	expectNoIncoming(t, "TestEndpointDuplicateRequests", server)
+
+	// a request with the same sequence number, but another message type, is not a
+	// duplicate
+	client.WriteTo(NewPDU(EchoRequest, 0x0105, nil).Encode(), server.LocalAddr())
+	expectIncoming(t, "TestEndpointDuplicateRequests", server, EchoRequest)
+
+	// the window restarts when the response is sent, so the request is remembered
+	// until 10 seconds after that
+	clock.Advance(2 * time.Second)
+	client.WriteTo(request, server.LocalAddr())
+	expectIncoming(t, "TestEndpointDuplicateRequests", server, CreateSessionRequest)
+
+	if len(server.responses.requests) != 2 || server.responses.byExpiry.Len() != 2 {
+		t.Errorf("[TestEndpointDuplicateRequests] expected expired entries to be evicted, leaving (2), got (%d) and (%d)", len(server.responses.requests), server.responses.byExpiry.Len())
+	}
+}
+
+func TestEndpointDuplicateRequestsWithoutCache(t *testing.T) {
+	server, err := ListenEndpointWithOptions("127.0.0.1:0", EndpointOptions{ResponseCacheWindow: -1})
+	if err != nil {
+		t.Fatalf("[TestEndpointDuplicateRequestsWithoutCache] on ListenEndpointWithOptions() expected no error, got = (%s)", err)
+	}
+	defer server.Close()
+
+	client, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("[TestEndpointDuplicateRequestsWithoutCache] on ListenPacket() expected no error, got = (%s)", err)
+	}
+	defer client.Close()
+
+	request := NewPDU(EchoRequest, 0x10, nil).Encode()
+
+	for i := 0; i < 2; i++ {
+		client.WriteTo(request, server.LocalAddr())
+		expectIncoming(t, "TestEndpointDuplicateRequestsWithoutCache", server, EchoRequest).Respond(NewPDU(EchoResponse, 0, nil))
+	}
+}
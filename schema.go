@@ -0,0 +1,261 @@
+package gtpv2
+
+import "fmt"
+
+//go:generate go run gen_schemas.go
+
+// IEPresence is the presence requirement of an IE in a message (TS 29.274
+// section 7.1)
+type IEPresence int
+
+// Possible IEPresence values
+const (
+	PresenceMandatory IEPresence = iota + 1
+	PresenceConditional
+	PresenceConditionalOptional
+	PresenceOptional
+)
+
+func (presence IEPresence) String() string {
+	switch presence {
+	case PresenceMandatory:
+		return "M"
+	case PresenceConditional:
+		return "C"
+	case PresenceConditionalOptional:
+		return "CO"
+	case PresenceOptional:
+		return "O"
+	}
+
+	return fmt.Sprintf("unknown presence (%d)", int(presence))
+}
+
+// IESchema describes an IE that may appear in a message or in a grouped IE.  Role
+// is the name of the IE in that context (e.g., "SenderFTEIDForControlPlane").
+// Multiple is true if several IEs with the same type and instance may be present.
+// For a grouped IE, Group names the content (e.g.,
+// "CreateSessionRequestBearerContextToBeCreated") and GroupedIEs describes the IEs
+// it contains.
+//
+// The remaining fields are used by an InterfaceProfile.  If Interfaces is not
+// empty, the IE may appear only on the listed interfaces.  PresenceByInterface
+// overrides Presence on particular interfaces.  For an F-TEID, FTEIDInterfaceTypes
+// lists the F-TEID interface type values allowed on each interface; an interface
+// that is not listed allows any value.
+type IESchema struct {
+	Role                string
+	Type                IEType
+	Instance            uint8
+	Presence            IEPresence
+	Multiple            bool
+	Interfaces          []Interface
+	PresenceByInterface map[Interface]IEPresence
+	FTEIDInterfaceTypes map[Interface][]FTEIDInterfaceType
+	Group               string
+	GroupedIEs          []*IESchema
+}
+
+// MessageSchema describes the IEs that may appear in a message of the provided type,
+// in the order of TS 29.274 chapter 7.  Private Extension, which any message may
+// carry, is not included.
+type MessageSchema struct {
+	Type MessageType
+	IEs  []*IESchema
+}
+
+// SchemaForMessageType returns the schema for the message type.  The boolean is
+// false if the package has no schema for the message type.
+func SchemaForMessageType(messageType MessageType) (*MessageSchema, bool) {
+	schema, isKnown := messageSchemas[messageType]
+	return schema, isKnown
+}
+
+func ieSchemaFor(schemas []*IESchema, ieType IEType, instance uint8) *IESchema {
+	for _, schema := range schemas {
+		if schema.Type == ieType && schema.Instance == instance {
+			return schema
+		}
+	}
+
+	return nil
+}
+
+func ieSchemasHaveType(schemas []*IESchema, ieType IEType) bool {
+	for _, schema := range schemas {
+		if schema.Type == ieType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SchemaViolationKind identifies the way in which a message does not conform to
+// its schema
+type SchemaViolationKind int
+
+// Possible SchemaViolationKind values
+const (
+	// ViolationMissingMandatoryIE means a mandatory IE is absent
+	ViolationMissingMandatoryIE SchemaViolationKind = iota + 1
+
+	// ViolationUnexpectedInstance means an IE has a type that the schema expects,
+	// but an instance number that it does not
+	ViolationUnexpectedInstance
+
+	// ViolationTooManyOccurrences means an IE that may appear only once appears
+	// more than once
+	ViolationTooManyOccurrences
+
+	// ViolationMalformedGroupedIE means the data of a grouped IE cannot be decoded
+	ViolationMalformedGroupedIE
+
+	// ViolationMessageNotAllowedOnInterface means the message type may not appear
+	// on the interface of the InterfaceProfile
+	ViolationMessageNotAllowedOnInterface
+
+	// ViolationIENotAllowedOnInterface means the IE may not appear on the
+	// interface of the InterfaceProfile
+	ViolationIENotAllowedOnInterface
+
+	// ViolationUnexpectedFTEIDInterfaceType means an F-TEID has an interface type
+	// value that is not allowed for its role on the interface of the
+	// InterfaceProfile
+	ViolationUnexpectedFTEIDInterfaceType
+)
+
+var schemaViolationKindDescriptions = map[SchemaViolationKind]string{
+	ViolationMissingMandatoryIE:           "missing mandatory IE",
+	ViolationUnexpectedInstance:           "unexpected instance",
+	ViolationTooManyOccurrences:           "too many occurrences",
+	ViolationMalformedGroupedIE:           "malformed grouped IE",
+	ViolationMessageNotAllowedOnInterface: "message not allowed on interface",
+	ViolationIENotAllowedOnInterface:      "IE not allowed on interface",
+	ViolationUnexpectedFTEIDInterfaceType: "unexpected F-TEID interface type",
+}
+
+func (kind SchemaViolationKind) String() string {
+	if description, isKnown := schemaViolationKindDescriptions[kind]; isKnown {
+		return description
+	}
+
+	return fmt.Sprintf("unknown schema violation (%d)", int(kind))
+}
+
+// SchemaViolation describes a way in which a message does not conform to its
+// schema.  Path locates the offending IE using the syntax of IE.LookupIE().  For
+// a missing IE, Path is the grouped IE from which it is missing, and is empty at
+// the message level.  Role is the role of the IE in the schema, if it has one.
+// Interface is set only for violations found by an InterfaceProfile.  For
+// ViolationMessageNotAllowedOnInterface, MessageType is set, and Path, Role, Type
+// and Instance are not.
+type SchemaViolation struct {
+	Kind        SchemaViolationKind
+	Path        string
+	Role        string
+	Type        IEType
+	Instance    uint8
+	MessageType MessageType
+	Interface   Interface
+}
+
+func (violation *SchemaViolation) Error() string {
+	if violation.Kind == ViolationMessageNotAllowedOnInterface {
+		return fmt.Sprintf("%s: %s on %s", violation.Kind, NameOfMessageForType(violation.MessageType), violation.Interface)
+	}
+
+	location := "message"
+	if violation.Path != "" {
+		location = violation.Path
+	}
+
+	role := "IE"
+	if violation.Role != "" {
+		role = violation.Role
+	}
+
+	description := fmt.Sprintf("%s: %s with type (%s) and instance (%d) in %s", violation.Kind, role, NameOfIEForType(violation.Type), violation.Instance, location)
+	if violation.Interface != 0 {
+		description += fmt.Sprintf(" on %s", violation.Interface)
+	}
+
+	return description
+}
+
+// Validate checks the IEs of the PDU, and the IEs inside its grouped IEs, against
+// the schema for the PDU message type and returns every violation found.  Only the
+// presence of mandatory IEs is checked, because conditions are not evaluated.  IE
+// types that the schema does not mention (including Private Extension) are ignored,
+// as they must be by a receiver, and so are unparsed IEs (see IE.Unparsed).  Returns
+// nil if there is no schema for the message type.
+func Validate(pdu *PDU) []*SchemaViolation {
+	schema, isKnown := SchemaForMessageType(pdu.Type)
+	if !isKnown {
+		return nil
+	}
+
+	return validateIEsAgainstSchema(pdu.InformationElements, schema.IEs, "", nil)
+}
+
+// validateIEsAgainstSchema checks the IEs against the schemas.  If profile is not
+// nil, the IEs are also checked against the interface of the profile.
+func validateIEsAgainstSchema(ies []*IE, schemas []*IESchema, parentPath string, profile *InterfaceProfile) []*SchemaViolation {
+	violations := make([]*SchemaViolation, 0)
+	countOfIEsByType := make(map[IEType]int)
+	countOfIEsBySchema := make(map[*IESchema]int)
+
+	for _, ie := range ies {
+		if ie.Unparsed {
+			continue
+		}
+
+		path := joinIELookupPath(parentPath, pathSegmentForIE(ie.Type, countOfIEsByType[ie.Type]))
+		countOfIEsByType[ie.Type]++
+
+		schema := ieSchemaFor(schemas, ie.Type, ie.InstanceNumber)
+		if schema == nil {
+			if ieSchemasHaveType(schemas, ie.Type) {
+				violations = append(violations, &SchemaViolation{Kind: ViolationUnexpectedInstance, Path: path, Type: ie.Type, Instance: ie.InstanceNumber})
+			}
+			continue
+		}
+
+		countOfIEsBySchema[schema]++
+		if countOfIEsBySchema[schema] > 1 && !schema.Multiple {
+			violations = append(violations, &SchemaViolation{Kind: ViolationTooManyOccurrences, Path: path, Role: schema.Role, Type: ie.Type, Instance: ie.InstanceNumber})
+		}
+
+		if profile != nil {
+			if kind, isViolation := profile.violationForIE(schema, ie); isViolation {
+				violations = append(violations, &SchemaViolation{Kind: kind, Path: path, Role: schema.Role, Type: ie.Type, Instance: ie.InstanceNumber, Interface: profile.Interface})
+				if kind == ViolationIENotAllowedOnInterface {
+					continue
+				}
+			}
+		}
+
+		if schema.GroupedIEs != nil {
+			groupedIEs, err := ExtractGroupedIEsFrom(ie)
+			if err != nil {
+				violations = append(violations, &SchemaViolation{Kind: ViolationMalformedGroupedIE, Path: path, Role: schema.Role, Type: ie.Type, Instance: ie.InstanceNumber})
+				continue
+			}
+
+			violations = append(violations, validateIEsAgainstSchema(groupedIEs, schema.GroupedIEs, path, profile)...)
+		}
+	}
+
+	for _, schema := range schemas {
+		presence := schema.Presence
+		if profile != nil {
+			presence, _ = profile.PresenceOf(schema)
+		}
+
+		if presence == PresenceMandatory && countOfIEsBySchema[schema] == 0 {
+			violations = append(violations, &SchemaViolation{Kind: ViolationMissingMandatoryIE, Path: parentPath, Role: schema.Role, Type: schema.Type, Instance: schema.Instance})
+		}
+	}
+
+	return violations
+}
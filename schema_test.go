@@ -0,0 +1,94 @@
+package gtpv2
+
+import (
+	"net"
+	"testing"
+)
+
+func TestSchemaForMessageType(t *testing.T) {
+	schema, isKnown := SchemaForMessageType(CreateSessionRequest)
+	if !isKnown {
+		t.Fatalf("[TestSchemaForMessageType] expected schema for Create Session Request, got none")
+	}
+
+	senderFTEID := ieSchemaFor(schema.IEs, FTEID, 0)
+	if senderFTEID == nil || senderFTEID.Role != "SenderFTEIDForControlPlane" || senderFTEID.Presence != PresenceMandatory {
+		t.Errorf("[TestSchemaForMessageType] expected mandatory SenderFTEIDForControlPlane at F-TEID instance 0, got = (%v)", senderFTEID)
+	}
+
+	bearerContext := ieSchemaFor(schema.IEs, BearerContext, 0)
+	if bearerContext == nil || !bearerContext.Multiple || ieSchemaFor(bearerContext.GroupedIEs, FTEID, 7) == nil {
+		t.Errorf("[TestSchemaForMessageType] expected multiple Bearer Contexts to be created with S11-U MME F-TEID at instance 7, got = (%v)", bearerContext)
+	}
+
+	if _, isKnown := SchemaForMessageType(MBMSSessionStartRequest); isKnown {
+		t.Errorf("[TestSchemaForMessageType] expected no schema for MBMS Session Start Request, got one")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	fteidIE := func(instance uint8) *IE {
+		ie := (&TypedFTEID{IPv4Addr: net.IPv4(10, 1, 1, 1), InterfaceType: 10, Key: 0x01}).ToIE()
+		ie.InstanceNumber = instance
+		return ie
+	}
+
+	validPDU := NewPDU(CreateSessionRequest, 1, []*IE{
+		(&TypedIMSI{AsString: "001002789012345"}).ToIE(),
+		NewIEWithRawData(RATType, []byte{0x06}),
+		fteidIE(0),
+		fteidIE(1),
+		NewIEWithRawData(APN, []byte("\x03apn")),
+		NewGroupedIE(BearerContext, []*IE{
+			NewIEWithRawData(EBI, []byte{0x05}),
+			NewIEWithRawData(BearerQoS, make([]byte, 22)),
+		}),
+		NewGroupedIE(BearerContext, []*IE{
+			NewIEWithRawData(EBI, []byte{0x06}),
+			NewIEWithRawData(BearerQoS, make([]byte, 22)),
+		}),
+		(&TypedPrivateExtension{EnterpriseID: 10415, Value: []byte{0x01}}).ToIE(),
+	}).SetTEID(0)
+
+	if violations := Validate(validPDU); len(violations) != 0 {
+		t.Errorf("[TestValidate] expected no violations for valid Create Session Request, got = (%v)", violations)
+	}
+
+	invalidPDU := NewPDU(CreateSessionRequest, 1, []*IE{
+		NewIEWithRawData(RATType, []byte{0x06}),
+		NewIEWithRawData(RATType, []byte{0x06}),
+		fteidIE(2),
+		NewIEWithRawData(APN, []byte("\x03apn")),
+		NewGroupedIE(BearerContext, []*IE{
+			NewIEWithRawData(BearerQoS, make([]byte, 22)),
+		}),
+		NewIEWithRawData(BearerContext, []byte{0x49, 0x00, 0x05}),
+	}).SetTEID(0)
+
+	expectedViolations := []struct {
+		kind SchemaViolationKind
+		path string
+		role string
+	}{
+		{ViolationTooManyOccurrences, "RATType[1]", "RATType"},
+		{ViolationUnexpectedInstance, "FTEID", ""},
+		{ViolationMissingMandatoryIE, "BearerContext", "EPSBearerID"},
+		{ViolationMalformedGroupedIE, "BearerContext[1]", "BearerContextsToBeCreated"},
+		{ViolationMissingMandatoryIE, "", "SenderFTEIDForControlPlane"},
+	}
+
+	violations := Validate(invalidPDU)
+	if len(violations) != len(expectedViolations) {
+		t.Fatalf("[TestValidate] expected (%d) violations, got = (%d): %v", len(expectedViolations), len(violations), violations)
+	}
+
+	for i, expected := range expectedViolations {
+		if violations[i].Kind != expected.kind || violations[i].Path != expected.path || violations[i].Role != expected.role {
+			t.Errorf("[TestValidate] for violation (%d), expected (%s) at (%s) for role (%s), got = (%s) at (%s) for role (%s)", i, expected.kind, expected.path, expected.role, violations[i].Kind, violations[i].Path, violations[i].Role)
+		}
+	}
+
+	if violations := Validate(NewPDU(MBMSSessionStartRequest, 1, nil)); violations != nil {
+		t.Errorf("[TestValidate] expected no violations for message type without schema, got = (%v)", violations)
+	}
+}
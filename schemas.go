@@ -0,0 +1,896 @@
+// Code generated by gen_schemas.go from schemas.yaml; DO NOT EDIT.
+
+package gtpv2
+
+var messageSchemas = map[MessageType]*MessageSchema{
+	EchoRequest: {
+		Type: EchoRequest,
+		IEs: []*IESchema{
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceMandatory},
+			{Role: "SendingNodeFeatures", Type: NodeFeatures, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	EchoResponse: {
+		Type: EchoResponse,
+		IEs: []*IESchema{
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceMandatory},
+			{Role: "SendingNodeFeatures", Type: NodeFeatures, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	CreateSessionRequest: {
+		Type: CreateSessionRequest,
+		IEs: []*IESchema{
+			{Role: "IMSI", Type: IMSI, Instance: 0, Presence: PresenceConditional, PresenceByInterface: map[Interface]IEPresence{InterfaceS2a: PresenceMandatory, InterfaceS2b: PresenceMandatory}},
+			{Role: "MSISDN", Type: MSISDN, Instance: 0, Presence: PresenceConditional},
+			{Role: "MEI", Type: MEI, Instance: 0, Presence: PresenceConditional},
+			{Role: "UserLocationInformation", Type: ULI, Instance: 0, Presence: PresenceConditional},
+			{Role: "ServingNetwork", Type: ServingNetwork, Instance: 0, Presence: PresenceConditional},
+			{Role: "RATType", Type: RATType, Instance: 0, Presence: PresenceMandatory},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditional},
+			{Role: "SenderFTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceMandatory, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS2a: {35}, InterfaceS2b: {30}, InterfaceS4: {17}, InterfaceS5S8: {6, 8}, InterfaceS11: {10}}},
+			{Role: "PGWS5S8AddressForControlPlane", Type: FTEID, Instance: 1, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4, InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {7, 9}, InterfaceS11: {7, 9}}},
+			{Role: "AccessPointName", Type: APN, Instance: 0, Presence: PresenceMandatory},
+			{Role: "SelectionMode", Type: SelectionMode, Instance: 0, Presence: PresenceConditional},
+			{Role: "PDNType", Type: PDNType, Instance: 0, Presence: PresenceConditional},
+			{Role: "PDNAddressAllocation", Type: PAA, Instance: 0, Presence: PresenceConditional},
+			{Role: "MaximumAPNRestriction", Type: APNRestriction, Instance: 0, Presence: PresenceConditional},
+			{Role: "APNAMBR", Type: AMBR, Instance: 0, Presence: PresenceConditional},
+			{Role: "LinkedEPSBearerID", Type: EBI, Instance: 0, Presence: PresenceConditional},
+			{Role: "TrustedWLANModeIndication", Type: TrustedWLANModeIndication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditional},
+			{Role: "BearerContextsToBeCreated", Type: BearerContext, Instance: 0, Presence: PresenceMandatory, Multiple: true, Group: "CreateSessionRequestBearerContextToBeCreated", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "TFT", Type: BearerTFT, Instance: 0, Presence: PresenceOptional},
+				{Role: "S1UeNodeBFTEID", Type: FTEID, Instance: 0, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS11: {0}}},
+				{Role: "S4USGSNFTEID", Type: FTEID, Instance: 1, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {15}}},
+				{Role: "S5S8USGWFTEID", Type: FTEID, Instance: 2, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS5S8}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS5S8: {4}}},
+				{Role: "S5S8UPGWFTEID", Type: FTEID, Instance: 3, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4, InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {5}, InterfaceS11: {5}}},
+				{Role: "S12RNCFTEID", Type: FTEID, Instance: 4, Presence: PresenceConditionalOptional, Interfaces: []Interface{InterfaceS4}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {2}}},
+				{Role: "S2bUePDGFTEID", Type: FTEID, Instance: 5, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS2b}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS2b: {31}}},
+				{Role: "S2aUTWANFTEID", Type: FTEID, Instance: 6, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS2a}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS2a: {34}}},
+				{Role: "BearerLevelQoS", Type: BearerQoS, Instance: 0, Presence: PresenceMandatory},
+				{Role: "S11UMMEFTEID", Type: FTEID, Instance: 7, Presence: PresenceConditionalOptional, Interfaces: []Interface{InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS11: {38}}},
+			}},
+			{Role: "BearerContextsToBeRemoved", Type: BearerContext, Instance: 1, Presence: PresenceConditional, Multiple: true, Interfaces: []Interface{InterfaceS4, InterfaceS11}, Group: "CreateSessionRequestBearerContextToBeRemoved", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "S4USGSNFTEID", Type: FTEID, Instance: 1, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {15}}},
+			}},
+			{Role: "TraceInformation", Type: TraceInformation, Instance: 0, Presence: PresenceConditional},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMEFQCSID", Type: FQCSID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWFQCSID", Type: FQCSID, Instance: 1, Presence: PresenceConditional},
+			{Role: "EPDGFQCSID", Type: FQCSID, Instance: 2, Presence: PresenceConditional},
+			{Role: "TWANFQCSID", Type: FQCSID, Instance: 3, Presence: PresenceConditional},
+			{Role: "UETimeZone", Type: UETimeZone, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UserCSGInformation", Type: UCI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ChargingCharacteristics", Type: ChargingCharacteristics, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMELDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWLDN", Type: LDN, Instance: 1, Presence: PresenceOptional},
+			{Role: "EPDGLDN", Type: LDN, Instance: 2, Presence: PresenceOptional},
+			{Role: "TWANLDN", Type: LDN, Instance: 3, Presence: PresenceOptional},
+			{Role: "SignallingPriorityIndication", Type: SignallingPriorityIndication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UELocalIPAddress", Type: IPAddress, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UEUDPPort", Type: PortNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "AdditionalProtocolConfigurationOptions", Type: APCO, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "HeNBLocalIPAddress", Type: IPAddress, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "HeNBUDPPort", Type: PortNumber, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "MMEIdentifier", Type: IPAddress, Instance: 2, Presence: PresenceConditionalOptional},
+			{Role: "TWANIdentifier", Type: TWANIdentifier, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "EPDGIPAddress", Type: IPAddress, Instance: 3, Presence: PresenceOptional},
+			{Role: "CNOperatorSelectionEntity", Type: CNOperatorSelectionEntity, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "PresenceReportingAreaInformation", Type: PresenceReportingAreaInformation, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "TWANOverloadControlInformation", Type: OverloadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "OriginationTimeStamp", Type: MillisecondTimeStamp, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MaximumWaitTime", Type: IntegerNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "WLANLocationInformation", Type: TWANIdentifier, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "WLANLocationTimestamp", Type: TWANIdentifierTimestamp, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "NBIFOMContainer", Type: FContainer, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "RemoteUEContextConnected", Type: RemoteUEContext, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "AAAServerIdentifier", Type: NodeIdentifier, Instance: 0, Presence: PresenceOptional},
+			{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ServingPLMNRateControl", Type: ServingPLMNRateControl, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MOExceptionDataCounter", Type: Counter, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UETCPPort", Type: PortNumber, Instance: 2, Presence: PresenceConditionalOptional},
+			{Role: "MappedUEUsageType", Type: MappedUEUsageType, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UserLocationInformationForSGW", Type: ULI, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "SGWUNodeName", Type: FQDN, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SecondaryRATUsageDataReports", Type: SecondaryRATUsageDataReport, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "UPFunctionSelectionIndicationFlags", Type: UPFunctionSelectionIndicationFlags, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "APNRateControlStatus", Type: APNRateControlStatus, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	CreateSessionResponse: {
+		Type: CreateSessionResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "ChangeReportingAction", Type: ChangeReportingAction, Instance: 0, Presence: PresenceConditional},
+			{Role: "CSGInformationReportingAction", Type: CSGInformationReportingAction, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "HeNBInformationReporting", Type: HeNBInformationReporting, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SenderFTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceConditional, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS2a: {36}, InterfaceS2b: {32}, InterfaceS4: {11}, InterfaceS5S8: {7, 9}, InterfaceS11: {11}}},
+			{Role: "PGWS5S8AddressForControlPlane", Type: FTEID, Instance: 1, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4, InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {7, 9}, InterfaceS11: {7, 9}}},
+			{Role: "PDNAddressAllocation", Type: PAA, Instance: 0, Presence: PresenceConditional},
+			{Role: "APNRestriction", Type: APNRestriction, Instance: 0, Presence: PresenceConditional},
+			{Role: "APNAMBR", Type: AMBR, Instance: 0, Presence: PresenceConditional},
+			{Role: "LinkedEPSBearerID", Type: EBI, Instance: 0, Presence: PresenceConditional},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditional},
+			{Role: "BearerContextsCreated", Type: BearerContext, Instance: 0, Presence: PresenceConditional, Multiple: true, Group: "CreateSessionResponseBearerContextCreated", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+				{Role: "S1USGWFTEID", Type: FTEID, Instance: 0, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS11: {1}}},
+				{Role: "S4USGWFTEID", Type: FTEID, Instance: 1, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {16}}},
+				{Role: "S5S8UPGWFTEID", Type: FTEID, Instance: 2, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4, InterfaceS5S8, InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {5}, InterfaceS5S8: {5}, InterfaceS11: {5}}},
+				{Role: "S12SGWFTEID", Type: FTEID, Instance: 3, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {3}}},
+				{Role: "S2bUPGWFTEID", Type: FTEID, Instance: 4, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS2b}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS2b: {33}}},
+				{Role: "S2aUPGWFTEID", Type: FTEID, Instance: 5, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS2a}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS2a: {37}}},
+				{Role: "BearerLevelQoS", Type: BearerQoS, Instance: 0, Presence: PresenceConditional},
+				{Role: "ChargingID", Type: ChargingID, Instance: 0, Presence: PresenceConditional},
+				{Role: "BearerFlags", Type: BearerFlags, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "S11USGWFTEID", Type: FTEID, Instance: 6, Presence: PresenceConditionalOptional, Interfaces: []Interface{InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS11: {39}}},
+			}},
+			{Role: "BearerContextsMarkedForRemoval", Type: BearerContext, Instance: 1, Presence: PresenceConditional, Multiple: true, Group: "CreateSessionResponseBearerContextMarkedForRemoval", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			}},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditional},
+			{Role: "ChargingGatewayName", Type: FQDN, Instance: 0, Presence: PresenceConditional},
+			{Role: "ChargingGatewayAddress", Type: IPAddress, Instance: 0, Presence: PresenceConditional},
+			{Role: "PGWFQCSID", Type: FQCSID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWFQCSID", Type: FQCSID, Instance: 1, Presence: PresenceConditional},
+			{Role: "SGWLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+			{Role: "PGWLDN", Type: LDN, Instance: 1, Presence: PresenceOptional},
+			{Role: "PGWBackOffTime", Type: EPCTimer, Instance: 0, Presence: PresenceOptional},
+			{Role: "AdditionalProtocolConfigurationOptions", Type: APCO, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "TrustedWLANIPv4Parameters", Type: IP4CP, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "PresenceReportingAreaActions", Type: PresenceReportingAreaAction, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "PGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "PGWAPNLevelLoadControlInformation", Type: LoadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "SGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "PGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "NBIFOMContainer", Type: FContainer, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "PDNConnectionChargingID", Type: ChargingID, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	ModifyBearerRequest: {
+		Type: ModifyBearerRequest,
+		IEs: []*IESchema{
+			{Role: "MEI", Type: MEI, Instance: 0, Presence: PresenceConditional},
+			{Role: "UserLocationInformation", Type: ULI, Instance: 0, Presence: PresenceConditional},
+			{Role: "ServingNetwork", Type: ServingNetwork, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "RATType", Type: RATType, Instance: 0, Presence: PresenceConditional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditional},
+			{Role: "SenderFTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceConditional, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {17}, InterfaceS5S8: {6, 8}, InterfaceS11: {10}}},
+			{Role: "APNAMBR", Type: AMBR, Instance: 0, Presence: PresenceConditional},
+			{Role: "DelayDownlinkPacketNotificationRequest", Type: DelayValue, Instance: 0, Presence: PresenceConditional},
+			{Role: "BearerContextsToBeModified", Type: BearerContext, Instance: 0, Presence: PresenceConditional, Multiple: true, Group: "ModifyBearerRequestBearerContextToBeModified", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "S1UeNodeBFTEID", Type: FTEID, Instance: 0, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS11: {0}}},
+				{Role: "S5S8USGWFTEID", Type: FTEID, Instance: 1, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS5S8}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS5S8: {4}}},
+				{Role: "S12RNCFTEID", Type: FTEID, Instance: 2, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {2}}},
+				{Role: "S4USGSNFTEID", Type: FTEID, Instance: 3, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {15}}},
+				{Role: "S11UMMEFTEID", Type: FTEID, Instance: 4, Presence: PresenceConditionalOptional, Interfaces: []Interface{InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS11: {38}}},
+			}},
+			{Role: "BearerContextsToBeRemoved", Type: BearerContext, Instance: 1, Presence: PresenceConditional, Multiple: true, Group: "ModifyBearerRequestBearerContextToBeRemoved", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+			}},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditional},
+			{Role: "UETimeZone", Type: UETimeZone, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEFQCSID", Type: FQCSID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWFQCSID", Type: FQCSID, Instance: 1, Presence: PresenceConditional},
+			{Role: "UserCSGInformation", Type: UCI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UELocalIPAddress", Type: IPAddress, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UEUDPPort", Type: PortNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMELDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWLDN", Type: LDN, Instance: 1, Presence: PresenceOptional},
+			{Role: "HeNBLocalIPAddress", Type: IPAddress, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "HeNBUDPPort", Type: PortNumber, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "MMEIdentifier", Type: IPAddress, Instance: 2, Presence: PresenceConditionalOptional},
+			{Role: "CNOperatorSelectionEntity", Type: CNOperatorSelectionEntity, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "PresenceReportingAreaInformation", Type: PresenceReportingAreaInformation, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "EPDGOverloadControlInformation", Type: OverloadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "ServingPLMNRateControl", Type: ServingPLMNRateControl, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MOExceptionDataCounter", Type: Counter, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "IMSI", Type: IMSI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UserLocationInformationForSGW", Type: ULI, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "WLANLocationInformation", Type: TWANIdentifier, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "WLANLocationTimestamp", Type: TWANIdentifierTimestamp, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SecondaryRATUsageDataReports", Type: SecondaryRATUsageDataReport, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+		},
+	},
+	ModifyBearerResponse: {
+		Type: ModifyBearerResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "MSISDN", Type: MSISDN, Instance: 0, Presence: PresenceConditional},
+			{Role: "LinkedEPSBearerID", Type: EBI, Instance: 0, Presence: PresenceConditional},
+			{Role: "APNRestriction", Type: APNRestriction, Instance: 0, Presence: PresenceConditional},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditional},
+			{Role: "BearerContextsModified", Type: BearerContext, Instance: 0, Presence: PresenceConditional, Multiple: true, Group: "ModifyBearerResponseBearerContextModified", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+				{Role: "S1USGWFTEID", Type: FTEID, Instance: 0, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS11: {1}}},
+				{Role: "S12SGWFTEID", Type: FTEID, Instance: 1, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {3}}},
+				{Role: "S4USGWFTEID", Type: FTEID, Instance: 2, Presence: PresenceConditional, Interfaces: []Interface{InterfaceS4}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS4: {16}}},
+				{Role: "ChargingID", Type: ChargingID, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "BearerFlags", Type: BearerFlags, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "S11USGWFTEID", Type: FTEID, Instance: 3, Presence: PresenceConditionalOptional, Interfaces: []Interface{InterfaceS11}, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS11: {39}}},
+			}},
+			{Role: "BearerContextsMarkedForRemoval", Type: BearerContext, Instance: 1, Presence: PresenceConditional, Multiple: true, Group: "ModifyBearerResponseBearerContextMarkedForRemoval", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			}},
+			{Role: "ChangeReportingAction", Type: ChangeReportingAction, Instance: 0, Presence: PresenceConditional},
+			{Role: "CSGInformationReportingAction", Type: CSGInformationReportingAction, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "HeNBInformationReporting", Type: HeNBInformationReporting, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ChargingGatewayName", Type: FQDN, Instance: 0, Presence: PresenceConditional},
+			{Role: "ChargingGatewayAddress", Type: IPAddress, Instance: 0, Presence: PresenceConditional},
+			{Role: "PGWFQCSID", Type: FQCSID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWFQCSID", Type: FQCSID, Instance: 1, Presence: PresenceConditional},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+			{Role: "PGWLDN", Type: LDN, Instance: 1, Presence: PresenceOptional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "PresenceReportingAreaActions", Type: PresenceReportingAreaAction, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "PGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "PGWAPNLevelLoadControlInformation", Type: LoadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "SGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "PGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "PDNConnectionChargingID", Type: ChargingID, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	DeleteSessionRequest: {
+		Type: DeleteSessionRequest,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceConditional},
+			{Role: "LinkedEPSBearerID", Type: EBI, Instance: 0, Presence: PresenceConditional},
+			{Role: "UserLocationInformation", Type: ULI, Instance: 0, Presence: PresenceConditional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditional},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditional},
+			{Role: "OriginatingNode", Type: NodeType, Instance: 0, Presence: PresenceConditional},
+			{Role: "SenderFTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceOptional, FTEIDInterfaceTypes: map[Interface][]FTEIDInterfaceType{InterfaceS2a: {35}, InterfaceS2b: {30}, InterfaceS4: {17}, InterfaceS5S8: {6, 8}, InterfaceS11: {10}}},
+			{Role: "UETimeZone", Type: UETimeZone, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ULITimestamp", Type: ULITimestamp, Instance: 0, Presence: PresenceOptional},
+			{Role: "RANNASReleaseCause", Type: RANNASCause, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "TWANIdentifier", Type: TWANIdentifier, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "TWANIdentifierTimestamp", Type: TWANIdentifierTimestamp, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "TWANOverloadControlInformation", Type: OverloadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "WLANLocationInformation", Type: TWANIdentifier, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "WLANLocationTimestamp", Type: TWANIdentifierTimestamp, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "UELocalIPAddress", Type: IPAddress, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UEUDPPort", Type: PortNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UETCPPort", Type: PortNumber, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "SecondaryRATUsageDataReports", Type: SecondaryRATUsageDataReport, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+		},
+	},
+	DeleteSessionResponse: {
+		Type: DeleteSessionResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditional},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "PGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "PGWAPNLevelLoadControlInformation", Type: LoadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "SGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "PGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	CreateBearerRequest: {
+		Type: CreateBearerRequest,
+		IEs: []*IESchema{
+			{Role: "ProcedureTransactionID", Type: ProcedureTransactionID, Instance: 0, Presence: PresenceConditional},
+			{Role: "LinkedEPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceOptional},
+			{Role: "BearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceMandatory, Multiple: true, Group: "CreateBearerRequestBearerContext", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "TFT", Type: BearerTFT, Instance: 0, Presence: PresenceMandatory},
+				{Role: "S1USGWFTEID", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+				{Role: "S5S8UPGWFTEID", Type: FTEID, Instance: 1, Presence: PresenceConditional},
+				{Role: "S12SGWFTEID", Type: FTEID, Instance: 2, Presence: PresenceConditional},
+				{Role: "S4USGWFTEID", Type: FTEID, Instance: 3, Presence: PresenceConditional},
+				{Role: "S2bUPGWFTEID", Type: FTEID, Instance: 4, Presence: PresenceConditional},
+				{Role: "S2aUPGWFTEID", Type: FTEID, Instance: 5, Presence: PresenceConditional},
+				{Role: "BearerLevelQoS", Type: BearerQoS, Instance: 0, Presence: PresenceMandatory},
+				{Role: "ChargingID", Type: ChargingID, Instance: 0, Presence: PresenceOptional},
+				{Role: "BearerFlags", Type: BearerFlags, Instance: 0, Presence: PresenceOptional},
+				{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceOptional},
+				{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceOptional},
+				{Role: "MaximumPacketLossRate", Type: MaximumPacketLossRate, Instance: 0, Presence: PresenceOptional},
+			}},
+			{Role: "PGWFQCSID", Type: FQCSID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWFQCSID", Type: FQCSID, Instance: 1, Presence: PresenceConditional},
+			{Role: "ChangeReportingAction", Type: ChangeReportingAction, Instance: 0, Presence: PresenceConditional},
+			{Role: "CSGInformationReportingAction", Type: CSGInformationReportingAction, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "HeNBInformationReporting", Type: HeNBInformationReporting, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "PresenceReportingAreaActions", Type: PresenceReportingAreaAction, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "PGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "PGWAPNLevelLoadControlInformation", Type: LoadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "SGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "PGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "NBIFOMContainer", Type: FContainer, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceOptional},
+		},
+	},
+	CreateBearerResponse: {
+		Type: CreateBearerResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "BearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceMandatory, Multiple: true, Group: "CreateBearerResponseBearerContext", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+				{Role: "S1UeNodeBFTEID", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+				{Role: "S1USGWFTEID", Type: FTEID, Instance: 1, Presence: PresenceConditional},
+				{Role: "S5S8USGWFTEID", Type: FTEID, Instance: 2, Presence: PresenceConditional},
+				{Role: "S5S8UPGWFTEID", Type: FTEID, Instance: 3, Presence: PresenceConditional},
+				{Role: "S12RNCFTEID", Type: FTEID, Instance: 4, Presence: PresenceConditional},
+				{Role: "S12SGWFTEID", Type: FTEID, Instance: 5, Presence: PresenceConditional},
+				{Role: "S4USGSNFTEID", Type: FTEID, Instance: 6, Presence: PresenceConditional},
+				{Role: "S4USGWFTEID", Type: FTEID, Instance: 7, Presence: PresenceConditional},
+				{Role: "S2bUePDGFTEID", Type: FTEID, Instance: 8, Presence: PresenceConditional},
+				{Role: "S2bUPGWFTEID", Type: FTEID, Instance: 9, Presence: PresenceConditional},
+				{Role: "S2aUTWANFTEID", Type: FTEID, Instance: 10, Presence: PresenceConditional},
+				{Role: "S2aUPGWFTEID", Type: FTEID, Instance: 11, Presence: PresenceConditional},
+				{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "RANNASCause", Type: RANNASCause, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceConditionalOptional},
+			}},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMEFQCSID", Type: FQCSID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWFQCSID", Type: FQCSID, Instance: 1, Presence: PresenceConditional},
+			{Role: "EPDGFQCSID", Type: FQCSID, Instance: 2, Presence: PresenceConditional},
+			{Role: "TWANFQCSID", Type: FQCSID, Instance: 3, Presence: PresenceConditional},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UETimeZone", Type: UETimeZone, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UserLocationInformation", Type: ULI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "TWANIdentifier", Type: TWANIdentifier, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "PresenceReportingAreaInformation", Type: PresenceReportingAreaInformation, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEIdentifier", Type: IPAddress, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "TWANOverloadControlInformation", Type: OverloadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "WLANLocationInformation", Type: TWANIdentifier, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "WLANLocationTimestamp", Type: TWANIdentifierTimestamp, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UELocalIPAddress", Type: IPAddress, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "UEUDPPort", Type: PortNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "NBIFOMContainer", Type: FContainer, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UETCPPort", Type: PortNumber, Instance: 1, Presence: PresenceConditionalOptional},
+		},
+	},
+	UpdateBearerRequest: {
+		Type: UpdateBearerRequest,
+		IEs: []*IESchema{
+			{Role: "BearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceMandatory, Multiple: true, Group: "UpdateBearerRequestBearerContext", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "TFT", Type: BearerTFT, Instance: 0, Presence: PresenceConditional},
+				{Role: "BearerLevelQoS", Type: BearerQoS, Instance: 0, Presence: PresenceConditional},
+				{Role: "BearerFlags", Type: BearerFlags, Instance: 0, Presence: PresenceOptional},
+				{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceOptional},
+				{Role: "AdditionalProtocolConfigurationOptions", Type: APCO, Instance: 0, Presence: PresenceOptional},
+				{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceOptional},
+				{Role: "MaximumPacketLossRate", Type: MaximumPacketLossRate, Instance: 0, Presence: PresenceOptional},
+			}},
+			{Role: "ProcedureTransactionID", Type: ProcedureTransactionID, Instance: 0, Presence: PresenceConditional},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceOptional},
+			{Role: "APNAMBR", Type: AMBR, Instance: 0, Presence: PresenceMandatory},
+			{Role: "ChangeReportingAction", Type: ChangeReportingAction, Instance: 0, Presence: PresenceConditional},
+			{Role: "CSGInformationReportingAction", Type: CSGInformationReportingAction, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "HeNBInformationReporting", Type: HeNBInformationReporting, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditional},
+			{Role: "PGWFQCSID", Type: FQCSID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWFQCSID", Type: FQCSID, Instance: 1, Presence: PresenceConditional},
+			{Role: "PresenceReportingAreaActions", Type: PresenceReportingAreaAction, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "PGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "PGWAPNLevelLoadControlInformation", Type: LoadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "SGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "PGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "NBIFOMContainer", Type: FContainer, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceOptional},
+		},
+	},
+	UpdateBearerResponse: {
+		Type: UpdateBearerResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "BearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceMandatory, Multiple: true, Group: "UpdateBearerResponseBearerContext", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+				{Role: "S4USGSNFTEID", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+				{Role: "S12RNCFTEID", Type: FTEID, Instance: 1, Presence: PresenceConditional},
+				{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "RANNASCause", Type: RANNASCause, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceConditionalOptional},
+			}},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMEFQCSID", Type: FQCSID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWFQCSID", Type: FQCSID, Instance: 1, Presence: PresenceConditional},
+			{Role: "EPDGFQCSID", Type: FQCSID, Instance: 2, Presence: PresenceConditional},
+			{Role: "TWANFQCSID", Type: FQCSID, Instance: 3, Presence: PresenceConditional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditional},
+			{Role: "UETimeZone", Type: UETimeZone, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UserLocationInformation", Type: ULI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "TWANIdentifier", Type: TWANIdentifier, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "PresenceReportingAreaInformation", Type: PresenceReportingAreaInformation, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEIdentifier", Type: IPAddress, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "TWANOverloadControlInformation", Type: OverloadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "WLANLocationInformation", Type: TWANIdentifier, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "WLANLocationTimestamp", Type: TWANIdentifierTimestamp, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UELocalIPAddress", Type: IPAddress, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "UEUDPPort", Type: PortNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "NBIFOMContainer", Type: FContainer, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UETCPPort", Type: PortNumber, Instance: 1, Presence: PresenceConditionalOptional},
+		},
+	},
+	DeleteBearerRequest: {
+		Type: DeleteBearerRequest,
+		IEs: []*IESchema{
+			{Role: "LinkedEPSBearerID", Type: EBI, Instance: 0, Presence: PresenceConditional},
+			{Role: "EPSBearerIDs", Type: EBI, Instance: 1, Presence: PresenceConditional, Multiple: true},
+			{Role: "FailedBearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceOptional, Multiple: true, Group: "DeleteBearerRequestFailedBearerContext", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			}},
+			{Role: "ProcedureTransactionID", Type: ProcedureTransactionID, Instance: 0, Presence: PresenceConditional},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditional},
+			{Role: "PGWFQCSID", Type: FQCSID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWFQCSID", Type: FQCSID, Instance: 1, Presence: PresenceConditional},
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceConditional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "PGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "PGWAPNLevelLoadControlInformation", Type: LoadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "SGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "PGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "NBIFOMContainer", Type: FContainer, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "APNRateControlStatus", Type: APNRateControlStatus, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	DeleteBearerResponse: {
+		Type: DeleteBearerResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "LinkedEPSBearerID", Type: EBI, Instance: 0, Presence: PresenceConditional},
+			{Role: "BearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceConditional, Multiple: true, Group: "DeleteBearerResponseBearerContext", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+				{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "RANNASCause", Type: RANNASCause, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "ExtendedProtocolConfigurationOptions", Type: ePCO, Instance: 0, Presence: PresenceConditionalOptional},
+			}},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMEFQCSID", Type: FQCSID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWFQCSID", Type: FQCSID, Instance: 1, Presence: PresenceConditional},
+			{Role: "EPDGFQCSID", Type: FQCSID, Instance: 2, Presence: PresenceConditional},
+			{Role: "TWANFQCSID", Type: FQCSID, Instance: 3, Presence: PresenceConditional},
+			{Role: "ProtocolConfigurationOptions", Type: PCI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UETimeZone", Type: UETimeZone, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UserLocationInformation", Type: ULI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ULITimestamp", Type: ULITimestamp, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "TWANIdentifier", Type: TWANIdentifier, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "TWANIdentifierTimestamp", Type: TWANIdentifierTimestamp, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 1, Presence: PresenceOptional},
+			{Role: "MMEIdentifier", Type: IPAddress, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "TWANOverloadControlInformation", Type: OverloadControlInformation, Instance: 2, Presence: PresenceOptional},
+			{Role: "WLANLocationInformation", Type: TWANIdentifier, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "WLANLocationTimestamp", Type: TWANIdentifierTimestamp, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "UELocalIPAddress", Type: IPAddress, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "UEUDPPort", Type: PortNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "NBIFOMContainer", Type: FContainer, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UETCPPort", Type: PortNumber, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "SecondaryRATUsageDataReports", Type: SecondaryRATUsageDataReport, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+		},
+	},
+	ReleaseAccessBearersRequest: {
+		Type: ReleaseAccessBearersRequest,
+		IEs: []*IESchema{
+			{Role: "ListOfRABs", Type: EBI, Instance: 0, Presence: PresenceOptional, Multiple: true},
+			{Role: "OriginatingNode", Type: NodeType, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SecondaryRATUsageDataReports", Type: SecondaryRATUsageDataReport, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+		},
+	},
+	ReleaseAccessBearersResponse: {
+		Type: ReleaseAccessBearersResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceOptional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+		},
+	},
+	DownlinkDataNotification: {
+		Type: DownlinkDataNotification,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "AllocationRetentionPriority", Type: ARP, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "IMSI", Type: IMSI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SenderFTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SGWNodeLevelLoadControlInformation", Type: LoadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGWOverloadControlInformation", Type: OverloadControlInformation, Instance: 0, Presence: PresenceOptional},
+			{Role: "PagingAndServiceInformation", Type: PagingandServiceInformation, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "DLDataPacketsSize", Type: IntegerNumber, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	DownlinkDataNotificationAcknowledge: {
+		Type: DownlinkDataNotificationAcknowledge,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "DataNotificationDelay", Type: DelayValue, Instance: 0, Presence: PresenceConditional},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceOptional},
+			{Role: "DLLowPriorityTrafficThrottling", Type: Throttling, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "IMSI", Type: IMSI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "DLBufferingDuration", Type: EPCTimer, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "DLBufferingSuggestedPacketCount", Type: IntegerNumber, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	IdentificationRequest: {
+		Type: IdentificationRequest,
+		IEs: []*IESchema{
+			{Role: "GUTI", Type: GUTI, Instance: 0, Presence: PresenceConditional},
+			{Role: "RoutingAreaIdentity", Type: ULI, Instance: 0, Presence: PresenceConditional},
+			{Role: "PTMSI", Type: PTMSI, Instance: 0, Presence: PresenceConditional},
+			{Role: "PTMSISignature", Type: PTMSISignature, Instance: 0, Presence: PresenceConditional},
+			{Role: "CompleteAttachRequestMessage", Type: CompleteRequestMessage, Instance: 0, Presence: PresenceConditional},
+			{Role: "AddressForControlPlane", Type: IPAddress, Instance: 0, Presence: PresenceOptional},
+			{Role: "UDPSourcePortNumber", Type: PortNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "HopCounter", Type: HopCounter, Instance: 0, Presence: PresenceOptional},
+			{Role: "TargetPLMNID", Type: ServingNetwork, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMESGSNLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+		},
+	},
+	IdentificationResponse: {
+		Type: IdentificationResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "IMSI", Type: IMSI, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextGSMKeyAndTriplets", Type: MMContextGSMKeyandTriplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextUMTSKeyUsedCipherAndQuintuplets", Type: MMContextUMTSKeyUsedCipherandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextGSMKeyUsedCipherAndQuintuplets", Type: MMContextGSMKeyUsedCipherandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextUMTSKeyAndQuintuplets", Type: MMContextUMTSKeyandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextEPSSecurityContextQuadrupletsAndQuintuplets", Type: MMContextEPSSecurityContextQuadrupletsandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextUMTSKeyQuadrupletsAndQuintuplets", Type: MMContextUMTSKeyQuadrupletsandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "TraceInformation", Type: TraceInformation, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "UEUsageType", Type: IntegerNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MonitoringEventInformation", Type: MonitoringEventInformation, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "MMESGSNLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+			{Role: "ExtendedTraceInformation", Type: ExtendedTraceInformation, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	ContextRequest: {
+		Type: ContextRequest,
+		IEs: []*IESchema{
+			{Role: "IMSI", Type: IMSI, Instance: 0, Presence: PresenceConditional},
+			{Role: "GUTI", Type: GUTI, Instance: 0, Presence: PresenceConditional},
+			{Role: "RoutingAreaIdentity", Type: ULI, Instance: 0, Presence: PresenceConditional},
+			{Role: "PTMSI", Type: PTMSI, Instance: 0, Presence: PresenceConditional},
+			{Role: "PTMSISignature", Type: PTMSISignature, Instance: 0, Presence: PresenceConditional},
+			{Role: "CompleteTAURequestMessage", Type: CompleteRequestMessage, Instance: 0, Presence: PresenceConditional},
+			{Role: "AddressAndTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+			{Role: "UDPSourcePortNumber", Type: PortNumber, Instance: 0, Presence: PresenceConditional},
+			{Role: "RATType", Type: RATType, Instance: 0, Presence: PresenceConditional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "HopCounter", Type: HopCounter, Instance: 0, Presence: PresenceOptional},
+			{Role: "TargetPLMNID", Type: ServingNetwork, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMESGSNLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGSNNodeName", Type: FQDN, Instance: 0, Presence: PresenceOptional},
+			{Role: "MMENodeName", Type: FQDN, Instance: 1, Presence: PresenceOptional},
+			{Role: "SGSNNumber", Type: NodeNumber, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGSNIdentifier", Type: NodeIdentifier, Instance: 0, Presence: PresenceOptional},
+			{Role: "MMEIdentifier", Type: NodeIdentifier, Instance: 1, Presence: PresenceOptional},
+			{Role: "CIoTOptimizationsSupportIndication", Type: CIoTOptimizationsSupportIndication, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	ContextResponse: {
+		Type: ContextResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "IMSI", Type: IMSI, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextGSMKeyAndTriplets", Type: MMContextGSMKeyandTriplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextUMTSKeyUsedCipherAndQuintuplets", Type: MMContextUMTSKeyUsedCipherandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextGSMKeyUsedCipherAndQuintuplets", Type: MMContextGSMKeyUsedCipherandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextUMTSKeyAndQuintuplets", Type: MMContextUMTSKeyandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextEPSSecurityContextQuadrupletsAndQuintuplets", Type: MMContextEPSSecurityContextQuadrupletsandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextUMTSKeyQuadrupletsAndQuintuplets", Type: MMContextUMTSKeyQuadrupletsandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMESGSNUEEPSPDNConnections", Type: PDNConnection, Instance: 0, Presence: PresenceConditional, Multiple: true, Group: "ContextResponsePDNConnection", GroupedIEs: []*IESchema{
+				{Role: "APN", Type: APN, Instance: 0, Presence: PresenceMandatory},
+				{Role: "APNRestriction", Type: APNRestriction, Instance: 0, Presence: PresenceConditional},
+				{Role: "SelectionMode", Type: SelectionMode, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "IPv4Address", Type: IPAddress, Instance: 0, Presence: PresenceConditional},
+				{Role: "IPv6Address", Type: IPAddress, Instance: 1, Presence: PresenceConditional},
+				{Role: "LinkedEPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "PGWS5S8IPAddressForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceMandatory},
+				{Role: "PGWNodeName", Type: FQDN, Instance: 0, Presence: PresenceConditional},
+				{Role: "BearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceConditional, Multiple: true, Group: "ContextResponseBearerContext", GroupedIEs: []*IESchema{
+					{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+					{Role: "TFT", Type: BearerTFT, Instance: 0, Presence: PresenceConditional},
+					{Role: "SGWS1S4S12IPAddressAndTEIDForUserPlane", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+					{Role: "PGWS5S8IPAddressAndTEIDForUserPlane", Type: FTEID, Instance: 1, Presence: PresenceConditional},
+					{Role: "BearerLevelQoS", Type: BearerQoS, Instance: 0, Presence: PresenceMandatory},
+					{Role: "BSSContainer", Type: FContainer, Instance: 0, Presence: PresenceConditionalOptional},
+					{Role: "TransactionIdentifier", Type: TI, Instance: 0, Presence: PresenceConditional},
+					{Role: "SGWS11IPAddressAndTEIDForUserPlane", Type: FTEID, Instance: 2, Presence: PresenceConditionalOptional},
+				}},
+				{Role: "AggregateMaximumBitRate", Type: AMBR, Instance: 0, Presence: PresenceMandatory},
+				{Role: "ChargingCharacteristics", Type: ChargingCharacteristics, Instance: 0, Presence: PresenceConditional},
+				{Role: "ChangeReportingAction", Type: ChangeReportingAction, Instance: 0, Presence: PresenceConditional},
+				{Role: "CSGInformationReportingAction", Type: CSGInformationReportingAction, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "HeNBInformationReporting", Type: HeNBInformationReporting, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "SignallingPriorityIndication", Type: SignallingPriorityIndication, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "ChangeToReportFlags", Type: ChangetoReportFlags, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "LocalHomeNetworkID", Type: FQDN, Instance: 1, Presence: PresenceConditionalOptional},
+				{Role: "WLANOffloadabilityIndication", Type: WLANOffloadabilityIndication, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "HeaderCompressionConfiguration", Type: HeaderCompressionConfiguration, Instance: 0, Presence: PresenceConditionalOptional},
+			}},
+			{Role: "SenderFTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+			{Role: "SGWS11S4IPAddressAndTEIDForControlPlane", Type: FTEID, Instance: 1, Presence: PresenceConditional},
+			{Role: "SGWNodeName", Type: FQDN, Instance: 0, Presence: PresenceConditional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditional},
+			{Role: "TraceInformation", Type: TraceInformation, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "HRPDAccessNodeS101IPAddress", Type: IPAddress, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "OneXIWSS102IPAddress", Type: IPAddress, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "SubscribedRFSPIndex", Type: RFSPIndex, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "RFSPIndexInUse", Type: RFSPIndex, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "UETimeZone", Type: UETimeZone, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMESGSNLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+			{Role: "MDTConfiguration", Type: MDTConfiguration, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SGSNNodeName", Type: FQDN, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "MMENodeName", Type: FQDN, Instance: 2, Presence: PresenceConditionalOptional},
+			{Role: "UserCSGInformation", Type: UCI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MonitoringEventInformation", Type: MonitoringEventInformation, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "UEUsageType", Type: IntegerNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMESGSNUESCEFPDNConnections", Type: SCEFPDNConnection, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "RATType", Type: RATType, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ServingPLMNRateControl", Type: ServingPLMNRateControl, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MOExceptionDataCounter", Type: Counter, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "RemainingRunningServiceGapTimer", Type: IntegerNumber, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "ExtendedTraceInformation", Type: ExtendedTraceInformation, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	ContextAcknowledge: {
+		Type: ContextAcknowledge,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditional},
+			{Role: "ForwardingFTEID", Type: FTEID, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "BearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true, Group: "ContextAcknowledgeBearerContext", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "ForwardingFTEID", Type: FTEID, Instance: 0, Presence: PresenceMandatory},
+			}},
+			{Role: "SGSNNumber", Type: NodeNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMENumberForMTSMS", Type: NodeNumber, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "SGSNIdentifierForMTSMS", Type: NodeIdentifier, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEIdentifierForMTSMS", Type: NodeIdentifier, Instance: 1, Presence: PresenceConditionalOptional},
+		},
+	},
+	ForwardRelocationRequest: {
+		Type: ForwardRelocationRequest,
+		IEs: []*IESchema{
+			{Role: "IMSI", Type: IMSI, Instance: 0, Presence: PresenceConditional},
+			{Role: "SenderFTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceMandatory},
+			{Role: "MMESGSNUEEPSPDNConnections", Type: PDNConnection, Instance: 0, Presence: PresenceMandatory, Multiple: true, Group: "ForwardRelocationRequestPDNConnection", GroupedIEs: []*IESchema{
+				{Role: "APN", Type: APN, Instance: 0, Presence: PresenceMandatory},
+				{Role: "APNRestriction", Type: APNRestriction, Instance: 0, Presence: PresenceConditional},
+				{Role: "SelectionMode", Type: SelectionMode, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "IPv4Address", Type: IPAddress, Instance: 0, Presence: PresenceConditional},
+				{Role: "IPv6Address", Type: IPAddress, Instance: 1, Presence: PresenceConditional},
+				{Role: "LinkedEPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "PGWS5S8IPAddressForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceMandatory},
+				{Role: "PGWNodeName", Type: FQDN, Instance: 0, Presence: PresenceConditional},
+				{Role: "BearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceMandatory, Multiple: true, Group: "ForwardRelocationRequestBearerContext", GroupedIEs: []*IESchema{
+					{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+					{Role: "TFT", Type: BearerTFT, Instance: 0, Presence: PresenceConditional},
+					{Role: "SGWS1S4S12IPAddressAndTEIDForUserPlane", Type: FTEID, Instance: 0, Presence: PresenceMandatory},
+					{Role: "PGWS5S8IPAddressAndTEIDForUserPlane", Type: FTEID, Instance: 1, Presence: PresenceConditional},
+					{Role: "BearerLevelQoS", Type: BearerQoS, Instance: 0, Presence: PresenceMandatory},
+					{Role: "BSSContainer", Type: FContainer, Instance: 0, Presence: PresenceConditional},
+					{Role: "TransactionIdentifier", Type: TI, Instance: 0, Presence: PresenceConditional},
+					{Role: "BearerFlags", Type: BearerFlags, Instance: 0, Presence: PresenceConditional},
+				}},
+				{Role: "AggregateMaximumBitRate", Type: AMBR, Instance: 0, Presence: PresenceMandatory},
+				{Role: "ChargingCharacteristics", Type: ChargingCharacteristics, Instance: 0, Presence: PresenceConditional},
+				{Role: "ChangeReportingAction", Type: ChangeReportingAction, Instance: 0, Presence: PresenceConditional},
+				{Role: "CSGInformationReportingAction", Type: CSGInformationReportingAction, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "HeNBInformationReporting", Type: HeNBInformationReporting, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "SignallingPriorityIndication", Type: SignallingPriorityIndication, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "ChangeToReportFlags", Type: ChangetoReportFlags, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "LocalHomeNetworkID", Type: FQDN, Instance: 1, Presence: PresenceConditionalOptional},
+				{Role: "WLANOffloadabilityIndication", Type: WLANOffloadabilityIndication, Instance: 0, Presence: PresenceConditionalOptional},
+				{Role: "HeaderCompressionConfiguration", Type: HeaderCompressionConfiguration, Instance: 0, Presence: PresenceConditionalOptional},
+			}},
+			{Role: "SGWS11S4IPAddressAndTEIDForControlPlane", Type: FTEID, Instance: 1, Presence: PresenceMandatory},
+			{Role: "SGWNodeName", Type: FQDN, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextGSMKeyAndTriplets", Type: MMContextGSMKeyandTriplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextUMTSKeyUsedCipherAndQuintuplets", Type: MMContextUMTSKeyUsedCipherandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextGSMKeyUsedCipherAndQuintuplets", Type: MMContextGSMKeyUsedCipherandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextUMTSKeyAndQuintuplets", Type: MMContextUMTSKeyandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextEPSSecurityContextQuadrupletsAndQuintuplets", Type: MMContextEPSSecurityContextQuadrupletsandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMContextUMTSKeyQuadrupletsAndQuintuplets", Type: MMContextUMTSKeyQuadrupletsandQuintuplets, Instance: 0, Presence: PresenceConditional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditional},
+			{Role: "EUTRANTransparentContainer", Type: FContainer, Instance: 0, Presence: PresenceConditional},
+			{Role: "UTRANTransparentContainer", Type: FContainer, Instance: 1, Presence: PresenceConditional},
+			{Role: "BSSContainer", Type: FContainer, Instance: 2, Presence: PresenceConditional},
+			{Role: "TargetIdentification", Type: TargetIdentification, Instance: 0, Presence: PresenceConditional},
+			{Role: "HRPDAccessNodeS101IPAddress", Type: IPAddress, Instance: 0, Presence: PresenceConditional},
+			{Role: "OneXIWSS102IPAddress", Type: IPAddress, Instance: 1, Presence: PresenceConditional},
+			{Role: "S1APCause", Type: FCause, Instance: 0, Presence: PresenceConditional},
+			{Role: "RANAPCause", Type: FCause, Instance: 1, Presence: PresenceConditional},
+			{Role: "BSSGPCause", Type: FCause, Instance: 2, Presence: PresenceConditional},
+			{Role: "SourceIdentification", Type: SourceIdentification, Instance: 0, Presence: PresenceConditional},
+			{Role: "SelectedPLMNID", Type: PLMNID, Instance: 0, Presence: PresenceConditional},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditional},
+			{Role: "TraceInformation", Type: TraceInformation, Instance: 0, Presence: PresenceConditional},
+			{Role: "SubscribedRFSPIndex", Type: RFSPIndex, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "RFSPIndexInUse", Type: RFSPIndex, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "CSGID", Type: CSGID, Instance: 0, Presence: PresenceConditional},
+			{Role: "CSGMembershipIndication", Type: CMI, Instance: 0, Presence: PresenceConditional},
+			{Role: "UETimeZone", Type: UETimeZone, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ServingNetwork", Type: ServingNetwork, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMESGSNLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+			{Role: "AdditionalMMContextForSRVCC", Type: AdditionalMMcontextforSRVCC, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "AdditionalFlagsForSRVCC", Type: AdditionalflagsforSRVCC, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "STNSR", Type: STNSR, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "CMSISDN", Type: MSISDN, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MDTConfiguration", Type: MDTConfiguration, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SGSNNodeName", Type: FQDN, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "MMENodeName", Type: FQDN, Instance: 2, Presence: PresenceConditionalOptional},
+			{Role: "UserCSGInformation", Type: UCI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MonitoringEventInformation", Type: MonitoringEventInformation, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "UEUsageType", Type: IntegerNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMESGSNUESCEFPDNConnections", Type: SCEFPDNConnection, Instance: 0, Presence: PresenceConditionalOptional, Multiple: true},
+			{Role: "MSISDN", Type: MSISDN, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "SourceUDPPortNumber", Type: PortNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ServingPLMNRateControl", Type: ServingPLMNRateControl, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ExtendedTraceInformation", Type: ExtendedTraceInformation, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	ForwardRelocationResponse: {
+		Type: ForwardRelocationResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "SenderFTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "ListOfSetUpBearers", Type: BearerContext, Instance: 0, Presence: PresenceConditional, Multiple: true, Group: "ForwardRelocationResponseBearerContextSetUp", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceConditional},
+				{Role: "PacketFlowID", Type: PacketFlowID, Instance: 0, Presence: PresenceConditional},
+				{Role: "ENodeBFTEIDForDLDataForwarding", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+				{Role: "ENodeBFTEIDForULDataForwarding", Type: FTEID, Instance: 1, Presence: PresenceConditional},
+				{Role: "SGWUPFFTEIDForDLDataForwarding", Type: FTEID, Instance: 2, Presence: PresenceConditional},
+				{Role: "RNCFTEIDForDLDataForwarding", Type: FTEID, Instance: 3, Presence: PresenceConditional},
+				{Role: "SGSNFTEIDForDLDataForwarding", Type: FTEID, Instance: 4, Presence: PresenceConditional},
+				{Role: "SGWFTEIDForULDataForwarding", Type: FTEID, Instance: 5, Presence: PresenceConditional},
+			}},
+			{Role: "ListOfSetUpRABs", Type: BearerContext, Instance: 1, Presence: PresenceConditional, Multiple: true, Group: "ForwardRelocationResponseRABSetUp", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceConditional},
+				{Role: "PacketFlowID", Type: PacketFlowID, Instance: 0, Presence: PresenceConditional},
+				{Role: "ENodeBFTEIDForDLDataForwarding", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+				{Role: "ENodeBFTEIDForULDataForwarding", Type: FTEID, Instance: 1, Presence: PresenceConditional},
+				{Role: "SGWUPFFTEIDForDLDataForwarding", Type: FTEID, Instance: 2, Presence: PresenceConditional},
+				{Role: "RNCFTEIDForDLDataForwarding", Type: FTEID, Instance: 3, Presence: PresenceConditional},
+				{Role: "SGSNFTEIDForDLDataForwarding", Type: FTEID, Instance: 4, Presence: PresenceConditional},
+				{Role: "SGWFTEIDForULDataForwarding", Type: FTEID, Instance: 5, Presence: PresenceConditional},
+			}},
+			{Role: "ListOfSetUpPFCs", Type: BearerContext, Instance: 2, Presence: PresenceOptional, Multiple: true, Group: "ForwardRelocationResponsePFCSetUp", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceConditional},
+				{Role: "PacketFlowID", Type: PacketFlowID, Instance: 0, Presence: PresenceConditional},
+				{Role: "SGSNFTEIDForDLDataForwarding", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+			}},
+			{Role: "S1APCause", Type: FCause, Instance: 0, Presence: PresenceConditional},
+			{Role: "RANAPCause", Type: FCause, Instance: 1, Presence: PresenceConditional},
+			{Role: "BSSGPCause", Type: FCause, Instance: 2, Presence: PresenceConditional},
+			{Role: "EUTRANTransparentContainer", Type: FContainer, Instance: 0, Presence: PresenceConditional},
+			{Role: "UTRANTransparentContainer", Type: FContainer, Instance: 1, Presence: PresenceConditional},
+			{Role: "BSSContainer", Type: FContainer, Instance: 2, Presence: PresenceConditional},
+			{Role: "MMESGSNLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+			{Role: "SGSNNodeName", Type: FQDN, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMENodeName", Type: FQDN, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "SGSNNumber", Type: NodeNumber, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SGSNIdentifier", Type: NodeIdentifier, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "MMEIdentifier", Type: NodeIdentifier, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "MMENumberForMTSMS", Type: NodeNumber, Instance: 1, Presence: PresenceConditionalOptional},
+			{Role: "SGSNIdentifierForMTSMS", Type: NodeIdentifier, Instance: 2, Presence: PresenceConditionalOptional},
+			{Role: "MMEIdentifierForMTSMS", Type: NodeIdentifier, Instance: 3, Presence: PresenceConditionalOptional},
+		},
+	},
+	ForwardRelocationCompleteNotification: {
+		Type: ForwardRelocationCompleteNotification,
+		IEs: []*IESchema{
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMESGSNLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+		},
+	},
+	ForwardRelocationCompleteAcknowledge: {
+		Type: ForwardRelocationCompleteAcknowledge,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceOptional},
+			{Role: "MMESGSNLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+		},
+	},
+	RelocationCancelRequest: {
+		Type: RelocationCancelRequest,
+		IEs: []*IESchema{
+			{Role: "IMSI", Type: IMSI, Instance: 0, Presence: PresenceConditional},
+			{Role: "MEI", Type: MEI, Instance: 0, Presence: PresenceConditional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditional},
+			{Role: "RANAPCause", Type: FCause, Instance: 0, Presence: PresenceConditional},
+			{Role: "MMESGSNLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+		},
+	},
+	RelocationCancelResponse: {
+		Type: RelocationCancelResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "MMESGSNLDN", Type: LDN, Instance: 0, Presence: PresenceOptional},
+		},
+	},
+	CreateIndirectDataForwardingTunnelRequest: {
+		Type: CreateIndirectDataForwardingTunnelRequest,
+		IEs: []*IESchema{
+			{Role: "IMSI", Type: IMSI, Instance: 0, Presence: PresenceConditional},
+			{Role: "MEI", Type: MEI, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "IndicationFlags", Type: Indication, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "SenderFTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceConditionalOptional},
+			{Role: "BearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceMandatory, Multiple: true, Group: "CreateIndirectDataForwardingTunnelRequestBearerContext", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "ENodeBFTEIDForDLDataForwarding", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+				{Role: "ENodeBFTEIDForULDataForwarding", Type: FTEID, Instance: 1, Presence: PresenceConditional},
+				{Role: "SGWUPFFTEIDForDLDataForwarding", Type: FTEID, Instance: 2, Presence: PresenceConditional},
+				{Role: "SGSNFTEIDForDLDataForwarding", Type: FTEID, Instance: 3, Presence: PresenceConditional},
+				{Role: "RNCFTEIDForDLDataForwarding", Type: FTEID, Instance: 4, Presence: PresenceConditional},
+				{Role: "SGWFTEIDForULDataForwarding", Type: FTEID, Instance: 5, Presence: PresenceConditional},
+				{Role: "MMEFTEIDForDLDataForwarding", Type: FTEID, Instance: 6, Presence: PresenceConditionalOptional},
+			}},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	CreateIndirectDataForwardingTunnelResponse: {
+		Type: CreateIndirectDataForwardingTunnelResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "SenderFTEIDForControlPlane", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+			{Role: "BearerContexts", Type: BearerContext, Instance: 0, Presence: PresenceMandatory, Multiple: true, Group: "CreateIndirectDataForwardingTunnelResponseBearerContext", GroupedIEs: []*IESchema{
+				{Role: "EPSBearerID", Type: EBI, Instance: 0, Presence: PresenceMandatory},
+				{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+				{Role: "SGWUPFFTEIDForDLDataForwarding", Type: FTEID, Instance: 0, Presence: PresenceConditional},
+				{Role: "SGWUPFFTEIDForULDataForwarding", Type: FTEID, Instance: 1, Presence: PresenceConditional},
+				{Role: "SGWFTEIDForDLDataForwardingForMME", Type: FTEID, Instance: 2, Presence: PresenceConditionalOptional},
+			}},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+	DeleteIndirectDataForwardingTunnelRequest: {
+		Type: DeleteIndirectDataForwardingTunnelRequest,
+		IEs:  []*IESchema{},
+	},
+	DeleteIndirectDataForwardingTunnelResponse: {
+		Type: DeleteIndirectDataForwardingTunnelResponse,
+		IEs: []*IESchema{
+			{Role: "Cause", Type: Cause, Instance: 0, Presence: PresenceMandatory},
+			{Role: "Recovery", Type: RecoveryRestartCounter, Instance: 0, Presence: PresenceConditionalOptional},
+		},
+	},
+}
@@ -6,84 +6,6 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
-var mapOfYamlPduTypeToMessageType = map[string]MessageType{
-	"EchoRequest":                                EchoRequest,
-	"EchoResponse":                               EchoResponse,
-	"CreateSessionRequest":                       CreateSessionRequest,
-	"CreateSessionResponse":                      CreateSessionResponse,
-	"ModifyBearerRequest":                        ModifyBearerRequest,
-	"ModifyBearerResponse":                       ModifyBearerResponse,
-	"DeleteSessionRequest":                       DeleteSessionRequest,
-	"DeleteSessionResponse":                      DeleteSessionResponse,
-	"RemoteUEReportNotification":                 RemoteUEReportNotification,
-	"RemoteUEReportAcknowlegement":               RemoteUEReportAcknowlegement,
-	"ChangeNotificationRequest":                  ChangeNotificationRequest,
-	"ChangeNotificationResponse":                 ChangeNotificationResponse,
-	"ModifyBearerCommand":                        ModifyBearerCommand,
-	"ModifyBearerFailureIndication":              ModifyBearerFailureIndication,
-	"DeleteBearerCommand":                        DeleteBearerCommand,
-	"DeleteBearerFailureIndication":              DeleteBearerFailureIndication,
-	"BearerResourceCommand":                      BearerResourceCommand,
-	"BearerResourceFailureIndication":            BearerResourceFailureIndication,
-	"DownlinkDataNotificationFailureIndication":  DownlinkDataNotificationFailureIndication,
-	"TraceSessionActivation":                     TraceSessionActivation,
-	"TraceSessionDeactivation":                   TraceSessionDeactivation,
-	"StopPagingIndication":                       StopPagingIndication,
-	"CreateBearerRequest":                        CreateBearerRequest,
-	"CreateBearerResponse":                       CreateBearerResponse,
-	"UpdateBearerRequest":                        UpdateBearerRequest,
-	"UpdateBearerResponse":                       UpdateBearerResponse,
-	"DeleteBearerRequest":                        DeleteBearerRequest,
-	"DeleteBearerResponse":                       DeleteBearerResponse,
-	"DeletePDNConnectionSetRequest":              DeletePDNConnectionSetRequest,
-	"DeletePDNConnectionSetResponse":             DeletePDNConnectionSetResponse,
-	"PGWDownlinkTriggeringNotification":          PGWDownlinkTriggeringNotification,
-	"PGWDownlinkTriggeringAcknowledge":           PGWDownlinkTriggeringAcknowledge,
-	"IdentificationRequest":                      IdentificationRequest,
-	"IdentificationResponse":                     IdentificationResponse,
-	"ContextRequest":                             ContextRequest,
-	"ContextResponse":                            ContextResponse,
-	"ContextAcknowledge":                         ContextAcknowledge,
-	"ForwardRelocationRequest":                   ForwardRelocationRequest,
-	"ForwardRelocationResponse":                  ForwardRelocationResponse,
-	"ForwardRelocationCompleteNotification":      ForwardRelocationCompleteNotification,
-	"ForwardRelocationCompleteAcknowledge":       ForwardRelocationCompleteAcknowledge,
-	"ForwardAccessContextNotification":           ForwardAccessContextNotification,
-	"ForwardAccessContextAcknowledge":            ForwardAccessContextAcknowledge,
-	"RelocationCancelRequest":                    RelocationCancelRequest,
-	"RelocationCancelResponse":                   RelocationCancelResponse,
-	"ConfigurationTransferTunnel":                ConfigurationTransferTunnel,
-	"DetachNotification":                         DetachNotification,
-	"DetachAcknowledge":                          DetachAcknowledge,
-	"CSPagingIndication":                         CSPagingIndication,
-	"RANInformationRelay":                        RANInformationRelay,
-	"AlertMMENotification":                       AlertMMENotification,
-	"AlertMMEAcknowledge":                        AlertMMEAcknowledge,
-	"UEActivityNotification":                     UEActivityNotification,
-	"UEActivityAcknowledge":                      UEActivityAcknowledge,
-	"ISRStatusIndication":                        ISRStatusIndication,
-	"UERegistrationQueryRequest":                 UERegistrationQueryRequest,
-	"UERegistrationQueryResponse":                UERegistrationQueryResponse,
-	"CreateForwardingTunnelRequest":              CreateForwardingTunnelRequest,
-	"CreateForwardingTunnelResponse":             CreateForwardingTunnelResponse,
-	"SuspendNotification":                        SuspendNotification,
-	"SuspendAcknowledge":                         SuspendAcknowledge,
-	"ResumeNotification":                         ResumeNotification,
-	"ResumeAcknowledge":                          ResumeAcknowledge,
-	"CreateIndirectDataForwardingTunnelRequest":  CreateIndirectDataForwardingTunnelRequest,
-	"CreateIndirectDataForwardingTunnelResponse": CreateIndirectDataForwardingTunnelResponse,
-	"DeleteIndirectDataForwardingTunnelRequest":  DeleteIndirectDataForwardingTunnelRequest,
-	"DeleteIndirectDataForwardingTunnelResponse": DeleteIndirectDataForwardingTunnelResponse,
-	"ReleaseAccessBearersRequest":                ReleaseAccessBearersRequest,
-	"ReleaseAccessBearersResponse":               ReleaseAccessBearersResponse,
-	"DownlinkDataNotification":                   DownlinkDataNotification,
-	"DownlinkDataNotificationAcknowledge":        DownlinkDataNotificationAcknowledge,
-	"PGWRestartNotification":                     PGWRestartNotification,
-	"PGWRestartNotificationAcknowledge":          PGWRestartNotificationAcknowledge,
-	"UpdatePDNConnectionSetRequest":              UpdatePDNConnectionSetRequest,
-	"UpdatePDNConnectionSetResponse":             UpdatePDNConnectionSetResponse,
-}
-
 type IEYaml struct {
 	Type  string      `yaml:"Type"`
 	Value interface{} `yaml:"Value"`